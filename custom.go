@@ -0,0 +1,202 @@
+package jschema
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// CustomRule a rule implemented in Go. Can be registered in the Registry and
+// used in the schema like any built-in rule. The value of the custom rule should
+// be a JSON literal.
+//
+// Example:
+//
+//	r.AddRule("countryCode", jschema.CustomRule{
+//		Validate: func(_ interface{}, value []byte) error {
+//			if !isCountryCode(value) {
+//				return errors.New("unknown country")
+//			}
+//			return nil
+//		},
+//	})
+//
+//	"DE" // {countryCode: true}
+type CustomRule struct {
+	// ParseValue parses the rule value. Receives the JSON literal as it's
+	// written in the schema (strings are in quotes). The returned value will be
+	// passed to the Validate and ASTNode functions.
+	// Optional. The rule value is used as is when not specified.
+	ParseValue func(ruleValue []byte) (interface{}, error)
+
+	// IsJsonTypeCompatible checks the rule can be used with the specified JSON
+	// type. Receives one of the "object", "array", "string", "integer",
+	// "float", "boolean", "null" or "mixed" types.
+	// Optional. By default, the rule is compatible with all types except
+	// objects and arrays.
+	IsJsonTypeCompatible func(SchemaType) bool
+
+	// Validate checks the JSON literal value (strings are in quotes) against the
+	// rule. Returned error will be reported as the validation error of this
+	// rule.
+	// Required.
+	Validate func(ruleValue interface{}, value []byte) error
+
+	// ASTNode returns an AST node for the rule value.
+	// Optional. By default, the rule value is placed in the AST as is.
+	ASTNode func(ruleValue interface{}) RuleASTNode
+}
+
+// CustomType a type implemented in Go. Can be registered in the Registry and
+// used in the "type" and "or" rules like any built-in type.
+//
+// Example:
+//
+//	r.AddType("iban", jschema.CustomType{
+//		Validate: validateIBAN,
+//	})
+//
+//	"DE89370400440532013000" // {type: "iban"}
+type CustomType struct {
+	// BaseType a JSON type of the values. Can be one of the "string",
+	// "integer", "float", "boolean" or "null" types.
+	// Optional. The "string" type is used by default.
+	BaseType SchemaType
+
+	// Validate checks the JSON literal value (strings are in quotes). Returned
+	// error will be reported as the validation error of this type.
+	// Required.
+	Validate func(value []byte) error
+}
+
+// Registry a set of custom rules and types. Is thread safe, so a single
+// registry can be shared between schemas.
+type Registry struct {
+	rules map[string]CustomRule
+	types map[string]CustomType
+	mx    sync.RWMutex
+}
+
+func NewRegistry() *Registry {
+	return &Registry{
+		rules: map[string]CustomRule{},
+		types: map[string]CustomType{},
+	}
+}
+
+// AddRule registers the custom rule with specified name.
+func (r *Registry) AddRule(name string, rule CustomRule) error {
+	if err := validateCustomName(name); err != nil {
+		return err
+	}
+
+	if IsValidRule(name) {
+		return fmt.Errorf("rule %q is a built-in rule", name)
+	}
+
+	if rule.Validate == nil {
+		return fmt.Errorf("validate function of the %q rule is nil", name)
+	}
+
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	if _, ok := r.rules[name]; ok {
+		return fmt.Errorf("rule %q is already registered", name)
+	}
+	r.rules[name] = rule
+	return nil
+}
+
+// AddType registers the custom type with specified name.
+func (r *Registry) AddType(name string, typ CustomType) error {
+	if err := validateCustomName(name); err != nil {
+		return err
+	}
+
+	if IsValidType(name) {
+		return fmt.Errorf("type %q is a built-in type", name)
+	}
+
+	if typ.Validate == nil {
+		return fmt.Errorf("validate function of the %q type is nil", name)
+	}
+
+	if typ.BaseType == SchemaTypeUndefined {
+		typ.BaseType = SchemaTypeString
+	}
+
+	if !typ.BaseType.IsOneOf(
+		SchemaTypeString,
+		SchemaTypeInteger,
+		SchemaTypeFloat,
+		SchemaTypeBoolean,
+		SchemaTypeNull,
+	) {
+		return fmt.Errorf("invalid base type %q of the %q type", typ.BaseType, name)
+	}
+
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	if _, ok := r.types[name]; ok {
+		return fmt.Errorf("type %q is already registered", name)
+	}
+	r.types[name] = typ
+	return nil
+}
+
+// Rule returns the custom rule with specified name.
+func (r *Registry) Rule(name string) (CustomRule, bool) {
+	if r == nil {
+		return CustomRule{}, false
+	}
+
+	r.mx.RLock()
+	defer r.mx.RUnlock()
+
+	rule, ok := r.rules[name]
+	return rule, ok
+}
+
+// Type returns the custom type with specified name.
+func (r *Registry) Type(name string) (CustomType, bool) {
+	if r == nil {
+		return CustomType{}, false
+	}
+
+	r.mx.RLock()
+	defer r.mx.RUnlock()
+
+	typ, ok := r.types[name]
+	return typ, ok
+}
+
+// RuleNames returns sorted names of all registered rules.
+func (r *Registry) RuleNames() []string {
+	if r == nil {
+		return nil
+	}
+
+	r.mx.RLock()
+	defer r.mx.RUnlock()
+
+	names := make([]string, 0, len(r.rules))
+	for n := range r.rules {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func validateCustomName(name string) error {
+	if name == "" {
+		return errors.New("name is empty")
+	}
+
+	if name[0] == '@' {
+		return fmt.Errorf("name %q is a user type name", name)
+	}
+	return nil
+}
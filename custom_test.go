@@ -0,0 +1,157 @@
+package jschema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistry_AddRule(t *testing.T) {
+	validate := func(interface{}, []byte) error { return nil }
+
+	t.Run("positive", func(t *testing.T) {
+		r := NewRegistry()
+
+		err := r.AddRule("countryCode", CustomRule{Validate: validate})
+		require.NoError(t, err)
+
+		rule, ok := r.Rule("countryCode")
+		assert.True(t, ok)
+		assert.NotNil(t, rule.Validate)
+		assert.Equal(t, []string{"countryCode"}, r.RuleNames())
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		cc := map[string]struct {
+			name     string
+			rule     CustomRule
+			expected string
+		}{
+			"empty name": {
+				"",
+				CustomRule{Validate: validate},
+				"name is empty",
+			},
+
+			"user type name": {
+				"@foo",
+				CustomRule{Validate: validate},
+				`name "@foo" is a user type name`,
+			},
+
+			"built-in rule": {
+				"min",
+				CustomRule{Validate: validate},
+				`rule "min" is a built-in rule`,
+			},
+
+			"without validate function": {
+				"foo",
+				CustomRule{},
+				`validate function of the "foo" rule is nil`,
+			},
+
+			"duplicate": {
+				"dup",
+				CustomRule{Validate: validate},
+				`rule "dup" is already registered`,
+			},
+		}
+
+		for n, c := range cc {
+			t.Run(n, func(t *testing.T) {
+				r := NewRegistry()
+				require.NoError(t, r.AddRule("dup", CustomRule{Validate: validate}))
+
+				err := r.AddRule(c.name, c.rule)
+				assert.EqualError(t, err, c.expected)
+			})
+		}
+	})
+}
+
+func TestRegistry_AddType(t *testing.T) {
+	validate := func([]byte) error { return nil }
+
+	t.Run("positive", func(t *testing.T) {
+		r := NewRegistry()
+
+		require.NoError(t, r.AddType("iban", CustomType{Validate: validate}))
+		require.NoError(t, r.AddType("sku", CustomType{BaseType: SchemaTypeInteger, Validate: validate}))
+
+		typ, ok := r.Type("iban")
+		assert.True(t, ok)
+		assert.Equal(t, SchemaTypeString, typ.BaseType)
+
+		typ, ok = r.Type("sku")
+		assert.True(t, ok)
+		assert.Equal(t, SchemaTypeInteger, typ.BaseType)
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		cc := map[string]struct {
+			name     string
+			typ      CustomType
+			expected string
+		}{
+			"empty name": {
+				"",
+				CustomType{Validate: validate},
+				"name is empty",
+			},
+
+			"user type name": {
+				"@foo",
+				CustomType{Validate: validate},
+				`name "@foo" is a user type name`,
+			},
+
+			"built-in type": {
+				"email",
+				CustomType{Validate: validate},
+				`type "email" is a built-in type`,
+			},
+
+			"without validate function": {
+				"foo",
+				CustomType{},
+				`validate function of the "foo" type is nil`,
+			},
+
+			"invalid base type": {
+				"foo",
+				CustomType{BaseType: SchemaTypeObject, Validate: validate},
+				`invalid base type "object" of the "foo" type`,
+			},
+
+			"duplicate": {
+				"dup",
+				CustomType{Validate: validate},
+				`type "dup" is already registered`,
+			},
+		}
+
+		for n, c := range cc {
+			t.Run(n, func(t *testing.T) {
+				r := NewRegistry()
+				require.NoError(t, r.AddType("dup", CustomType{Validate: validate}))
+
+				err := r.AddType(c.name, c.typ)
+				assert.EqualError(t, err, c.expected)
+			})
+		}
+	})
+}
+
+func TestRegistry_nil(t *testing.T) {
+	var r *Registry
+
+	_, ok := r.Rule("foo")
+	assert.False(t, ok)
+
+	_, ok = r.Type("foo")
+	assert.False(t, ok)
+
+	assert.Nil(t, r.RuleNames())
+}
@@ -0,0 +1,34 @@
+package errors
+
+// CanceledError indicates that validation was stopped because the context was
+// canceled or its deadline was exceeded. It wraps the error of the context, so
+// it can be checked with errors.Is(err, context.Canceled).
+type CanceledError struct {
+	err error
+}
+
+func NewCanceledError(err error) CanceledError {
+	return CanceledError{
+		err: err,
+	}
+}
+
+func (CanceledError) Code() ErrorCode {
+	return ErrValidationCanceled
+}
+
+func (e CanceledError) Error() string {
+	return e.Message()
+}
+
+func (e CanceledError) Message() string {
+	return Format(ErrValidationCanceled, e.err.Error()).Error()
+}
+
+func (CanceledError) ErrCode() int {
+	return int(ErrValidationCanceled)
+}
+
+func (e CanceledError) Unwrap() error {
+	return e.err
+}
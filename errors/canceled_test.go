@@ -0,0 +1,28 @@
+package errors
+
+import (
+	"context"
+	stdErrors "errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCanceledError(t *testing.T) {
+	cc := map[string]error{
+		"Validation canceled: context canceled":          context.Canceled,
+		"Validation canceled: context deadline exceeded": context.DeadlineExceeded,
+	}
+
+	for expected, ctxErr := range cc {
+		t.Run(expected, func(t *testing.T) {
+			err := NewCanceledError(ctxErr)
+
+			assert.EqualError(t, err, expected)
+			assert.Equal(t, expected, err.Message())
+			assert.Equal(t, ErrValidationCanceled, err.Code())
+			assert.Equal(t, 216, err.ErrCode())
+			assert.True(t, stdErrors.Is(err, ctxErr))
+		})
+	}
+}
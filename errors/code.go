@@ -32,6 +32,10 @@ const (
 	ErrInvalidValueType                ErrorCode = 210
 	ErrInvalidKeyType                  ErrorCode = 211
 	ErrUnexpectedLexInMixedValidator   ErrorCode = 212
+	ErrReadOnlyKey                     ErrorCode = 213
+	ErrWriteOnlyKey                    ErrorCode = 214
+	ErrDeprecatedKey                   ErrorCode = 215
+	ErrValidationCanceled              ErrorCode = 216
 
 	// Scanner.
 
@@ -39,6 +43,11 @@ const (
 	ErrInvalidCharacterInAnnotationObjectKey ErrorCode = 302
 	ErrUnexpectedEOF                         ErrorCode = 303
 	ErrAnnotationNotAllowed                  ErrorCode = 304
+	ErrDepthLimitExceeded                    ErrorCode = 305
+	ErrStringLengthLimitExceeded             ErrorCode = 306
+	ErrKeysLimitExceeded                     ErrorCode = 307
+	ErrArrayLengthLimitExceeded              ErrorCode = 308
+	ErrLexemesLimitExceeded                  ErrorCode = 309
 
 	// Schema.
 
@@ -70,6 +79,36 @@ const (
 	ErrInvalidDate                                 ErrorCode = 616
 	ErrValueOfOneConstraintGreaterThanAnother      ErrorCode = 617
 	ErrValueOfOneConstraintGreaterOrEqualToAnother ErrorCode = 618
+	ErrConstraintUniqueItemsValidation             ErrorCode = 619
+	ErrConstraintUniqueItemsKeyValidation          ErrorCode = 620
+	ErrMultipleOfOutOfRange                        ErrorCode = 621
+	ErrConstraintMinPropertiesValidation           ErrorCode = 622
+	ErrConstraintMaxPropertiesValidation           ErrorCode = 623
+	ErrConstraintPropertyNamesValidation           ErrorCode = 624
+	ErrConstraintDependentRequiredValidation       ErrorCode = 625
+	ErrConstraintWhenValidation                    ErrorCode = 626
+	ErrDiscriminatorKeyNotFound                    ErrorCode = 627
+	ErrInvalidDiscriminatorValue                   ErrorCode = 628
+	ErrOrRuleSeveralTypesMatched                   ErrorCode = 629
+	ErrConstraintNotValidation                     ErrorCode = 630
+	ErrInvalidIPv4                                 ErrorCode = 631
+	ErrInvalidIPv6                                 ErrorCode = 632
+	ErrInvalidCIDR                                 ErrorCode = 633
+	ErrInvalidHostname                             ErrorCode = 634
+	ErrInvalidTime                                 ErrorCode = 635
+	ErrInvalidDuration                             ErrorCode = 636
+	ErrInvalidBase64                               ErrorCode = 637
+	ErrInvalidBase64URL                            ErrorCode = 638
+	ErrInvalidHex                                  ErrorCode = 639
+	ErrInvalidSemver                               ErrorCode = 640
+	ErrInvalidLanguageTag                          ErrorCode = 641
+	ErrConstraintCustomValidation                  ErrorCode = 642
+	ErrCustomTypeValidation                        ErrorCode = 643
+	ErrIntegerOutOfRange                           ErrorCode = 644
+	ErrTooManyTupleItems                           ErrorCode = 645
+	ErrNotEnoughTupleItems                         ErrorCode = 646
+	ErrNotEnoughContainedItems                     ErrorCode = 647
+	ErrTooManyContainedItems                       ErrorCode = 648
 
 	// Loader.
 
@@ -81,16 +120,23 @@ const (
 
 	// Rule loader.
 
-	ErrLoader                           ErrorCode = 801
-	ErrIncorrectRuleValueType           ErrorCode = 802
-	ErrIncorrectRuleWithoutExample      ErrorCode = 803
-	ErrIncorrectRuleForSeveralNode      ErrorCode = 804
-	ErrLiteralValueExpected             ErrorCode = 805
-	ErrInvalidValueInEnumRule           ErrorCode = 806
-	ErrIncorrectArrayItemTypeInEnumRule ErrorCode = 807
-	ErrUnacceptableValueInAllOfRule     ErrorCode = 808
-	ErrTypeNameNotFoundInAllOfRule      ErrorCode = 809
-	ErrDuplicationInEnumRule            ErrorCode = 810
+	ErrLoader                              ErrorCode = 801
+	ErrIncorrectRuleValueType              ErrorCode = 802
+	ErrIncorrectRuleWithoutExample         ErrorCode = 803
+	ErrIncorrectRuleForSeveralNode         ErrorCode = 804
+	ErrLiteralValueExpected                ErrorCode = 805
+	ErrInvalidValueInEnumRule              ErrorCode = 806
+	ErrIncorrectArrayItemTypeInEnumRule    ErrorCode = 807
+	ErrUnacceptableValueInAllOfRule        ErrorCode = 808
+	ErrTypeNameNotFoundInAllOfRule         ErrorCode = 809
+	ErrDuplicationInEnumRule               ErrorCode = 810
+	ErrInvalidValueInDependentRequiredRule ErrorCode = 811
+	ErrInvalidValueInWhenRule              ErrorCode = 812
+	ErrInvalidValueInNotRule               ErrorCode = 813
+	ErrInvalidValueInCustomRule            ErrorCode = 814
+	// ErrCustomRuleConflict                  ErrorCode = 815
+	ErrInvalidValueInExamplesRule ErrorCode = 816
+	ErrInvalidValueInContainsRule ErrorCode = 817
 
 	// "or" rule loader.
 
@@ -119,13 +165,37 @@ const (
 	ErrIncompatibleTypes                         ErrorCode = 1115
 	// ErrUnknownAdditionalPropertiesTypes          ErrorCode = 1116
 
-	ErrUnexpectedConstraint ErrorCode = 1117
+	ErrUnexpectedConstraint     ErrorCode = 1117
+	ErrRuleWithoutOr            ErrorCode = 1118
+	ErrDateRangeWithoutDateType ErrorCode = 1119
+	ErrInvalidDateRangeValue    ErrorCode = 1120
+	ErrLengthUnitWithoutLength  ErrorCode = 1121
+	ErrRuleWithoutConstOrEnum   ErrorCode = 1122
+	ErrReadOnlyWithWriteOnly    ErrorCode = 1123
+	ErrDefaultNotForOptionalKey ErrorCode = 1124
+	ErrRangeOutOfIntegerWidth   ErrorCode = 1125
+	ErrRuleWithoutTuple         ErrorCode = 1126
+	ErrTupleItemAfterOptional   ErrorCode = 1127
+	ErrRuleWithoutContains      ErrorCode = 1128
 
 	// Checker.
 
 	ErrChecker                               ErrorCode = 1201
 	ErrElementNotFoundInArray                ErrorCode = 1203
 	ErrIncorrectConstraintValueForEmptyArray ErrorCode = 1204
+	ErrInvalidPropertyNamesType              ErrorCode = 1205
+	ErrUnknownKeyInDependentRequiredRule     ErrorCode = 1206
+	ErrSelfDependencyInDependentRequiredRule ErrorCode = 1207
+	ErrUnknownKeyInWhenRule                  ErrorCode = 1208
+	ErrInvalidConstInWhenRule                ErrorCode = 1209
+	ErrDuplicateConditionInWhenRule          ErrorCode = 1210
+	ErrInvalidWhenType                       ErrorCode = 1211
+	ErrInvalidDiscriminatorType              ErrorCode = 1212
+	ErrDuplicateDiscriminatorValue           ErrorCode = 1213
+	ErrOverlappingTypesInExclusiveOr         ErrorCode = 1214
+	ErrNotRuleExcludesExample                ErrorCode = 1215
+	ErrUnsatisfiableNotRule                  ErrorCode = 1216
+	ErrInvalidDefaultValue                   ErrorCode = 1217
 
 	// Link checker.
 
@@ -151,6 +221,34 @@ const (
 	ErrEnumIsHoldRuleName ErrorCode = 1601
 	ErrEnumRuleNotFound   ErrorCode = 1602
 	ErrNotAnEnumRule      ErrorCode = 1603
+
+	// YAML.
+
+	ErrInvalidYAML           ErrorCode = 1700
+	ErrYAMLMultipleDocuments ErrorCode = 1701
+	ErrYAMLUnsupportedTag    ErrorCode = 1702
+	ErrYAMLInvalidKey        ErrorCode = 1703
+	ErrYAMLInvalidNumber     ErrorCode = 1704
+	ErrYAMLMergeKey          ErrorCode = 1705
+	ErrYAMLRecursiveAlias    ErrorCode = 1706
+	ErrYAMLTooManyAliases    ErrorCode = 1707
+
+	// Binary formats.
+
+	ErrBinaryUnexpectedEOF     ErrorCode = 1800
+	ErrBinaryInvalidData       ErrorCode = 1801
+	ErrBinaryByteString        ErrorCode = 1802
+	ErrBinaryNonStringKey      ErrorCode = 1803
+	ErrBinaryUnsupportedValue  ErrorCode = 1804
+	ErrBinaryTrailingData      ErrorCode = 1805
+	ErrBinaryNonFiniteNumber   ErrorCode = 1806
+	ErrBinaryInvalidUTF8String ErrorCode = 1807
+
+	// Form.
+
+	ErrFormInvalidEncoding ErrorCode = 1900
+	ErrFormInvalidKey      ErrorCode = 1901
+	ErrFormKeyConflict     ErrorCode = 1902
 )
 
 var errorFormat = map[ErrorCode]string{
@@ -178,12 +276,21 @@ var errorFormat = map[ErrorCode]string{
 	ErrUnexpectedLexInMixedValidator:   `Invalid value, scalar, array, or object expected`,
 	ErrInvalidValueType:                `Invalid value type "%s", expected "%s"`,
 	ErrInvalidKeyType:                  `Incorrect key type "%s"`,
+	ErrReadOnlyKey:                     `The key "%s" is read-only and cannot be sent in a request`,
+	ErrWriteOnlyKey:                    `The key "%s" is write-only and cannot be sent in a response`,
+	ErrDeprecatedKey:                   `The key "%s" is deprecated`,
+	ErrValidationCanceled:              "Validation canceled: %s",
 
 	// scanner
 	ErrInvalidCharacter:                      "Invalid character %q %s",
 	ErrInvalidCharacterInAnnotationObjectKey: "Invalid character %s in object key (inside comment)",
 	ErrUnexpectedEOF:                         "Unexpected end of file",
 	ErrAnnotationNotAllowed:                  "Annotation not allowed here",
+	ErrDepthLimitExceeded:                    "The nesting depth exceeds the limit of %s",
+	ErrStringLengthLimitExceeded:             "The string length exceeds the limit of %s bytes",
+	ErrKeysLimitExceeded:                     "The number of object keys exceeds the limit of %s",
+	ErrArrayLengthLimitExceeded:              "The number of array items exceeds the limit of %s",
+	ErrLexemesLimitExceeded:                  "The number of lexemes exceeds the limit of %s",
 
 	// schema
 	ErrNodeGrow:                 "Node grow error",
@@ -212,6 +319,36 @@ var errorFormat = map[ErrorCode]string{
 	ErrInvalidDate:                                 "Date parsing error (%s)",
 	ErrValueOfOneConstraintGreaterThanAnother:      "Value of constraint %q should be less or equal to value of %q constraint", //nolint:lll
 	ErrValueOfOneConstraintGreaterOrEqualToAnother: "Value of constraint %q should be less than value of %q constraint",
+	ErrConstraintUniqueItemsValidation:             `Array items should be unique, but the item duplicates the item at index %s`,
+	ErrConstraintUniqueItemsKeyValidation:          `The %q property of array items should be unique, but the item duplicates the item at index %s`, //nolint:lll
+	ErrMultipleOfOutOfRange:                        `There is no value which is a multiple of %s between values of "min" and "max" constraints`,     //nolint:lll
+	ErrConstraintMinPropertiesValidation:           `The number of object properties does not match the "minProperties" rule`,
+	ErrConstraintMaxPropertiesValidation:           `The number of object properties does not match the "maxProperties" rule`,
+	ErrConstraintPropertyNamesValidation:           `The key %q does not match the "propertyNames" rule`,
+	ErrConstraintDependentRequiredValidation:       `The key %q is required when the key %q is present ("dependentRequired" rule)`,
+	ErrConstraintWhenValidation:                    `The object should match the %q type when the key %q is %s ("when" rule): %s`,
+	ErrDiscriminatorKeyNotFound:                    `The discriminator key %q not found`,
+	ErrInvalidDiscriminatorValue:                   `Invalid value %s of the discriminator key %q, expected one of: %s`,
+	ErrOrRuleSeveralTypesMatched:                   `The value matches several types of the exclusive "or" rule: %s`,
+	ErrConstraintNotValidation:                     `The value should not match the "not" rule`,
+	ErrInvalidIPv4:                                 "Invalid IPv4 address (%s)",
+	ErrInvalidIPv6:                                 "Invalid IPv6 address (%s)",
+	ErrInvalidCIDR:                                 "Invalid CIDR notation (%s)",
+	ErrInvalidHostname:                             "Invalid hostname (%s)",
+	ErrInvalidTime:                                 "Time parsing error (%s)",
+	ErrInvalidDuration:                             "Invalid ISO 8601 duration (%s)",
+	ErrInvalidBase64:                               "Invalid base64 string (%s)",
+	ErrInvalidBase64URL:                            "Invalid base64url string (%s)",
+	ErrInvalidHex:                                  "Invalid hex string (%s)",
+	ErrInvalidSemver:                               "Invalid semantic version (%s)",
+	ErrInvalidLanguageTag:                          "Invalid BCP 47 language tag (%s)",
+	ErrConstraintCustomValidation:                  "The value doesn't match the %q rule (%s)",
+	ErrCustomTypeValidation:                        "Invalid value of the %q type (%s)",
+	ErrIntegerOutOfRange:                           "The value is out of range of the %q type (from %s to %s)",
+	ErrTooManyTupleItems:                           "The tuple allows at most %s items",
+	ErrNotEnoughTupleItems:                         "The tuple requires at least %s items",
+	ErrNotEnoughContainedItems:                     `The array requires at least %s items matching the "contains" rule`,
+	ErrTooManyContainedItems:                       `The array allows at most %s items matching the "contains" rule`,
 
 	// loader
 	ErrInvalidSchemaName:                "Invalid schema name (%s)",
@@ -221,16 +358,23 @@ var errorFormat = map[ErrorCode]string{
 	ErrConflictAdditionalProperties:     `Conflicting value in AdditionalProperties rules when inheriting from allOf`,
 
 	// rule loader
-	ErrLoader:                           "Loader error", // error somewhere in the loader code
-	ErrIncorrectRuleValueType:           "Incorrect rule value type",
-	ErrIncorrectRuleWithoutExample:      "You cannot place a RULE on line without EXAMPLE",
-	ErrIncorrectRuleForSeveralNode:      "You cannot place a RULE on lines that contain more than one EXAMPLE node to which any RULES can apply. The only exception is when an object key and its value are found in one line.", //nolint:lll
-	ErrLiteralValueExpected:             "Literal value expected",
-	ErrInvalidValueInEnumRule:           `An array or rule name was expected as a value for the "enum"`,
-	ErrIncorrectArrayItemTypeInEnumRule: `Incorrect array item type in "enum". Only literals are allowed.`,
-	ErrUnacceptableValueInAllOfRule:     `Incorrect value in "allOf" rule. A type name, or list of type names, is expected.`, //nolint:lll
-	ErrTypeNameNotFoundInAllOfRule:      `Type name not found in "allOf" rule`,
-	ErrDuplicationInEnumRule:            `%s value duplicates in "enum"`,
+	ErrLoader:                              "Loader error", // error somewhere in the loader code
+	ErrIncorrectRuleValueType:              "Incorrect rule value type",
+	ErrIncorrectRuleWithoutExample:         "You cannot place a RULE on line without EXAMPLE",
+	ErrIncorrectRuleForSeveralNode:         "You cannot place a RULE on lines that contain more than one EXAMPLE node to which any RULES can apply. The only exception is when an object key and its value are found in one line.", //nolint:lll
+	ErrLiteralValueExpected:                "Literal value expected",
+	ErrInvalidValueInEnumRule:              `An array or rule name was expected as a value for the "enum"`,
+	ErrIncorrectArrayItemTypeInEnumRule:    `Incorrect array item type in "enum". Only literals are allowed.`,
+	ErrUnacceptableValueInAllOfRule:        `Incorrect value in "allOf" rule. A type name, or list of type names, is expected.`, //nolint:lll
+	ErrTypeNameNotFoundInAllOfRule:         `Type name not found in "allOf" rule`,
+	ErrDuplicationInEnumRule:               `%s value duplicates in "enum"`,
+	ErrInvalidValueInDependentRequiredRule: `An object with lists of key names was expected as a value for the "dependentRequired" rule`,                                //nolint:lll
+	ErrInvalidValueInWhenRule:              `A condition, or list of conditions, with "key", "const" and "then" properties was expected as a value for the "when" rule`, //nolint:lll
+	ErrInvalidValueInNotRule:               `A value, a user type name or a rule-set was expected as a value for the "not" rule`,
+	ErrInvalidValueInCustomRule:            "Invalid value of the %q rule (%s)",
+	// ErrCustomRuleConflict:                  "The custom rule %q conflicts with the built-in rule",
+	ErrInvalidValueInExamplesRule: `An array of literals was expected as a value for the "examples" rule`,
+	ErrInvalidValueInContainsRule: `A value, a user type name or a rule-set was expected as a value for the "contains" rule`,
 
 	// "or" rule loader
 	ErrArrayWasExpectedInOrRule:       `An array was expected as a value for the "or" rule`,
@@ -256,12 +400,36 @@ var errorFormat = map[ErrorCode]string{
 	ErrNotFoundRuleOr:                            `Not found the rule "or" for the "mixed" type`,
 	ErrIncompatibleTypes:                         `Incompatible value of example and "type" rule (%s)`,
 	// ErrUnknownAdditionalPropertiesTypes:          "Unknown type of additionalProperties (%s)",
-	ErrUnexpectedConstraint: "The %q constraint can't be used for the %q type",
+	ErrUnexpectedConstraint:     "The %q constraint can't be used for the %q type",
+	ErrRuleWithoutOr:            `The %q rule can only be used together with the "or" rule`,
+	ErrDateRangeWithoutDateType: `The %q rule with the date value can only be used with the "date" and "datetime" types`,
+	ErrInvalidDateRangeValue:    `The value of the %q rule should be a %s value`,
+	ErrLengthUnitWithoutLength:  `The "lengthUnit" rule can only be used together with the "minLength" or "maxLength" rules`,
+	ErrRuleWithoutConstOrEnum:   `The %q rule can only be used together with the "const" or "enum" rules`,
+	ErrReadOnlyWithWriteOnly:    `The "readOnly" and "writeOnly" rules cannot be used together`,
+	ErrDefaultNotForOptionalKey: `The "default" rule can only be used for optional object properties`,
+	ErrRangeOutOfIntegerWidth:   `The value of the %q rule is out of range of the %q type`,
+	ErrRuleWithoutTuple:         `The %q rule can only be used together with the "tuple" rule`,
+	ErrTupleItemAfterOptional:   `A required tuple item cannot follow an optional one`,
+	ErrRuleWithoutContains:      `The %q rule can only be used together with the "contains" rule`,
 
 	// checker
 	ErrChecker:                               `Checker error`,
 	ErrElementNotFoundInArray:                `Element not found in schema array node`,
 	ErrIncorrectConstraintValueForEmptyArray: `Incorrect constraint value for empty array`,
+	ErrInvalidPropertyNamesType:              `The %q type in the "propertyNames" rule should be a string type`,
+	ErrUnknownKeyInDependentRequiredRule:     `The key %q in the "dependentRequired" rule isn't defined in the object`,
+	ErrSelfDependencyInDependentRequiredRule: `The key %q can't depend on itself in the "dependentRequired" rule`,
+	ErrUnknownKeyInWhenRule:                  `The key %q in the "when" rule isn't defined in the object`,
+	ErrInvalidConstInWhenRule:                `The value %s in the "when" rule isn't valid for the key %q`,
+	ErrDuplicateConditionInWhenRule:          `The "when" rule has several conditions for the key %q equal to %s`,
+	ErrInvalidWhenType:                       `The %q type in the "when" rule should be an object type`,
+	ErrInvalidDiscriminatorType:              `The %q type should be an object with the %q key with the "const" rule to be used with the "discriminator" rule`, //nolint:lll
+	ErrDuplicateDiscriminatorValue:           `The %q and %q types have the same value %s of the discriminator key %q`,
+	ErrOverlappingTypesInExclusiveOr:         `The %q and %q types of the exclusive "or" rule both accept the value %s`,
+	ErrNotRuleExcludesExample:                `The example value is excluded by the "not" rule`,
+	ErrUnsatisfiableNotRule:                  `The "not" rule excludes all values allowed by the node`,
+	ErrInvalidDefaultValue:                   `The default value %s isn't valid for the node`,
 
 	// link checker
 	ErrIncorrectUserType: "Incorrect type of user type",
@@ -281,6 +449,31 @@ var errorFormat = map[ErrorCode]string{
 	ErrEnumIsHoldRuleName: "Can't append specific value to enum initialized with rule name",
 	ErrEnumRuleNotFound:   "Enum rule %q not found",
 	ErrNotAnEnumRule:      "Rule %q not an Enum",
+
+	// yaml
+	ErrInvalidYAML:           "Invalid YAML: %s",
+	ErrYAMLMultipleDocuments: "YAML stream should contain only one document",
+	ErrYAMLUnsupportedTag:    "YAML tag %q is not supported, only JSON-compatible values are allowed",
+	ErrYAMLInvalidKey:        "YAML mapping key should be a string",
+	ErrYAMLInvalidNumber:     "YAML number %q can't be represented in JSON",
+	ErrYAMLMergeKey:          "YAML merge keys are not supported",
+	ErrYAMLRecursiveAlias:    "YAML alias refers to the node which contains it",
+	ErrYAMLTooManyAliases:    "YAML aliases expand to more than %s nodes",
+
+	// binary formats
+	ErrBinaryUnexpectedEOF:     "Unexpected end of %s data at offset %s",
+	ErrBinaryInvalidData:       "Invalid %s data at offset %s: %s",
+	ErrBinaryByteString:        "%s byte string at offset %s can't be represented in JSON",
+	ErrBinaryNonStringKey:      "%s map key at offset %s should be a string",
+	ErrBinaryUnsupportedValue:  "%s value at offset %s can't be represented in JSON: %s",
+	ErrBinaryTrailingData:      "Unexpected data after the end of %s value at offset %s",
+	ErrBinaryNonFiniteNumber:   "%s number at offset %s is infinite or NaN, which can't be represented in JSON",
+	ErrBinaryInvalidUTF8String: "%s string at offset %s is not a valid UTF-8 string",
+
+	// form
+	ErrFormInvalidEncoding: "Invalid percent-encoding in %q",
+	ErrFormInvalidKey:      "Invalid key %q, brackets should follow the name and be balanced",
+	ErrFormKeyConflict:     "The key %q is used both for a value and for nested keys",
 }
 
 func (c ErrorCode) Code() ErrorCode {
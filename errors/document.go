@@ -3,6 +3,7 @@ package errors
 import (
 	"fmt"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/jsightapi/jsight-schema-go-library/bytes"
 	"github.com/jsightapi/jsight-schema-go-library/fs"
@@ -19,6 +20,13 @@ type DocumentError struct {
 	incorrectUserType string
 	code              ErrorCode
 
+	// suggestion a correct alternative for a misspelled name, if any.
+	suggestion string
+
+	// path a JSON Pointer (RFC 6901) to the invalid value in the validated
+	// document.
+	path string
+
 	// index of the byte in which the error was found.
 	index bytes.Index
 
@@ -28,6 +36,9 @@ type DocumentError struct {
 	// hasIndex true if the value for Index have been defined.
 	hasIndex bool
 
+	// hasPath true if the value for Path have been defined.
+	hasPath bool
+
 	// prepared is true when preliminary calculations are made, the results of
 	// which are used in some methods.
 	prepared bool
@@ -42,11 +53,15 @@ var (
 )
 
 func NewDocumentError(file *fs.File, err Err) DocumentError {
-	return DocumentError{
+	e := DocumentError{
 		code:    err.Code(),
 		message: err.Error(),
 		file:    file,
 	}
+	if s, ok := err.(Suggester); ok {
+		e.suggestion = s.Suggestion()
+	}
+	return e
 }
 
 func (e DocumentError) Code() ErrorCode {
@@ -81,6 +96,24 @@ func (e *DocumentError) SetIndex(index bytes.Index) {
 	e.hasIndex = true
 }
 
+// HasIndex returns true if the index of the byte in which the error was found
+// is known.
+func (e DocumentError) HasIndex() bool {
+	return e.hasIndex
+}
+
+// Path returns a JSON Pointer (RFC 6901) to the invalid value in the validated
+// document. The second value is false if the path is unknown, e.g. for schema
+// errors.
+func (e DocumentError) Path() (string, bool) {
+	return e.path, e.hasPath
+}
+
+func (e *DocumentError) SetPath(path string) {
+	e.path = path
+	e.hasPath = true
+}
+
 func (e DocumentError) IncorrectUserType() string {
 	return e.incorrectUserType
 }
@@ -89,6 +122,21 @@ func (e *DocumentError) SetIncorrectUserType(s string) {
 	e.incorrectUserType = s
 }
 
+// Suggestion returns a correct alternative for a misspelled name, or an empty
+// string if there is no suggestion.
+func (e DocumentError) Suggestion() string {
+	return e.suggestion
+}
+
+func (e *DocumentError) SetSuggestion(s string) {
+	e.suggestion = s
+}
+
+// File returns a file in which the error was found.
+func (e DocumentError) File() *fs.File {
+	return e.file
+}
+
 func (e *DocumentError) SetFile(file *fs.File) {
 	e.file = file
 }
@@ -195,6 +243,23 @@ func (e *DocumentError) Line() uint {
 	return n + 1
 }
 
+// Column returns 0, if cannot determine the column number, or 1+ if it can.
+// Column is counted in characters, not bytes.
+func (e *DocumentError) Column() uint {
+	if e.file == nil || len(e.file.Content()) == 0 || !e.hasIndex {
+		return 0
+	}
+
+	e.preparation()
+
+	if e.index >= e.length {
+		return 0
+	}
+
+	begin := e.lineBeginning()
+	return uint(utf8.RuneCount(e.file.Content()[begin:e.index])) + 1
+}
+
 // SourceSubString returns empty string, if cannot determine the source sub-string.
 func (e *DocumentError) SourceSubString() string {
 	const maxLength = 200
@@ -198,6 +198,32 @@ func TestNewDocumentError_Line(t *testing.T) {
 	}
 }
 
+func TestDocumentError_Column(t *testing.T) {
+	cc := map[string]struct {
+		source   string
+		index    bytes.Index
+		expected uint
+	}{
+		"first character":  {"abc", 0, 1},
+		"middle character": {"abc", 1, 2},
+		"second line":      {"abc\ndef", 6, 3},
+		"multibyte":        {"\"€€\": 1", 8, 5},
+		"out of range":     {"abc", 3, 0},
+	}
+
+	for name, c := range cc {
+		t.Run(name, func(t *testing.T) {
+			e := newFakeDocumentError(fs.NewFile("", c.source), c.index)
+			assert.Equal(t, c.expected, e.Column())
+		})
+	}
+
+	t.Run("without index", func(t *testing.T) {
+		e := NewDocumentError(fs.NewFile("", "abc"), ErrEmptyJson)
+		assert.Equal(t, uint(0), e.Column())
+	})
+}
+
 func TestDocumentError_SourceSubString(t *testing.T) {
 	for _, d := range data {
 		for _, v := range d.valid {
@@ -6,8 +6,9 @@ import (
 )
 
 type Errorf struct { //nolint:errname // This is okay.
-	args []interface{}
-	code ErrorCode
+	args       []interface{}
+	suggestion string
+	code       ErrorCode
 }
 
 func Format(code ErrorCode, args ...interface{}) Errorf {
@@ -21,7 +22,23 @@ func (e Errorf) Code() ErrorCode {
 	return e.code
 }
 
+// WithSuggestion returns a copy of the error with the suggested alternative.
+// An empty suggestion is ignored.
+func (e Errorf) WithSuggestion(s string) Errorf {
+	e.suggestion = s
+	return e
+}
+
+// Suggestion returns the suggested alternative, if any.
+func (e Errorf) Suggestion() string {
+	return e.suggestion
+}
+
 func (e Errorf) Error() string {
+	return withSuggestion(e.message(), e.suggestion)
+}
+
+func (e Errorf) message() string {
 	if format, ok := errorFormat[e.code]; ok {
 		cnt := strings.Count(format, "%s")
 		cnt += strings.Count(format, "%q")
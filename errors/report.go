@@ -0,0 +1,309 @@
+package errors
+
+import (
+	"encoding/json"
+	stdErrors "errors"
+	"sort"
+	"strconv"
+)
+
+// ReportEntry a machine-readable representation of a single error.
+// Line and Column are zero when the position of the error is unknown.
+type ReportEntry struct {
+	File              string  `json:"file"`
+	Line              uint    `json:"line"`
+	Column            uint    `json:"column"`
+	Offset            uint    `json:"offset"`
+	Code              int     `json:"code"`
+	Message           string  `json:"message"`
+	IncorrectUserType string  `json:"incorrectUserType,omitempty"`
+	Path              *string `json:"path,omitempty"`
+}
+
+// Report a machine-readable representation of an error list.
+type Report struct {
+	Errors []ReportEntry `json:"errors"`
+}
+
+// NewReportEntry creates a report entry from the error.
+func NewReportEntry(err error) ReportEntry {
+	var de DocumentError
+	if stdErrors.As(err, &de) {
+		return newReportEntryFromDocumentError(de)
+	}
+
+	var e Error
+	if stdErrors.As(err, &e) {
+		return ReportEntry{
+			File:              e.Filename(),
+			Offset:            e.Position(),
+			Code:              e.ErrCode(),
+			Message:           e.Message(),
+			IncorrectUserType: e.IncorrectUserType(),
+		}
+	}
+
+	var m interface {
+		Message() string
+		ErrCode() int
+	}
+	if stdErrors.As(err, &m) {
+		entry := ReportEntry{
+			Code:    m.ErrCode(),
+			Message: m.Message(),
+		}
+		if p, ok := m.(interface{ Position() uint }); ok {
+			entry.Offset = p.Position()
+		}
+		return entry
+	}
+
+	var ee Err
+	if stdErrors.As(err, &ee) {
+		return ReportEntry{
+			Code:    int(ee.Code()),
+			Message: ee.Error(),
+		}
+	}
+
+	return ReportEntry{
+		Code:    int(ErrGeneric),
+		Message: err.Error(),
+	}
+}
+
+func newReportEntryFromDocumentError(e DocumentError) ReportEntry {
+	entry := ReportEntry{
+		File:              e.Filename(),
+		Offset:            e.Position(),
+		Code:              e.ErrCode(),
+		Message:           e.Message(),
+		IncorrectUserType: e.IncorrectUserType(),
+	}
+
+	if e.hasIndex {
+		entry.Line = e.Line()
+		entry.Column = e.Column()
+	}
+
+	if p, ok := e.Path(); ok {
+		entry.Path = &p
+	}
+	return entry
+}
+
+// NewReport creates a report from the list of errors. Aggregated errors which
+// implement the `Unwrap() []error` method are flattened. Nil errors are ignored.
+func NewReport(errs ...error) Report {
+	r := Report{
+		Errors: make([]ReportEntry, 0, len(errs)),
+	}
+	for _, err := range flattenErrors(errs) {
+		r.Errors = append(r.Errors, NewReportEntry(err))
+	}
+	return r
+}
+
+func flattenErrors(errs []error) []error {
+	res := make([]error, 0, len(errs))
+	for _, err := range errs {
+		if err == nil {
+			continue
+		}
+
+		if u, ok := err.(interface{ Unwrap() []error }); ok { //nolint:errorlint // We need only this level.
+			res = append(res, flattenErrors(u.Unwrap())...)
+			continue
+		}
+		res = append(res, err)
+	}
+	return res
+}
+
+// MarshalJSON returns a machine-readable representation of the error.
+func (e DocumentError) MarshalJSON() ([]byte, error) {
+	return json.Marshal(newReportEntryFromDocumentError(e))
+}
+
+// MarshalJSONReport returns a machine-readable representation of the errors
+// in the following format:
+//
+//	{"errors": [{"file": "...", "line": 1, "column": 2, ...}]}
+func MarshalJSONReport(errs ...error) ([]byte, error) {
+	return json.Marshal(NewReport(errs...))
+}
+
+const (
+	sarifVersion   = "2.1.0"
+	sarifSchema    = "https://json.schemastore.org/sarif-2.1.0.json"
+	sarifToolName  = "jsight-schema"
+	sarifToolURI   = "https://github.com/jsightapi/jsight-schema-go-library"
+	sarifLevel     = "error"
+	sarifRuleIDPfx = "JSIGHT"
+)
+
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID               string       `json:"id"`
+	ShortDescription sarifMessage `json:"shortDescription"`
+}
+
+type sarifResult struct {
+	RuleID     string           `json:"ruleId"`
+	RuleIndex  int              `json:"ruleIndex"`
+	Level      string           `json:"level"`
+	Message    sarifMessage     `json:"message"`
+	Locations  []sarifLocation  `json:"locations,omitempty"`
+	Properties *sarifProperties `json:"properties,omitempty"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation *sarifPhysicalLocation `json:"physicalLocation,omitempty"`
+	LogicalLocations []sarifLogicalLocation `json:"logicalLocations,omitempty"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           *sarifRegion          `json:"region,omitempty"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifRegion struct {
+	StartLine   uint `json:"startLine"`
+	StartColumn uint `json:"startColumn,omitempty"`
+	ByteOffset  uint `json:"byteOffset"`
+}
+
+type sarifLogicalLocation struct {
+	FullyQualifiedName string `json:"fullyQualifiedName"`
+	Kind               string `json:"kind"`
+}
+
+type sarifProperties struct {
+	Code              int     `json:"code"`
+	IncorrectUserType string  `json:"incorrectUserType,omitempty"`
+	DocumentPath      *string `json:"documentPath,omitempty"`
+}
+
+// MarshalSARIF returns a SARIF 2.1.0 log with the single run which contains
+// all passed errors as results.
+func MarshalSARIF(errs ...error) ([]byte, error) {
+	return json.Marshal(newSARIFLog(NewReport(errs...)))
+}
+
+func newSARIFLog(r Report) sarifLog {
+	codes := make([]int, 0, len(r.Errors))
+	seen := make(map[int]struct{}, len(r.Errors))
+	for _, e := range r.Errors {
+		if _, ok := seen[e.Code]; !ok {
+			seen[e.Code] = struct{}{}
+			codes = append(codes, e.Code)
+		}
+	}
+	sort.Ints(codes)
+
+	rules := make([]sarifRule, 0, len(codes))
+	ruleIndex := make(map[int]int, len(codes))
+	for i, c := range codes {
+		ruleIndex[c] = i
+		rules = append(rules, sarifRule{
+			ID:               sarifRuleID(c),
+			ShortDescription: sarifMessage{Text: errorFormat[ErrorCode(c)]},
+		})
+	}
+
+	results := make([]sarifResult, 0, len(r.Errors))
+	for _, e := range r.Errors {
+		results = append(results, sarifResult{
+			RuleID:    sarifRuleID(e.Code),
+			RuleIndex: ruleIndex[e.Code],
+			Level:     sarifLevel,
+			Message:   sarifMessage{Text: e.Message},
+			Locations: sarifLocations(e),
+			Properties: &sarifProperties{
+				Code:              e.Code,
+				IncorrectUserType: e.IncorrectUserType,
+				DocumentPath:      e.Path,
+			},
+		})
+	}
+
+	return sarifLog{
+		Schema:  sarifSchema,
+		Version: sarifVersion,
+		Runs: []sarifRun{
+			{
+				Tool: sarifTool{
+					Driver: sarifDriver{
+						Name:           sarifToolName,
+						InformationURI: sarifToolURI,
+						Rules:          rules,
+					},
+				},
+				Results: results,
+			},
+		},
+	}
+}
+
+func sarifRuleID(code int) string {
+	return sarifRuleIDPfx + strconv.Itoa(code)
+}
+
+func sarifLocations(e ReportEntry) []sarifLocation {
+	var l sarifLocation
+
+	if e.File != "" {
+		l.PhysicalLocation = &sarifPhysicalLocation{
+			ArtifactLocation: sarifArtifactLocation{URI: e.File},
+		}
+		if e.Line != 0 {
+			l.PhysicalLocation.Region = &sarifRegion{
+				StartLine:   e.Line,
+				StartColumn: e.Column,
+				ByteOffset:  e.Offset,
+			}
+		}
+	}
+
+	if e.Path != nil {
+		l.LogicalLocations = []sarifLogicalLocation{
+			{
+				FullyQualifiedName: *e.Path,
+				Kind:               "member",
+			},
+		}
+	}
+
+	if l.PhysicalLocation == nil && l.LogicalLocations == nil {
+		return nil
+	}
+	return []sarifLocation{l}
+}
@@ -0,0 +1,198 @@
+package errors
+
+import (
+	stdErrors "errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsightapi/jsight-schema-go-library/fs"
+)
+
+type fakeAggregateError []error
+
+func (e fakeAggregateError) Error() string   { return "aggregate" }
+func (e fakeAggregateError) Unwrap() []error { return e }
+
+func TestNewReportEntry(t *testing.T) {
+	path := "/foo/1"
+
+	documentError := func() DocumentError {
+		e := NewDocumentError(fs.NewFile("file", "{\n  \"foo\": 1\n}"), Format(ErrInvalidValueType, "integer", "string"))
+		e.SetIndex(11)
+		e.SetIncorrectUserType("@foo")
+		e.SetPath(path)
+		return e
+	}
+
+	cc := map[string]struct {
+		given    error
+		expected ReportEntry
+	}{
+		"document error": {
+			documentError(),
+			ReportEntry{
+				File:              "file",
+				Line:              2,
+				Column:            10,
+				Offset:            11,
+				Code:              int(ErrInvalidValueType),
+				Message:           `Invalid value type "integer", expected "string"`,
+				IncorrectUserType: "@foo",
+				Path:              &path,
+			},
+		},
+
+		"wrapped document error": {
+			fmt.Errorf("wrapped: %w", documentError()),
+			ReportEntry{
+				File:              "file",
+				Line:              2,
+				Column:            10,
+				Offset:            11,
+				Code:              int(ErrInvalidValueType),
+				Message:           `Invalid value type "integer", expected "string"`,
+				IncorrectUserType: "@foo",
+				Path:              &path,
+			},
+		},
+
+		"document error without index": {
+			NewDocumentError(fs.NewFile("file", "42"), ErrEmptyJson),
+			ReportEntry{
+				File:    "file",
+				Code:    int(ErrEmptyJson),
+				Message: ErrEmptyJson.Error(),
+			},
+		},
+
+		"error code": {
+			ErrEmptyJson,
+			ReportEntry{
+				Code:    int(ErrEmptyJson),
+				Message: ErrEmptyJson.Error(),
+			},
+		},
+
+		"generic error": {
+			stdErrors.New("fake error"),
+			ReportEntry{
+				Message: "fake error",
+			},
+		},
+	}
+
+	for name, c := range cc {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, c.expected, NewReportEntry(c.given))
+		})
+	}
+}
+
+func TestMarshalJSONReport(t *testing.T) {
+	e := NewDocumentError(fs.NewFile("file", "[1, 2]"), Format(ErrInvalidValueType, "integer", "string"))
+	e.SetIndex(4)
+	e.SetPath("/1")
+
+	b, err := MarshalJSONReport(e, nil, fakeAggregateError{ErrEmptyJson})
+	require.NoError(t, err)
+
+	assert.JSONEq(t, `{
+	"errors": [
+		{
+			"file": "file",
+			"line": 1,
+			"column": 5,
+			"offset": 4,
+			"code": 210,
+			"message": "Invalid value type \"integer\", expected \"string\"",
+			"path": "/1"
+		},
+		{
+			"file": "",
+			"line": 0,
+			"column": 0,
+			"offset": 0,
+			"code": 203,
+			"message": "Empty JSON"
+		}
+	]
+}`, string(b))
+}
+
+func TestDocumentError_MarshalJSON(t *testing.T) {
+	e := NewDocumentError(fs.NewFile("file", "[1, 2]"), ErrEmptyJson)
+
+	b, err := e.MarshalJSON()
+	require.NoError(t, err)
+
+	assert.JSONEq(t, `{
+	"file": "file",
+	"line": 0,
+	"column": 0,
+	"offset": 0,
+	"code": 203,
+	"message": "Empty JSON"
+}`, string(b))
+}
+
+func TestMarshalSARIF(t *testing.T) {
+	e := NewDocumentError(fs.NewFile("file", "[1, 2]"), ErrEmptyJson)
+	e.SetIndex(4)
+	e.SetPath("/1")
+	e.SetIncorrectUserType("@foo")
+
+	b, err := MarshalSARIF(e, ErrEmptyJson)
+	require.NoError(t, err)
+
+	assert.JSONEq(t, `{
+	"$schema": "https://json.schemastore.org/sarif-2.1.0.json",
+	"version": "2.1.0",
+	"runs": [
+		{
+			"tool": {
+				"driver": {
+					"name": "jsight-schema",
+					"informationUri": "https://github.com/jsightapi/jsight-schema-go-library",
+					"rules": [
+						{"id": "JSIGHT203", "shortDescription": {"text": "Empty JSON"}}
+					]
+				}
+			},
+			"results": [
+				{
+					"ruleId": "JSIGHT203",
+					"ruleIndex": 0,
+					"level": "error",
+					"message": {"text": "Empty JSON"},
+					"locations": [
+						{
+							"physicalLocation": {
+								"artifactLocation": {"uri": "file"},
+								"region": {"startLine": 1, "startColumn": 5, "byteOffset": 4}
+							},
+							"logicalLocations": [
+								{"fullyQualifiedName": "/1", "kind": "member"}
+							]
+						}
+					],
+					"properties": {
+						"code": 203,
+						"incorrectUserType": "@foo",
+						"documentPath": "/1"
+					}
+				},
+				{
+					"ruleId": "JSIGHT203",
+					"ruleIndex": 0,
+					"level": "error",
+					"message": {"text": "Empty JSON"},
+					"properties": {"code": 203}
+				}
+			]
+		}
+	]
+}`, string(b))
+}
@@ -0,0 +1,100 @@
+package errors
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// Suggester is implemented by errors which can propose a correct alternative
+// for a misspelled name.
+type Suggester interface {
+	Suggestion() string
+}
+
+// Suggest returns the candidate closest to the given name in terms of
+// Levenshtein distance, or an empty string if none of candidates is close enough.
+// The comparison is case-insensitive, an exact match is never suggested.
+func Suggest(name string, candidates []string) string {
+	if name == "" {
+		return ""
+	}
+
+	lowerName := strings.ToLower(name)
+	threshold := suggestionThreshold(name)
+
+	var (
+		best         string
+		bestDistance = threshold + 1
+	)
+
+	for _, c := range candidates {
+		if c == "" || c == name {
+			continue
+		}
+
+		d := levenshtein(lowerName, strings.ToLower(c))
+		if d < bestDistance || (d == bestDistance && c < best) {
+			best = c
+			bestDistance = d
+		}
+	}
+
+	if bestDistance > threshold {
+		return ""
+	}
+	return best
+}
+
+// suggestionThreshold returns the maximal distance for the suggestion.
+// Short names may differ by one character, longer ones by a third of their
+// length.
+func suggestionThreshold(name string) int {
+	n := utf8.RuneCountInString(name) / 3
+	if n < 1 {
+		return 1
+	}
+	return n
+}
+
+// levenshtein computes the edit distance between two strings in runes.
+func levenshtein(a, b string) int {
+	ra := []rune(a)
+	rb := []rune(b)
+
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = minInt(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+
+	return prev[len(rb)]
+}
+
+func minInt(v int, vv ...int) int {
+	for _, x := range vv {
+		if x < v {
+			v = x
+		}
+	}
+	return v
+}
+
+func withSuggestion(message, suggestion string) string {
+	if suggestion == "" {
+		return message
+	}
+	return message + ". Did you mean \"" + suggestion + "\"?"
+}
@@ -0,0 +1,78 @@
+package errors
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jsightapi/jsight-schema-go-library/fs"
+)
+
+func TestSuggest(t *testing.T) {
+	candidates := []string{"minLength", "maxLength", "min", "max", "optional", "@foo"}
+
+	cc := map[string]string{
+		"minLenght": "minLength",
+		"maxlength": "maxLength",
+		"mim":       "min",
+		"optinal":   "optional",
+		"@fo":       "@foo",
+		"min":       "",
+		"foo":       "@foo",
+		"mni":       "",
+		"invalid":   "",
+		"":          "",
+	}
+
+	for given, expected := range cc {
+		t.Run(given, func(t *testing.T) {
+			assert.Equal(t, expected, Suggest(given, candidates))
+		})
+	}
+
+	t.Run("no candidates", func(t *testing.T) {
+		assert.Equal(t, "", Suggest("foo", nil))
+	})
+}
+
+func TestLevenshtein(t *testing.T) {
+	cc := []struct {
+		a, b     string
+		expected int
+	}{
+		{"", "", 0},
+		{"abc", "", 3},
+		{"", "abc", 3},
+		{"abc", "abc", 0},
+		{"kitten", "sitting", 3},
+		{"flaw", "lawn", 2},
+		{"привет", "превед", 2},
+	}
+
+	for _, c := range cc {
+		t.Run(c.a+"-"+c.b, func(t *testing.T) {
+			assert.Equal(t, c.expected, levenshtein(c.a, c.b))
+		})
+	}
+}
+
+func TestErrorf_WithSuggestion(t *testing.T) {
+	t.Run("with suggestion", func(t *testing.T) {
+		e := Format(ErrUnknownRule, "minLenght").WithSuggestion("minLength")
+
+		assert.Equal(t, "minLength", e.Suggestion())
+		assert.EqualError(t, e, `Unknown rule "minLenght". Did you mean "minLength"?`)
+
+		de := NewDocumentError(fs.NewFile("", "foo"), e)
+		assert.Equal(t, "minLength", de.Suggestion())
+		assert.Equal(t, `Unknown rule "minLenght". Did you mean "minLength"?`, de.Message())
+	})
+
+	t.Run("without suggestion", func(t *testing.T) {
+		e := Format(ErrUnknownRule, "invalid").WithSuggestion("")
+
+		assert.Equal(t, "", e.Suggestion())
+		assert.EqualError(t, e, `Unknown rule "invalid"`)
+		assert.Equal(t, "", NewDocumentError(nil, e).Suggestion())
+	})
+}
@@ -0,0 +1,33 @@
+package cbor
+
+import (
+	jschema "github.com/jsightapi/jsight-schema-go-library"
+	"github.com/jsightapi/jsight-schema-go-library/formats/internal/converted"
+	"github.com/jsightapi/jsight-schema-go-library/fs"
+)
+
+// Document a CBOR (RFC 8949) document.
+//
+// The document is converted to the equivalent JSON which is used for producing
+// lexemes, so validation errors point to the JSON representation of the
+// document. Values which can't be represented in JSON, such as byte strings,
+// non-string map keys, `undefined` and non-finite floats, are reported as
+// errors. Bignums (tags 2 and 3) and decimal fractions (tag 4) are converted
+// to JSON numbers, other tags are ignored.
+type Document struct {
+	converted.Document
+}
+
+var _ jschema.Document = &Document{}
+
+// New creates a CBOR document with specified name and content.
+func New[T fs.FileContent](name string, content T, oo ...Option) *Document {
+	return FromFile(fs.NewFile(name, content), oo...)
+}
+
+// FromFile creates a CBOR document from file.
+func FromFile(f *fs.File, oo ...Option) *Document {
+	return &Document{
+		Document: converted.New(f, convert, converted.Limits(oo)),
+	}
+}
@@ -0,0 +1,198 @@
+package cbor
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsightapi/jsight-schema-go-library/fs"
+	"github.com/jsightapi/jsight-schema-go-library/internal/limits"
+)
+
+func TestConvert(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		cc := map[string]struct {
+			given    []byte
+			expected string
+		}{
+			"empty":                 {nil, ""},
+			"small uint":            {[]byte{0x0a}, "10"},
+			"uint8":                 {[]byte{0x18, 0x64}, "100"},
+			"uint16":                {[]byte{0x19, 0x03, 0xe8}, "1000"},
+			"uint64 max":            {[]byte{0x1b, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}, "18446744073709551615"},
+			"negative int":          {[]byte{0x38, 0x63}, "-100"},
+			"negative int64 min":    {[]byte{0x3b, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}, "-18446744073709551616"},
+			"false":                 {[]byte{0xf4}, "false"},
+			"true":                  {[]byte{0xf5}, "true"},
+			"null":                  {[]byte{0xf6}, "null"},
+			"float16":               {[]byte{0xf9, 0x3e, 0x00}, "1.5"},
+			"float16 integer":       {[]byte{0xf9, 0x3c, 0x00}, "1.0"},
+			"float16 subnormal":     {[]byte{0xf9, 0x00, 0x01}, "5.9604645e-08"},
+			"float32":               {[]byte{0xfa, 0x47, 0xc3, 0x50, 0x00}, "100000.0"},
+			"float64":               {[]byte{0xfb, 0x3f, 0xf1, 0x99, 0x99, 0x99, 0x99, 0x99, 0x9a}, "1.1"},
+			"text":                  {[]byte{0x63, 'f', 'o', 'o'}, `"foo"`},
+			"text with quotes":      {[]byte{0x63, '"', '<', '\n'}, `"\"<\n"`},
+			"indefinite text":       {[]byte{0x7f, 0x62, 'f', 'o', 0x61, 'o', 0xff}, `"foo"`},
+			"array":                 {[]byte{0x83, 0x01, 0x02, 0x03}, "[1,2,3]"},
+			"indefinite array":      {[]byte{0x9f, 0x01, 0x82, 0x02, 0x03, 0xff}, "[1,[2,3]]"},
+			"empty array":           {[]byte{0x80}, "[]"},
+			"map":                   {[]byte{0xa2, 0x61, 'a', 0x01, 0x61, 'b', 0x82, 0x02, 0x03}, `{"a":1,"b":[2,3]}`},
+			"indefinite map":        {[]byte{0xbf, 0x61, 'a', 0xf5, 0xff}, `{"a":true}`},
+			"positive bignum":       {[]byte{0xc2, 0x49, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00}, "18446744073709551616"},
+			"negative bignum":       {[]byte{0xc3, 0x49, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00}, "-18446744073709551617"},
+			"decimal fraction":      {[]byte{0xc4, 0x82, 0x21, 0x19, 0x6a, 0xb3}, "27315e-2"},
+			"tagged date-time text": {[]byte{0xc0, 0x64, '2', '0', '2', '2'}, `"2022"`},
+		}
+
+		for name, c := range cc {
+			t.Run(name, func(t *testing.T) {
+				actual, sm, err := convert(fs.NewFile("", c.given), limits.NewCounter(limits.Limits{}))
+				require.NoError(t, err)
+				assert.Nil(t, sm)
+				assert.Equal(t, c.expected, string(actual))
+			})
+		}
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		cc := map[string]struct {
+			given    []byte
+			expected string
+		}{
+			"unexpected EOF": {
+				[]byte{0x82, 0x01},
+				"ERROR (code 1800): Unexpected end of CBOR data at offset 2",
+			},
+			"text too long": {
+				[]byte{0x63, 'f'},
+				"ERROR (code 1800): Unexpected end of CBOR data at offset 2",
+			},
+			"reserved additional information": {
+				[]byte{0x1c},
+				"ERROR (code 1801): Invalid CBOR data at offset 0: reserved additional information",
+			},
+			"unexpected break": {
+				[]byte{0x81, 0xff},
+				"ERROR (code 1801): Invalid CBOR data at offset 1: unexpected break",
+			},
+			"byte string": {
+				[]byte{0xa1, 0x61, 'a', 0x42, 0x01, 0x02},
+				"ERROR (code 1802): CBOR byte string at offset 3 can't be represented in JSON",
+			},
+			"non-string key": {
+				[]byte{0xa1, 0x01, 0x02},
+				"ERROR (code 1803): CBOR map key at offset 1 should be a string",
+			},
+			"undefined": {
+				[]byte{0xf7},
+				"ERROR (code 1804): CBOR value at offset 0 can't be represented in JSON: undefined",
+			},
+			"simple value": {
+				[]byte{0xf0},
+				"ERROR (code 1804): CBOR value at offset 0 can't be represented in JSON: simple value 16",
+			},
+			"trailing data": {
+				[]byte{0x01, 0x02},
+				"ERROR (code 1805): Unexpected data after the end of CBOR value at offset 1",
+			},
+			"infinity": {
+				[]byte{0xf9, 0x7c, 0x00},
+				"ERROR (code 1806): CBOR number at offset 0 is infinite or NaN, which can't be represented in JSON",
+			},
+			"NaN": {
+				[]byte{0xfb, 0x7f, 0xf8, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00},
+				"ERROR (code 1806): CBOR number at offset 0 is infinite or NaN, which can't be represented in JSON",
+			},
+			"invalid UTF-8": {
+				[]byte{0x62, 0xc3, 0x28},
+				"ERROR (code 1807): CBOR string at offset 0 is not a valid UTF-8 string",
+			},
+		}
+
+		for name, c := range cc {
+			t.Run(name, func(t *testing.T) {
+				_, _, err := convert(fs.NewFile("file", c.given), limits.NewCounter(limits.Limits{}))
+				require.Error(t, err)
+				assert.Equal(t, c.expected+"\n\tin file file", err.Error())
+			})
+		}
+	})
+}
+
+func TestDocument_Check(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		require.NoError(t, New("", []byte{0xa1, 0x61, 'a', 0x01}).Check())
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		assert.EqualError(t, New("file", []byte{0x42, 0x01, 0x02}).Check(),
+			"ERROR (code 1802): CBOR byte string at offset 0 can't be represented in JSON\n\tin file file")
+	})
+}
+
+func TestDocument_limits(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		// {"a": [1, "bc"]}
+		data := []byte{0xa1, 0x61, 'a', 0x82, 0x01, 0x62, 'b', 'c'}
+		require.NoError(t, New("", data, MaxDepth(2), MaxStringLength(2), MaxArrayLength(2), MaxLexemes(18)).Check())
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		cc := map[string]struct {
+			given    []byte
+			option   Option
+			expected string
+		}{
+			"depth": {
+				// [[[]]], also checked for the indefinite-length array.
+				[]byte{0x81, 0x9f, 0x80, 0xff},
+				MaxDepth(2),
+				"ERROR (code 305): The nesting depth exceeds the limit of 2",
+			},
+			"string length": {
+				[]byte{0x63, 'a', 'b', 'c'},
+				MaxStringLength(2),
+				"ERROR (code 306): The string length exceeds the limit of 2 bytes",
+			},
+			"keys": {
+				// {"a": 1, "b": 2}
+				[]byte{0xa2, 0x61, 'a', 0x01, 0x61, 'b', 0x02},
+				MaxKeysPerObject(1),
+				"ERROR (code 307): The number of object keys exceeds the limit of 1",
+			},
+			"array length": {
+				// The array which declares 2^32-1 items, but contains only one.
+				[]byte{0x9a, 0xff, 0xff, 0xff, 0xff, 0x01},
+				MaxArrayLength(1),
+				"ERROR (code 308): The number of array items exceeds the limit of 1",
+			},
+			"lexemes": {
+				[]byte{0x82, 0x01, 0x02},
+				MaxLexemes(5),
+				"ERROR (code 309): The number of lexemes exceeds the limit of 5",
+			},
+		}
+
+		for name, c := range cc {
+			t.Run(name, func(t *testing.T) {
+				assert.EqualError(t, New("file", c.given, c.option).Check(), c.expected+"\n\tin file file")
+			})
+		}
+	})
+}
+
+func TestDocument_nesting(t *testing.T) {
+	t.Run("tags", func(t *testing.T) {
+		// 1 enclosed in 20 000 000 tags.
+		data := append(bytes.Repeat([]byte{0xc6}, 20_000_000), 0x01)
+		require.NoError(t, New("", data, MaxDepth(10), MaxLexemes(100)).Check())
+	})
+
+	t.Run("default depth", func(t *testing.T) {
+		data := append(bytes.Repeat([]byte{0x81}, 1_000_000), 0x01)
+		assert.EqualError(t, New("file", data).Check(),
+			"ERROR (code 305): The nesting depth exceeds the limit of 10000\n\tin file file")
+	})
+}
@@ -0,0 +1,331 @@
+package cbor
+
+import (
+	"math"
+	"math/big"
+	"strconv"
+	"unicode/utf8"
+
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+	"github.com/jsightapi/jsight-schema-go-library/errors"
+	"github.com/jsightapi/jsight-schema-go-library/formats/internal/converted"
+	"github.com/jsightapi/jsight-schema-go-library/fs"
+	"github.com/jsightapi/jsight-schema-go-library/internal/limits"
+	"github.com/jsightapi/jsight-schema-go-library/internal/sourcemap"
+)
+
+const (
+	majorUnsignedInt = 0
+	majorNegativeInt = 1
+	majorByteString  = 2
+	majorTextString  = 3
+	majorArray       = 4
+	majorMap         = 5
+	majorTag         = 6
+	majorSimple      = 7
+
+	infoIndefinite = 31
+
+	simpleFalse     = 20
+	simpleTrue      = 21
+	simpleNull      = 22
+	simpleUndefined = 23
+	simpleFloat16   = 25
+	simpleFloat32   = 26
+	simpleFloat64   = 27
+
+	tagPositiveBignum  = 2
+	tagNegativeBignum  = 3
+	tagDecimalFraction = 4
+	breakByte          = 0xff
+	formatName         = "CBOR"
+)
+
+// decoder converts the CBOR content to the equivalent JSON content.
+type decoder struct {
+	r *converted.BinaryReader
+	w converted.Writer
+
+	// counter checks the content against the limits of the document.
+	counter *limits.Counter
+}
+
+func convert(f *fs.File, counter *limits.Counter) (bytes.Bytes, *sourcemap.Map, error) {
+	d := decoder{
+		r:       converted.NewBinaryReader(formatName, f),
+		counter: counter,
+	}
+
+	err := d.r.Do(func() {
+		if d.r.EOF() {
+			return // Empty document.
+		}
+
+		d.item()
+
+		if !d.r.EOF() {
+			d.r.Fail(d.r.Pos(), errors.ErrBinaryTrailingData)
+		}
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return d.w.Bytes(), nil, nil
+}
+
+// head reads the initial byte and the argument of the data item.
+func (d *decoder) head() (major byte, info byte, arg uint64, offset int) {
+	offset = d.r.Pos()
+	ib := d.r.Byte()
+	major = ib >> 5
+	info = ib & 0x1f
+
+	switch {
+	case info < 24:
+		arg = uint64(info)
+	case info == 24:
+		arg = d.r.Uint(1)
+	case info == 25:
+		arg = d.r.Uint(2)
+	case info == 26:
+		arg = d.r.Uint(4)
+	case info == 27:
+		arg = d.r.Uint(8)
+	case info == infoIndefinite:
+		if major < majorByteString || major == majorTag {
+			d.r.Fail(offset, errors.ErrBinaryInvalidData, "unexpected indefinite length")
+		}
+	default:
+		d.r.Fail(offset, errors.ErrBinaryInvalidData, "reserved additional information")
+	}
+	return major, info, arg, offset
+}
+
+func (d *decoder) item() {
+	major, info, arg, offset := d.head()
+
+	// Other tags only add semantics to the enclosed data item, which is still
+	// representable in JSON. They are skipped in the loop, so long chains of
+	// tags can't exhaust the stack.
+	for major == majorTag && !isConvertedTag(arg) {
+		major, info, arg, offset = d.head()
+	}
+	d.r.Check(d.counter.Lexemes(2))
+
+	switch major {
+	case majorUnsignedInt:
+		d.w.Raw(strconv.FormatUint(arg, 10))
+
+	case majorNegativeInt:
+		d.w.Raw(negativeInt(arg).String())
+
+	case majorByteString:
+		d.r.Fail(offset, errors.ErrBinaryByteString)
+
+	case majorTextString:
+		d.w.String(d.text(info, arg, offset))
+
+	case majorArray:
+		d.array(info, arg)
+
+	case majorMap:
+		d.mapping(info, arg)
+
+	case majorTag:
+		d.tag(arg, offset)
+
+	case majorSimple:
+		d.simple(info, arg, offset)
+	}
+}
+
+func (d *decoder) array(info byte, n uint64) {
+	d.r.Check(d.counter.Enter())
+	d.w.Byte('[')
+	for i := uint64(0); d.hasNext(info, i, n); i++ {
+		d.r.Check(d.counter.Lexemes(2), d.counter.Item())
+		if i > 0 {
+			d.w.Byte(',')
+		}
+		d.item()
+	}
+	d.w.Byte(']')
+	d.counter.Leave()
+}
+
+func (d *decoder) mapping(info byte, n uint64) {
+	d.r.Check(d.counter.Enter())
+	d.w.Byte('{')
+	for i := uint64(0); d.hasNext(info, i, n); i++ {
+		d.r.Check(d.counter.Lexemes(4), d.counter.Key())
+		if i > 0 {
+			d.w.Byte(',')
+		}
+
+		major, keyInfo, arg, offset := d.head()
+		if major != majorTextString {
+			d.r.Fail(offset, errors.ErrBinaryNonStringKey)
+		}
+		d.w.String(d.text(keyInfo, arg, offset))
+		d.w.Byte(':')
+		d.item()
+	}
+	d.w.Byte('}')
+	d.counter.Leave()
+}
+
+// hasNext returns true if the array or the map has the next item. Consumes the
+// "break" stop code for indefinite-length items.
+func (d *decoder) hasNext(info byte, i, n uint64) bool {
+	if info != infoIndefinite {
+		return i < n
+	}
+
+	if d.r.Peek() == breakByte {
+		d.r.Byte()
+		return false
+	}
+	return true
+}
+
+func (d *decoder) text(info byte, n uint64, offset int) string {
+	var b []byte
+	if info == infoIndefinite {
+		for d.r.Peek() != breakByte {
+			major, chunkInfo, chunkLen, chunkOffset := d.head()
+			if major != majorTextString || chunkInfo == infoIndefinite {
+				d.r.Fail(chunkOffset, errors.ErrBinaryInvalidData, "invalid chunk of indefinite-length string")
+			}
+			b = append(b, d.r.Bytes(chunkLen)...)
+			d.r.Check(d.counter.String(uint(len(b))))
+		}
+		d.r.Byte()
+	} else {
+		d.r.Check(d.counter.String(uint(n)))
+		b = d.r.Bytes(n)
+	}
+
+	if !utf8.Valid(b) {
+		d.r.Fail(offset, errors.ErrBinaryInvalidUTF8String)
+	}
+	return string(b)
+}
+
+// isConvertedTag returns true if the tag and the enclosed data item are
+// converted to a single JSON value.
+func isConvertedTag(tag uint64) bool {
+	return tag == tagPositiveBignum || tag == tagNegativeBignum || tag == tagDecimalFraction
+}
+
+// tag converts the data item with one of the tags accepted by isConvertedTag.
+func (d *decoder) tag(tag uint64, offset int) {
+	switch tag {
+	case tagPositiveBignum, tagNegativeBignum:
+		d.w.Raw(d.bignum(tag, offset).String())
+
+	case tagDecimalFraction:
+		major, _, n, arrayOffset := d.head()
+		if major != majorArray || n != 2 {
+			d.r.Fail(arrayOffset, errors.ErrBinaryInvalidData, "decimal fraction should be an array of two integers")
+		}
+		exponent := d.integer()
+		mantissa := d.integer()
+		d.w.Raw(mantissa.String() + "e" + exponent.String())
+	}
+}
+
+// integer reads the integer data item, including bignums.
+func (d *decoder) integer() *big.Int {
+	major, _, arg, offset := d.head()
+	switch major {
+	case majorUnsignedInt:
+		return new(big.Int).SetUint64(arg)
+	case majorNegativeInt:
+		return negativeInt(arg)
+	case majorTag:
+		if arg == tagPositiveBignum || arg == tagNegativeBignum {
+			return d.bignum(arg, offset)
+		}
+	}
+	d.r.Fail(offset, errors.ErrBinaryInvalidData, "integer expected")
+	return nil
+}
+
+func (d *decoder) bignum(tag uint64, offset int) *big.Int {
+	major, info, n, _ := d.head()
+	if major != majorByteString || info == infoIndefinite {
+		d.r.Fail(offset, errors.ErrBinaryInvalidData, "bignum should be a byte string")
+	}
+
+	v := new(big.Int).SetBytes(d.r.Bytes(n))
+	if tag == tagNegativeBignum {
+		v.Neg(v).Sub(v, big.NewInt(1))
+	}
+	return v
+}
+
+func (d *decoder) simple(info byte, arg uint64, offset int) {
+	switch info {
+	case simpleFalse:
+		d.w.Raw("false")
+
+	case simpleTrue:
+		d.w.Raw("true")
+
+	case simpleNull:
+		d.w.Raw("null")
+
+	case simpleUndefined:
+		d.r.Fail(offset, errors.ErrBinaryUnsupportedValue, "undefined")
+
+	case simpleFloat16:
+		d.float(float64(float16(uint16(arg))), 32, offset)
+
+	case simpleFloat32:
+		d.float(float64(math.Float32frombits(uint32(arg))), 32, offset)
+
+	case simpleFloat64:
+		d.float(math.Float64frombits(arg), 64, offset)
+
+	case infoIndefinite:
+		d.r.Fail(offset, errors.ErrBinaryInvalidData, "unexpected break")
+
+	default:
+		d.r.Fail(offset, errors.ErrBinaryUnsupportedValue, "simple value "+strconv.FormatUint(arg, 10))
+	}
+}
+
+func (d *decoder) float(f float64, bitSize int, offset int) {
+	if !d.w.Float(f, bitSize) {
+		d.r.Fail(offset, errors.ErrBinaryNonFiniteNumber)
+	}
+}
+
+// negativeInt returns the value of the negative integer which is encoded as
+// -1 - n.
+func negativeInt(n uint64) *big.Int {
+	v := new(big.Int).SetUint64(n)
+	return v.Neg(v).Sub(v, big.NewInt(1))
+}
+
+// float16 converts IEEE 754 half-precision number to the float32.
+func float16(h uint16) float32 {
+	sign := uint32(h>>15) << 31
+	exp := uint32(h>>10) & 0x1f
+	frac := uint32(h) & 0x3ff
+
+	switch exp {
+	case 0:
+		// Zero or subnormal number.
+		f := float32(frac) / (1 << 24)
+		if sign != 0 {
+			return -f
+		}
+		return f
+
+	case 0x1f:
+		// Infinity or NaN.
+		return math.Float32frombits(sign | 0x7f800000 | frac<<13)
+	}
+	return math.Float32frombits(sign | (exp+112)<<23 | frac<<13)
+}
@@ -0,0 +1,362 @@
+package form
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+	"github.com/jsightapi/jsight-schema-go-library/errors"
+	"github.com/jsightapi/jsight-schema-go-library/formats/internal/converted"
+	"github.com/jsightapi/jsight-schema-go-library/fs"
+	"github.com/jsightapi/jsight-schema-go-library/internal/limits"
+	"github.com/jsightapi/jsight-schema-go-library/internal/sourcemap"
+)
+
+// maxKeySegments the maximum number of segments of the key, e.g. `a[b][]` has
+// three segments. It's applied even if no limits are set, so the huge key can't
+// produce too deep document.
+const maxKeySegments = limits.DefaultMaxDepth
+
+type nodeKind uint8
+
+const (
+	kindValue nodeKind = iota
+	kindArray
+	kindObject
+)
+
+// node a value of the form which is collected from one or several pairs.
+type node struct {
+	kind nodeKind
+
+	// at the position in the original content, which the node is mapped to.
+	at bytes.Index
+
+	// value the decoded value for the kindValue node.
+	value string
+
+	// items the items of the kindArray node.
+	items []*node
+
+	// keys the keys of the kindObject node in the order of appearance.
+	keys []string
+
+	// children the values of the kindObject node by keys.
+	children map[string]*node
+
+	// keysAt the positions in the original content, which the keys of the
+	// kindObject node are mapped to.
+	keysAt map[string]bytes.Index
+}
+
+func newObject(at bytes.Index) *node {
+	return &node{
+		kind:     kindObject,
+		at:       at,
+		children: map[string]*node{},
+		keysAt:   map[string]bytes.Index{},
+	}
+}
+
+// set sets the child of the object. The key is mapped to the specified
+// position when it's added for the first time.
+func (n *node) set(key string, child *node, at bytes.Index) {
+	if _, ok := n.children[key]; !ok {
+		n.keys = append(n.keys, key)
+		n.keysAt[key] = at
+	}
+	n.children[key] = child
+}
+
+// converter converts the form content to the equivalent JSON content.
+type converter struct {
+	file *fs.File
+
+	root *node
+
+	// counter checks the content against the limits of the document.
+	counter *limits.Counter
+
+	buf       converted.Writer
+	sourceMap sourcemap.Map
+}
+
+func convert(f *fs.File, counter *limits.Counter) (bytes.Bytes, *sourcemap.Map, error) {
+	c := converter{
+		file:    f,
+		root:    newObject(0),
+		counter: counter,
+	}
+
+	if err := c.parse(); err != nil {
+		return nil, nil, err
+	}
+
+	if err := c.write(c.root); err != nil {
+		return nil, nil, err
+	}
+	return c.buf.Bytes(), &c.sourceMap, nil
+}
+
+// parse collects pairs of the content into the root object.
+func (c *converter) parse() error {
+	content := string(c.file.Content())
+
+	var offset int
+	if strings.HasPrefix(content, "?") {
+		offset = 1
+	}
+
+	for offset <= len(content) {
+		part := content[offset:]
+		if i := strings.IndexByte(part, '&'); i >= 0 {
+			part = part[:i]
+		}
+
+		if part != "" {
+			if err := c.pair(part, bytes.Index(offset)); err != nil {
+				return err
+			}
+		}
+		offset += len(part) + 1
+	}
+	return nil
+}
+
+// pair adds the `key=value` pair which begins at specified position.
+func (c *converter) pair(part string, at bytes.Index) error {
+	rawKey, rawValue, hasValue := strings.Cut(part, "=")
+
+	valueAt := at
+	if hasValue {
+		valueAt += bytes.Index(len(rawKey) + 1)
+	}
+
+	key, err := url.QueryUnescape(rawKey)
+	if err != nil {
+		return c.error(at, errors.Format(errors.ErrFormInvalidEncoding, rawKey))
+	}
+
+	value, err := url.QueryUnescape(rawValue)
+	if err != nil {
+		return c.error(valueAt, errors.Format(errors.ErrFormInvalidEncoding, rawValue))
+	}
+
+	// Each segment of the key is a nesting level, so the depth is checked
+	// before the key is split.
+	depth := strings.Count(key, "[") + 1
+	if err := c.limit(at, c.counter.Depth(uint(depth))); err != nil {
+		return err
+	}
+	if depth > maxKeySegments {
+		return c.error(at, errors.Format(errors.ErrDepthLimitExceeded, strconv.Itoa(maxKeySegments)))
+	}
+
+	path, ok := splitKey(key)
+	if !ok {
+		return c.error(at, errors.Format(errors.ErrFormInvalidKey, key))
+	}
+
+	for _, name := range path {
+		if err := c.limit(at, c.counter.String(uint(len(name)))); err != nil {
+			return err
+		}
+	}
+	if err := c.limit(valueAt, c.counter.String(uint(len(value)))); err != nil {
+		return err
+	}
+
+	v := &node{
+		kind:  kindValue,
+		at:    valueAt,
+		value: value,
+	}
+	return c.insert(key, path, v, at)
+}
+
+// splitKey splits the key with brackets into the path, e.g. `a[b][]` into
+// `a`, `b` and an empty string, which means a new array item.
+func splitKey(key string) ([]string, bool) {
+	i := strings.IndexByte(key, '[')
+	if i < 0 {
+		return []string{key}, key != "" && !strings.Contains(key, "]")
+	}
+
+	name, rest := key[:i], key[i:]
+	if name == "" || strings.Contains(name, "]") {
+		return nil, false
+	}
+
+	path := []string{name}
+	for rest != "" {
+		j := strings.IndexByte(rest, ']')
+		if rest[0] != '[' || j < 0 || strings.Contains(rest[1:j], "[") {
+			return nil, false
+		}
+		path = append(path, rest[1:j])
+		rest = rest[j+1:]
+	}
+	return path, true
+}
+
+// insert adds the value to the root object by the path.
+func (c *converter) insert(key string, path []string, v *node, at bytes.Index) error {
+	obj := c.root
+	for {
+		name := path[0]
+		if name == "" {
+			return c.error(at, errors.Format(errors.ErrFormInvalidKey, key))
+		}
+		child := obj.children[name]
+
+		switch {
+		case len(path) == 1:
+			switch {
+			case child == nil:
+				return c.set(obj, name, v, at)
+			case child.kind == kindValue:
+				arr := &node{kind: kindArray, at: child.at, items: []*node{child}}
+				obj.set(name, arr, at)
+				return c.append(arr, v)
+			case child.kind == kindArray:
+				return c.append(child, v)
+			}
+			return c.error(at, errors.Format(errors.ErrFormKeyConflict, key))
+
+		case path[1] == "":
+			switch {
+			case child == nil:
+				child = &node{kind: kindArray, at: at}
+				if err := c.set(obj, name, child, at); err != nil {
+					return err
+				}
+			case child.kind == kindValue:
+				child = &node{kind: kindArray, at: child.at, items: []*node{child}}
+				obj.set(name, child, at)
+			case child.kind == kindObject:
+				return c.error(at, errors.Format(errors.ErrFormKeyConflict, key))
+			}
+
+			if len(path) == 2 {
+				return c.append(child, v)
+			}
+
+			item := newObject(at)
+			if err := c.append(child, item); err != nil {
+				return err
+			}
+			obj, path = item, path[2:]
+
+		default:
+			switch {
+			case child == nil:
+				child = newObject(at)
+				if err := c.set(obj, name, child, at); err != nil {
+					return err
+				}
+			case child.kind != kindObject:
+				return c.error(at, errors.Format(errors.ErrFormKeyConflict, key))
+			}
+			obj, path = child, path[1:]
+		}
+	}
+}
+
+// set sets the child of the object and checks the number of its keys.
+func (c *converter) set(obj *node, key string, child *node, at bytes.Index) error {
+	obj.set(key, child, at)
+	return c.limit(at, c.counter.Keys(uint(len(obj.keys))))
+}
+
+// append adds the item to the array and checks the number of its items.
+func (c *converter) append(arr, item *node) error {
+	arr.items = append(arr.items, item)
+	return c.limit(item.at, c.counter.Items(uint(len(arr.items))))
+}
+
+// write writes the JSON equivalent of the node.
+func (c *converter) write(n *node) error {
+	if err := c.limit(n.at, c.counter.Lexemes(2)); err != nil {
+		return err
+	}
+
+	c.mark(n.at)
+
+	switch n.kind {
+	case kindValue:
+		if err := c.limit(n.at, c.counter.String(uint(len(n.value)))); err != nil {
+			return err
+		}
+		c.buf.String(n.value)
+
+	case kindArray:
+		if err := c.limit(n.at, c.counter.Enter()); err != nil {
+			return err
+		}
+		c.buf.Byte('[')
+		for i, item := range n.items {
+			if err := c.limit(item.at, c.counter.Lexemes(2), c.counter.Item()); err != nil {
+				return err
+			}
+			if i > 0 {
+				c.buf.Byte(',')
+			}
+			if err := c.write(item); err != nil {
+				return err
+			}
+		}
+		c.mark(n.at)
+		c.buf.Byte(']')
+		c.counter.Leave()
+
+	case kindObject:
+		if err := c.limit(n.at, c.counter.Enter()); err != nil {
+			return err
+		}
+		c.buf.Byte('{')
+		for i, k := range n.keys {
+			child, keyAt := n.children[k], n.keysAt[k]
+			err := c.limit(keyAt, c.counter.Lexemes(4), c.counter.Key(), c.counter.String(uint(len(k))))
+			if err != nil {
+				return err
+			}
+			if i > 0 {
+				c.buf.Byte(',')
+			}
+			c.mark(keyAt)
+			c.buf.String(k)
+			c.buf.Byte(':')
+			if err := c.write(child); err != nil {
+				return err
+			}
+		}
+		c.mark(n.at)
+		c.buf.Byte('}')
+		c.counter.Leave()
+	}
+	return nil
+}
+
+// mark binds the current position in the JSON content with the position in
+// the form content.
+func (c *converter) mark(at bytes.Index) {
+	c.sourceMap.Add(c.buf.Len(), at, false)
+}
+
+// limit returns the error of the first exceeded limit, if any, which is found at
+// the specified position.
+func (c *converter) limit(at bytes.Index, errs ...errors.Err) error {
+	for _, err := range errs {
+		if err != nil {
+			return c.error(at, err)
+		}
+	}
+	return nil
+}
+
+func (c *converter) error(at bytes.Index, err errors.Err) error {
+	e := errors.NewDocumentError(c.file, err)
+	e.SetIndex(at)
+	return e
+}
@@ -0,0 +1,38 @@
+package form
+
+import (
+	jschema "github.com/jsightapi/jsight-schema-go-library"
+	"github.com/jsightapi/jsight-schema-go-library/formats/internal/converted"
+	"github.com/jsightapi/jsight-schema-go-library/fs"
+)
+
+// Document an URL query string or an `application/x-www-form-urlencoded`
+// content, e.g. `page=1&tags=a&tags=b&filter[active]=true`. The leading `?` is
+// ignored.
+//
+// The document is converted to the equivalent JSON object. All values are
+// strings, use the coercion mode of the schema for validating them as numbers
+// or booleans. Keys are converted as follows:
+//   - a repeated key becomes an array of values: `a=1&a=2` is `{"a": ["1", "2"]}`;
+//   - a key with brackets becomes a nested object: `a[b]=1` is `{"a": {"b": "1"}}`;
+//   - empty brackets append a new array item: `a[]=1` is `{"a": ["1"]}`, and
+//     `a[][b]=1&a[][b]=2` is `{"a": [{"b": "1"}, {"b": "2"}]}`.
+//
+// Positions of errors are mapped back to the original content.
+type Document struct {
+	converted.Document
+}
+
+var _ jschema.Document = &Document{}
+
+// New creates a form document with specified name and content.
+func New[T fs.FileContent](name string, content T, oo ...Option) *Document {
+	return FromFile(fs.NewFile(name, content), oo...)
+}
+
+// FromFile creates a form document from file.
+func FromFile(f *fs.File, oo ...Option) *Document {
+	return &Document{
+		Document: converted.New(f, convert, converted.Limits(oo)),
+	}
+}
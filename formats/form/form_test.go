@@ -0,0 +1,208 @@
+package form
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsightapi/jsight-schema-go-library/errors"
+	"github.com/jsightapi/jsight-schema-go-library/fs"
+	"github.com/jsightapi/jsight-schema-go-library/internal/limits"
+)
+
+func TestConvert(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		cc := map[string]string{
+			"":                            "{}",
+			"?":                           "{}",
+			"a=1":                         `{"a":"1"}`,
+			"?a=1&b=foo":                  `{"a":"1","b":"foo"}`,
+			"a":                           `{"a":""}`,
+			"a=&&b=":                      `{"a":"","b":""}`,
+			"a=hello+world%21":            `{"a":"hello world!"}`,
+			"a%5Bb%5D=1":                  `{"a":{"b":"1"}}`,
+			"q=%22%5C":                    `{"q":"\"\\"}`,
+			"a=1&a=2&a=3":                 `{"a":["1","2","3"]}`,
+			"a[]=1":                       `{"a":["1"]}`,
+			"a=1&a[]=2":                   `{"a":["1","2"]}`,
+			"a[]=1&a=2":                   `{"a":["1","2"]}`,
+			"a[b]=1&a[c]=2":               `{"a":{"b":"1","c":"2"}}`,
+			"a[b][c]=1&d=2":               `{"a":{"b":{"c":"1"}},"d":"2"}`,
+			"a[b]=1&a[b]=2":               `{"a":{"b":["1","2"]}}`,
+			"a[][b]=1&a[][b]=2":           `{"a":[{"b":"1"},{"b":"2"}]}`,
+			"a[][b]=1&a[][c]=2&x=y&a[]=3": `{"a":[{"b":"1"},{"c":"2"},"3"],"x":"y"}`,
+			"b=2&a=1&b=3":                 `{"b":["2","3"],"a":"1"}`,
+		}
+
+		for given, expected := range cc {
+			t.Run(given, func(t *testing.T) {
+				actual, _, err := convert(fs.NewFile("", given), limits.NewCounter(limits.Limits{}))
+				require.NoError(t, err)
+				assert.Equal(t, expected, string(actual))
+			})
+		}
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		cc := map[string]string{
+			"a=1&b=%zz": `ERROR (code 1900): Invalid percent-encoding in "%zz"
+	in line 1 on file 
+	> a=1&b=%zz
+	--------^`,
+
+			"a=1&%=2": `ERROR (code 1900): Invalid percent-encoding in "%"
+	in line 1 on file 
+	> a=1&%=2
+	------^`,
+
+			"=1": `ERROR (code 1901): Invalid key "", brackets should follow the name and be balanced
+	in line 1 on file 
+	> =1
+	--^`,
+
+			"a=1&[b]=2": `ERROR (code 1901): Invalid key "[b]", brackets should follow the name and be balanced
+	in line 1 on file 
+	> a=1&[b]=2
+	------^`,
+
+			"a[b=1": `ERROR (code 1901): Invalid key "a[b", brackets should follow the name and be balanced
+	in line 1 on file 
+	> a[b=1
+	--^`,
+
+			"a[b]c=1": `ERROR (code 1901): Invalid key "a[b]c", brackets should follow the name and be balanced
+	in line 1 on file 
+	> a[b]c=1
+	--^`,
+
+			"a[][]=1": `ERROR (code 1901): Invalid key "a[][]", brackets should follow the name and be balanced
+	in line 1 on file 
+	> a[][]=1
+	--^`,
+
+			"a=1&a[b]=2": `ERROR (code 1902): The key "a[b]" is used both for a value and for nested keys
+	in line 1 on file 
+	> a=1&a[b]=2
+	------^`,
+
+			"a[b]=1&a=2": `ERROR (code 1902): The key "a" is used both for a value and for nested keys
+	in line 1 on file 
+	> a[b]=1&a=2
+	---------^`,
+
+			"a[b]=1&a[]=2": `ERROR (code 1902): The key "a[]" is used both for a value and for nested keys
+	in line 1 on file 
+	> a[b]=1&a[]=2
+	---------^`,
+		}
+
+		for given, expected := range cc {
+			t.Run(given, func(t *testing.T) {
+				_, _, err := convert(fs.NewFile("", given), limits.NewCounter(limits.Limits{}))
+				assert.EqualError(t, err, expected)
+			})
+		}
+	})
+}
+
+func TestDocument_Check(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		require.NoError(t, New("", "a=1&b[]=2").Check())
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		assert.EqualError(t, New("", "a[b]=1&a[b][c]=2").Check(), `ERROR (code 1902): The key "a[b][c]" is used both for a value and for nested keys
+	in line 1 on file 
+	> a[b]=1&a[b][c]=2
+	---------^`)
+	})
+}
+
+func TestDocument_limits(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		err := New("", "a=1&a=2&b[c]=foo", MaxDepth(2), MaxStringLength(3), MaxKeysPerObject(2), MaxArrayLength(2)).Check()
+		require.NoError(t, err)
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		cc := map[string]struct {
+			data     string
+			option   Option
+			expected string
+		}{
+			"depth": {
+				"a[b][c]=1",
+				MaxDepth(2),
+				`ERROR (code 305): The nesting depth exceeds the limit of 2
+	in line 1 on file 
+	> a[b][c]=1
+	--^`,
+			},
+			"string length": {
+				"a=1&b=fizz",
+				MaxStringLength(3),
+				`ERROR (code 306): The string length exceeds the limit of 3 bytes
+	in line 1 on file 
+	> a=1&b=fizz
+	--------^`,
+			},
+			"keys per object": {
+				"a=1&b=2&c[d]=3",
+				MaxKeysPerObject(2),
+				`ERROR (code 307): The number of object keys exceeds the limit of 2
+	in line 1 on file 
+	> a=1&b=2&c[d]=3
+	----------^`,
+			},
+			"array length": {
+				"a=1&a=2&a=3",
+				MaxArrayLength(2),
+				`ERROR (code 308): The number of array items exceeds the limit of 2
+	in line 1 on file 
+	> a=1&a=2&a=3
+	------------^`,
+			},
+		}
+
+		for name, c := range cc {
+			t.Run(name, func(t *testing.T) {
+				assert.EqualError(t, New("", c.data, c.option).Check(), c.expected)
+			})
+		}
+	})
+}
+
+func TestDocument_nesting(t *testing.T) {
+	key := "a" + strings.Repeat("[b]", 8_000_000)
+
+	cc := map[string]struct {
+		convert  func() error
+		expected string
+	}{
+		"max depth": {
+			func() error {
+				return New("", key+"=1", MaxDepth(5)).Check()
+			},
+			"The nesting depth exceeds the limit of 5",
+		},
+		"without limits": {
+			func() error {
+				_, _, err := convert(fs.NewFile("", key+"=1"), limits.NewCounter(limits.Limits{}))
+				return err
+			},
+			"The nesting depth exceeds the limit of 10000",
+		},
+	}
+
+	for name, c := range cc {
+		t.Run(name, func(t *testing.T) {
+			var e errors.DocumentError
+			require.ErrorAs(t, c.convert(), &e)
+			assert.Equal(t, errors.ErrDepthLimitExceeded, e.Code())
+			assert.Equal(t, c.expected, e.Message())
+			assert.Equal(t, 0, int(e.Index()))
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package form
+
+import (
+	"github.com/jsightapi/jsight-schema-go-library/formats/internal/converted"
+)
+
+// Option sets the limit of the form document, see converted.Option.
+type Option = converted.Option
+
+// MaxDepth limits the nesting depth of objects and arrays. The root object or
+// array has the depth of 1.
+func MaxDepth(n uint) Option {
+	return converted.MaxDepth(n)
+}
+
+// MaxStringLength limits the length of strings and object keys in bytes.
+func MaxStringLength(n uint) Option {
+	return converted.MaxStringLength(n)
+}
+
+// MaxKeysPerObject limits the number of keys of each object.
+func MaxKeysPerObject(n uint) Option {
+	return converted.MaxKeysPerObject(n)
+}
+
+// MaxArrayLength limits the number of items of each array.
+func MaxArrayLength(n uint) Option {
+	return converted.MaxArrayLength(n)
+}
+
+// MaxLexemes limits the total number of lexemes of the equivalent JSON
+// document.
+func MaxLexemes(n uint) Option {
+	return converted.MaxLexemes(n)
+}
@@ -0,0 +1,117 @@
+package converted
+
+import (
+	"encoding/binary"
+	"strconv"
+
+	"github.com/jsightapi/jsight-schema-go-library/errors"
+	"github.com/jsightapi/jsight-schema-go-library/fs"
+)
+
+// BinaryReader reads the content of binary formats.
+// All methods panic with the document error if something went wrong, use
+// BinaryReader.Do for catching these errors.
+type BinaryReader struct {
+	file   *fs.File
+	data   []byte
+	format string
+	pos    int
+}
+
+// NewBinaryReader creates a reader for the file. The format name is used in
+// error messages.
+func NewBinaryReader(format string, f *fs.File) *BinaryReader {
+	return &BinaryReader{
+		file:   f,
+		data:   f.Content(),
+		format: format,
+	}
+}
+
+// Do calls the function and converts the panic with the document error into
+// the returned error.
+func (r *BinaryReader) Do(fn func()) (err error) {
+	defer func() {
+		rec := recover()
+		if rec == nil {
+			return
+		}
+
+		e, ok := rec.(errors.DocumentError)
+		if !ok {
+			panic(rec)
+		}
+		err = e
+	}()
+
+	fn()
+	return nil
+}
+
+// Pos returns the offset of the next byte.
+func (r *BinaryReader) Pos() int {
+	return r.pos
+}
+
+// EOF returns true if all bytes were read.
+func (r *BinaryReader) EOF() bool {
+	return r.pos >= len(r.data)
+}
+
+// Peek returns the next byte without moving forward.
+func (r *BinaryReader) Peek() byte {
+	if r.EOF() {
+		r.Fail(r.pos, errors.ErrBinaryUnexpectedEOF)
+	}
+	return r.data[r.pos]
+}
+
+// Byte reads the next byte.
+func (r *BinaryReader) Byte() byte {
+	c := r.Peek()
+	r.pos++
+	return c
+}
+
+// Bytes reads n next bytes.
+func (r *BinaryReader) Bytes(n uint64) []byte {
+	if n > uint64(len(r.data)-r.pos) {
+		r.Fail(len(r.data), errors.ErrBinaryUnexpectedEOF)
+	}
+	b := r.data[r.pos : r.pos+int(n)]
+	r.pos += int(n)
+	return b
+}
+
+// Uint reads the big-endian unsigned integer of specified size in bytes.
+// Size should be 1, 2, 4 or 8.
+func (r *BinaryReader) Uint(size int) uint64 {
+	b := r.Bytes(uint64(size))
+	switch size {
+	case 1:
+		return uint64(b[0])
+	case 2:
+		return uint64(binary.BigEndian.Uint16(b))
+	case 4:
+		return uint64(binary.BigEndian.Uint32(b))
+	case 8:
+		return binary.BigEndian.Uint64(b)
+	}
+	panic(errors.ErrImpossible)
+}
+
+// Fail panics with the error which is found at specified offset. The format
+// name and the offset are the first two arguments of the error message.
+func (r *BinaryReader) Fail(offset int, code errors.ErrorCode, args ...interface{}) {
+	args = append([]interface{}{r.format, strconv.Itoa(offset)}, args...)
+	panic(errors.NewDocumentError(r.file, errors.Format(code, args...)))
+}
+
+// Check panics with the first found error of the exceeded limit, if any.
+func (r *BinaryReader) Check(errs ...errors.Err) {
+	for _, err := range errs {
+		if err != nil {
+			panic(errors.NewDocumentError(r.file, err))
+		}
+	}
+}
@@ -0,0 +1,130 @@
+// Package converted contains the common implementation of documents which are
+// converted to JSON before scanning.
+package converted
+
+import (
+	stdErrors "errors"
+
+	jschema "github.com/jsightapi/jsight-schema-go-library"
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+	"github.com/jsightapi/jsight-schema-go-library/errors"
+	"github.com/jsightapi/jsight-schema-go-library/formats/json"
+	"github.com/jsightapi/jsight-schema-go-library/fs"
+	"github.com/jsightapi/jsight-schema-go-library/internal/lexeme"
+	"github.com/jsightapi/jsight-schema-go-library/internal/limits"
+	"github.com/jsightapi/jsight-schema-go-library/internal/sourcemap"
+	"github.com/jsightapi/jsight-schema-go-library/internal/sync"
+)
+
+// ConvertFunc converts the file content to the equivalent JSON content.
+// The counter should be used for checking the content against the limits while
+// it's decoded.
+// The returned source map is used for mapping positions of errors back to the
+// original content. Might be nil if positions can't be mapped, e.g. for binary
+// formats. In this case errors will point to the JSON content.
+type ConvertFunc func(*fs.File, *limits.Counter) (bytes.Bytes, *sourcemap.Map, error)
+
+// Document a document which is converted to the equivalent JSON document for
+// producing lexemes.
+type Document struct {
+	file    *fs.File
+	convert ConvertFunc
+
+	// limits the safeguards against too large documents, which are checked
+	// during conversion.
+	limits limits.Limits
+
+	// json the equivalent JSON document.
+	json jschema.Document
+
+	// jsonFile the file with the equivalent JSON content.
+	jsonFile *fs.File
+
+	// sourceMap maps positions in the JSON content to the original content.
+	sourceMap *sourcemap.Map
+
+	convertOnce sync.ErrOnce
+}
+
+var _ jschema.Document = &Document{}
+
+// New creates a document which will be converted by specified function with
+// specified limits.
+func New(f *fs.File, fn ConvertFunc, l limits.Limits) Document {
+	return Document{
+		file:    f,
+		convert: fn,
+		limits:  l,
+	}
+}
+
+// SetLimits adds the limits which are checked during conversion. The stricter
+// value of each limit is used. Has no effect if the document is already
+// converted, i.e. after the first call of other methods.
+func (d *Document) SetLimits(l limits.Limits) {
+	d.limits = d.limits.Stricter(l)
+}
+
+func (d *Document) NextLexeme() (lexeme.LexEvent, error) {
+	if err := d.doConvert(); err != nil {
+		return lexeme.LexEvent{}, err
+	}
+
+	lex, err := d.json.NextLexeme()
+	return lex, d.MapError(err)
+}
+
+func (d *Document) Len() (uint, error) {
+	if err := d.doConvert(); err != nil {
+		return 0, err
+	}
+
+	if _, err := d.json.Len(); err != nil {
+		return 0, d.MapError(err)
+	}
+
+	// The length of the equivalent JSON content is meaningless for the original
+	// document, and the document always takes the whole file.
+	return uint(len(d.file.Content())), nil
+}
+
+func (d *Document) Check() error {
+	if err := d.doConvert(); err != nil {
+		return err
+	}
+	return d.MapError(d.json.Check())
+}
+
+// MapError maps positions of the error which was found in the equivalent JSON
+// content back to the original content. Other errors are returned as is.
+func (d *Document) MapError(err error) error {
+	if err == nil || d.jsonFile == nil || d.sourceMap == nil {
+		return err
+	}
+
+	var e errors.DocumentError
+	if !stdErrors.As(err, &e) || e.File() != d.jsonFile {
+		return err
+	}
+
+	e.SetFile(d.file)
+	if e.HasIndex() {
+		e.SetIndex(d.sourceMap.Find(e.Index()))
+	}
+	return e
+}
+
+func (d *Document) doConvert() error {
+	return d.convertOnce.Do(func() error {
+		l := d.limits.Stricter(limits.Limits{MaxDepth: limits.DefaultMaxDepth})
+		content, sm, err := d.convert(d.file, limits.NewCounter(l))
+		if err != nil {
+			return err
+		}
+
+		d.jsonFile = fs.NewFile(d.file.Name(), content)
+		d.sourceMap = sm
+		d.json = json.FromFile(d.jsonFile)
+		return nil
+	})
+}
@@ -0,0 +1,63 @@
+package converted
+
+import (
+	"github.com/jsightapi/jsight-schema-go-library/internal/limits"
+)
+
+// Option sets the limit of the converted document. Limits are checked while the
+// content is converted to JSON, and the conversion stops at the first exceeded
+// limit:
+//   - YAML content is parsed into nodes by gopkg.in/yaml.v3 first, then limits
+//     are checked while nodes are converted;
+//   - CBOR and MessagePack data items are checked as soon as they are read;
+//   - form pairs are checked while they are parsed, except the number of
+//     lexemes, which is checked while the JSON content is written.
+//
+// The nesting depth is limited by limits.DefaultMaxDepth even if no limit is
+// set.
+type Option func(l *limits.Limits)
+
+// Limits returns the limits which are set by options.
+func Limits(oo []Option) limits.Limits {
+	var l limits.Limits
+	for _, o := range oo {
+		o(&l)
+	}
+	return l
+}
+
+// MaxDepth limits the nesting depth of objects and arrays.
+func MaxDepth(n uint) Option {
+	return func(l *limits.Limits) {
+		l.MaxDepth = n
+	}
+}
+
+// MaxStringLength limits the length of strings and object keys in bytes.
+func MaxStringLength(n uint) Option {
+	return func(l *limits.Limits) {
+		l.MaxStringLength = n
+	}
+}
+
+// MaxKeysPerObject limits the number of keys of each object.
+func MaxKeysPerObject(n uint) Option {
+	return func(l *limits.Limits) {
+		l.MaxKeysPerObject = n
+	}
+}
+
+// MaxArrayLength limits the number of items of each array.
+func MaxArrayLength(n uint) Option {
+	return func(l *limits.Limits) {
+		l.MaxArrayLength = n
+	}
+}
+
+// MaxLexemes limits the total number of lexemes of the equivalent JSON
+// document.
+func MaxLexemes(n uint) Option {
+	return func(l *limits.Limits) {
+		l.MaxLexemes = n
+	}
+}
@@ -0,0 +1,61 @@
+package converted
+
+import (
+	stdBytes "bytes"
+	stdJson "encoding/json"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+)
+
+// Writer builds the JSON content.
+type Writer struct {
+	buf stdBytes.Buffer
+}
+
+// Len returns the length of the written content.
+func (w *Writer) Len() bytes.Index {
+	return bytes.Index(w.buf.Len())
+}
+
+// Bytes returns the written content.
+func (w *Writer) Bytes() bytes.Bytes {
+	return w.buf.Bytes()
+}
+
+// Raw writes the string as is.
+func (w *Writer) Raw(s string) {
+	w.buf.WriteString(s)
+}
+
+// Byte writes the byte as is.
+func (w *Writer) Byte(c byte) {
+	w.buf.WriteByte(c)
+}
+
+// String writes the JSON string.
+func (w *Writer) String(s string) {
+	var b stdBytes.Buffer
+	enc := stdJson.NewEncoder(&b)
+	enc.SetEscapeHTML(false)
+	_ = enc.Encode(s) // Encoding of the string can't fail.
+	w.buf.Write(stdBytes.TrimSuffix(b.Bytes(), []byte{'\n'}))
+}
+
+// Float writes the JSON number which always looks like a float, e.g. `1.0`
+// instead of `1`. Returns false if the number is infinite or NaN, because JSON
+// can't represent such values.
+func (w *Writer) Float(f float64, bitSize int) bool {
+	if math.IsInf(f, 0) || math.IsNaN(f) {
+		return false
+	}
+
+	v := strconv.FormatFloat(f, 'g', -1, bitSize)
+	if !strings.ContainsAny(v, ".e") {
+		v += ".0"
+	}
+	w.buf.WriteString(v)
+	return true
+}
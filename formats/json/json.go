@@ -5,9 +5,12 @@ import (
 	"io"
 
 	jschema "github.com/jsightapi/jsight-schema-go-library"
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
 	"github.com/jsightapi/jsight-schema-go-library/errors"
 	"github.com/jsightapi/jsight-schema-go-library/fs"
 	"github.com/jsightapi/jsight-schema-go-library/internal/lexeme"
+	"github.com/jsightapi/jsight-schema-go-library/internal/limits"
+	"github.com/jsightapi/jsight-schema-go-library/internal/sourcemap"
 	"github.com/jsightapi/jsight-schema-go-library/internal/sync"
 )
 
@@ -15,9 +18,28 @@ type Document struct {
 	file    *fs.File
 	scanner *scanner
 
+	// relaxedFile a file with the strict JSON content which is produced from
+	// the JSONC or JSON5 content. Nil if relaxed options aren't used.
+	relaxedFile *fs.File
+
+	// sourceMap maps positions in the relaxedFile to the original file.
+	sourceMap sourcemap.Map
+
+	// relaxErr the error of the limit which is exceeded while the JSONC or
+	// JSON5 content is converted.
+	relaxErr error
+
 	lenOnce   sync.ErrOnceWithValue[uint]
 	checkOnce sync.ErrOnce
 
+	relaxed relaxedOptions
+
+	// limits the safeguards against too large documents.
+	limits limits.Limits
+
+	// guard checks found lexemes against the limits. Nil if no limit is set.
+	guard *limits.Guard
+
 	allowTrailingNonSpaceCharacters bool
 }
 
@@ -38,6 +60,16 @@ func FromFile(f *fs.File, oo ...Option) jschema.Document {
 		o(d)
 	}
 
+	if d.relaxed != (relaxedOptions{}) {
+		// Limits are checked while the content is converted, so too large
+		// documents fail before the whole content is copied.
+		l := d.limits.Stricter(limits.Limits{MaxDepth: limits.DefaultMaxDepth})
+		content, sm, err := relax(f, d.relaxed, limits.NewCounter(l))
+		d.relaxedFile = fs.NewFile(f.Name(), content)
+		d.sourceMap = sm
+		d.relaxErr = err
+	}
+
 	d.rewind()
 
 	return d
@@ -51,6 +83,109 @@ func AllowTrailingNonSpaceCharacters() Option {
 	}
 }
 
+// AllowComments allows `//` and `/* */` comments.
+func AllowComments() Option {
+	return func(s *Document) {
+		s.relaxed.comments = true
+	}
+}
+
+// AllowTrailingCommas allows a comma after the last item of an object or an
+// array.
+func AllowTrailingCommas() Option {
+	return func(s *Document) {
+		s.relaxed.trailingCommas = true
+	}
+}
+
+// AllowSingleQuotedStrings allows JSON5 single-quoted strings.
+func AllowSingleQuotedStrings() Option {
+	return func(s *Document) {
+		s.relaxed.singleQuotes = true
+	}
+}
+
+// AllowUnquotedKeys allows JSON5 object keys which are ECMAScript identifiers
+// without quotes.
+func AllowUnquotedKeys() Option {
+	return func(s *Document) {
+		s.relaxed.unquotedKeys = true
+	}
+}
+
+// AllowHexNumbers allows JSON5 hexadecimal integers, such as `0x1F`. They are
+// validated as regular integers.
+func AllowHexNumbers() Option {
+	return func(s *Document) {
+		s.relaxed.hexNumbers = true
+	}
+}
+
+// AllowInfinityAndNaN allows JSON5 `Infinity`, `-Infinity` and `NaN` literals.
+// Such values can't be represented in JSON, so they are valid only for the
+// "any" type.
+func AllowInfinityAndNaN() Option {
+	return func(s *Document) {
+		s.relaxed.nonFiniteNumbers = true
+	}
+}
+
+// MaxDepth limits the nesting depth of objects and arrays. The root object or
+// array has the depth of 1.
+func MaxDepth(n uint) Option {
+	return func(s *Document) {
+		s.limits.MaxDepth = n
+	}
+}
+
+// MaxStringLength limits the length of strings and object keys in bytes, as
+// written in the document without quotes.
+func MaxStringLength(n uint) Option {
+	return func(s *Document) {
+		s.limits.MaxStringLength = n
+	}
+}
+
+// MaxKeysPerObject limits the number of keys of each object.
+func MaxKeysPerObject(n uint) Option {
+	return func(s *Document) {
+		s.limits.MaxKeysPerObject = n
+	}
+}
+
+// MaxArrayLength limits the number of items of each array.
+func MaxArrayLength(n uint) Option {
+	return func(s *Document) {
+		s.limits.MaxArrayLength = n
+	}
+}
+
+// MaxLexemes limits the total number of lexemes of the document.
+func MaxLexemes(n uint) Option {
+	return func(s *Document) {
+		s.limits.MaxLexemes = n
+	}
+}
+
+// JSONC allows comments and trailing commas.
+func JSONC() Option {
+	return func(s *Document) {
+		AllowComments()(s)
+		AllowTrailingCommas()(s)
+	}
+}
+
+// JSON5 allows all supported JSON5 extensions.
+func JSON5() Option {
+	return func(s *Document) {
+		JSONC()(s)
+		AllowSingleQuotedStrings()(s)
+		AllowUnquotedKeys()(s)
+		AllowHexNumbers()(s)
+		AllowInfinityAndNaN()(s)
+	}
+}
+
 func (d *Document) NextLexeme() (lexeme.LexEvent, error) {
 	return d.nextLexeme()
 }
@@ -79,7 +214,15 @@ func (d *Document) computeLen() (length uint, err error) {
 		err = rErr
 	}()
 
-	return d.scanner.Length(), err
+	if d.relaxErr != nil {
+		return 0, d.relaxErr
+	}
+
+	length = d.scanner.Length()
+	if d.relaxedFile != nil && length != 0 {
+		length = uint(d.sourceMap.Find(bytes.Index(length-1))) + 1
+	}
+	return length, err
 }
 
 func (d *Document) Check() error {
@@ -124,9 +267,13 @@ func (d *Document) nextLexeme() (lex lexeme.LexEvent, err error) {
 		if !ok {
 			panic(r)
 		}
-		err = rErr
+		err = d.MapError(rErr)
 	}()
 
+	if d.relaxErr != nil {
+		return lexeme.LexEvent{}, d.relaxErr
+	}
+
 	lex, ok := d.scanner.Next()
 	if !ok {
 		return lexeme.LexEvent{}, io.EOF
@@ -135,11 +282,43 @@ func (d *Document) nextLexeme() (lex lexeme.LexEvent, err error) {
 	if lex.Type() == lexeme.EndTop {
 		return lex, io.EOF
 	}
+
+	if d.guard != nil {
+		d.guard.Check(lex) // can panic
+	}
 	return lex, nil
 }
 
+// MapError maps positions of the error which was found in the strict JSON
+// content produced from the JSONC or JSON5 one back to the original content.
+// Other errors are returned as is.
+func (d *Document) MapError(err error) error {
+	if err == nil || d.relaxedFile == nil {
+		return err
+	}
+
+	var e errors.DocumentError
+	if !stdErrors.As(err, &e) || e.File() != d.relaxedFile {
+		return err
+	}
+
+	e.SetFile(d.file)
+	if e.HasIndex() {
+		e.SetIndex(d.sourceMap.Find(e.Index()))
+	}
+	return e
+}
+
 // rewind rewinds document to the beginning.
 func (d *Document) rewind() {
-	d.scanner = newScanner(d.file)
+	f := d.file
+	if d.relaxedFile != nil {
+		f = d.relaxedFile
+	}
+	d.scanner = newScanner(f)
 	d.scanner.allowTrailingNonSpaceCharacters = d.allowTrailingNonSpaceCharacters
+	d.scanner.allowNonFiniteNumbers = d.relaxed.nonFiniteNumbers
+	if !d.limits.IsZero() {
+		d.guard = limits.NewGuard(d.limits)
+	}
 }
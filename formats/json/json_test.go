@@ -1,12 +1,13 @@
 package json
 
 import (
-	"errors"
+	stdErrors "errors"
 	"io"
 	"path/filepath"
 	"strings"
 	"testing"
 
+	"github.com/jsightapi/jsight-schema-go-library/errors"
 	"github.com/jsightapi/jsight-schema-go-library/reader"
 	"github.com/jsightapi/jsight-schema-go-library/test"
 
@@ -24,7 +25,7 @@ func BenchmarkDocument_NextLexeme(b *testing.B) {
 		s := FromFile(file, AllowTrailingNonSpaceCharacters())
 		for {
 			_, err := s.NextLexeme()
-			if errors.Is(err, io.EOF) {
+			if stdErrors.Is(err, io.EOF) {
 				break
 			}
 			require.NoError(b, err)
@@ -144,6 +145,112 @@ some trailing data`,
 	})
 }
 
+func TestDocument_relaxed(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		cc := map[string]struct {
+			data        string
+			opts        []Option
+			expectedLen uint
+		}{
+			"JSONC": {
+				data: `// comment
+{
+	"foo": [1, 2,], /* comment */
+}`,
+				opts:        []Option{JSONC()},
+				expectedLen: 45,
+			},
+
+			"JSON5": {
+				data:        `{foo: 'bar', hex: 0x1F, inf: -Infinity, nan: NaN,}`,
+				opts:        []Option{JSON5()},
+				expectedLen: 50,
+			},
+
+			"with trailing data": {
+				data: `[1, 2,] // comment
+some trailing data`,
+				opts:        []Option{JSONC(), AllowTrailingNonSpaceCharacters()},
+				expectedLen: 7,
+			},
+		}
+
+		for name, c := range cc {
+			t.Run(name, func(t *testing.T) {
+				d := New("", c.data, c.opts...)
+				require.NoError(t, d.Check())
+
+				l, err := d.Len()
+				require.NoError(t, err)
+				assert.Equal(t, c.expectedLen, l)
+			})
+		}
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		cc := map[string]struct {
+			data     string
+			opts     []Option
+			expected string
+		}{
+			"comments are not allowed": {
+				data: `[1, 2,]`,
+				opts: []Option{AllowComments()},
+				expected: `ERROR (code 301): Invalid character "]" looking for beginning of value
+	in line 1 on file 
+	> [1, 2,]
+	--------^`,
+			},
+
+			"trailing commas are not allowed": {
+				data: `{
+	// comment
+	"foo": 1
+}`,
+				opts: []Option{AllowTrailingCommas()},
+				expected: `ERROR (code 301): Invalid character "/" looking for beginning of string
+	in line 2 on file 
+	> // comment
+	--^`,
+			},
+
+			"non-finite numbers are not allowed": {
+				data: `{foo: NaN}`,
+				opts: []Option{AllowUnquotedKeys()},
+				expected: `ERROR (code 301): Invalid character "N" looking for beginning of value
+	in line 1 on file 
+	> {foo: NaN}
+	--------^`,
+			},
+
+			"invalid non-finite number": {
+				data: `[1, Infinit]`,
+				opts: []Option{JSON5()},
+				expected: `ERROR (code 301): Invalid character "]" in literal (expecting 'y')
+	in line 1 on file 
+	> [1, Infinit]
+	-------------^`,
+			},
+
+			"error after comment": {
+				data: `/* comment */ [1, 'foo', 'bar]`,
+				opts: []Option{JSON5()},
+				expected: `ERROR (code 301): Invalid character "'" looking for beginning of value
+	in line 1 on file 
+	> /* comment */ [1, 'foo', 'bar]
+	---------------------------^`,
+			},
+		}
+
+		for name, c := range cc {
+			t.Run(name, func(t *testing.T) {
+				err := New("", c.data, c.opts...).Check()
+				assert.EqualError(t, err, c.expected)
+			})
+		}
+	})
+}
+
 func BenchmarkDocument_Check(b *testing.B) {
 	file := reader.Read(filepath.Join(test.GetProjectRoot(), "testdata", "big.json"))
 
@@ -390,3 +497,142 @@ some trailing data`, 11},
 with trailing data
 `, 18},
 }
+
+func TestDocument_limits(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		cc := map[string]Option{
+			"depth":         MaxDepth(2),
+			"string length": MaxStringLength(3),
+			"keys":          MaxKeysPerObject(2),
+			"array length":  MaxArrayLength(3),
+			"lexemes":       MaxLexemes(26),
+		}
+
+		for name, o := range cc {
+			t.Run(name, func(t *testing.T) {
+				err := New("", `{"foo": [1, 2, 3], "bar": "baz"}`, o).Check()
+				require.NoError(t, err)
+			})
+		}
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		cc := map[string]struct {
+			data     string
+			option   Option
+			expected string
+		}{
+			"depth": {
+				`{"foo": [[1]]}`,
+				MaxDepth(2),
+				`ERROR (code 305): The nesting depth exceeds the limit of 2
+	in line 1 on file 
+	> {"foo": [[1]]}
+	-----------^`,
+			},
+			"string length": {
+				`["foo", "fizz"]`,
+				MaxStringLength(3),
+				`ERROR (code 306): The string length exceeds the limit of 3 bytes
+	in line 1 on file 
+	> ["foo", "fizz"]
+	----------^`,
+			},
+			"key length": {
+				`{"fizz": 1}`,
+				MaxStringLength(3),
+				`ERROR (code 306): The string length exceeds the limit of 3 bytes
+	in line 1 on file 
+	> {"fizz": 1}
+	---^`,
+			},
+			"keys": {
+				`{"a": 1, "b": {"c": 3}, "d": 4}`,
+				MaxKeysPerObject(2),
+				`ERROR (code 307): The number of object keys exceeds the limit of 2
+	in line 1 on file 
+	> {"a": 1, "b": {"c": 3}, "d": 4}
+	--------------------------^`,
+			},
+			"array length": {
+				`[1, [2], 3]`,
+				MaxArrayLength(2),
+				`ERROR (code 308): The number of array items exceeds the limit of 2
+	in line 1 on file 
+	> [1, [2], 3]
+	-----------^`,
+			},
+			"lexemes": {
+				`[1, 2]`,
+				MaxLexemes(5),
+				`ERROR (code 309): The number of lexemes exceeds the limit of 5
+	in line 1 on file 
+	> [1, 2]
+	------^`,
+			},
+		}
+
+		for name, c := range cc {
+			t.Run(name, func(t *testing.T) {
+				err := New("", c.data, c.option).Check()
+				assert.EqualError(t, err, c.expected)
+			})
+		}
+	})
+	t.Run("relaxed", func(t *testing.T) {
+		cc := map[string]struct {
+			data     string
+			option   Option
+			expected string
+		}{
+			"depth": {
+				`{foo: [[1]]}`,
+				MaxDepth(2),
+				`ERROR (code 305): The nesting depth exceeds the limit of 2
+	in line 1 on file 
+	> {foo: [[1]]}
+	---------^`,
+			},
+			"string length": {
+				`['foo', 'fizz']`,
+				MaxStringLength(3),
+				`ERROR (code 306): The string length exceeds the limit of 3 bytes
+	in line 1 on file 
+	> ['foo', 'fizz']
+	----------^`,
+			},
+			"key length": {
+				`{fizz: 1}`,
+				MaxStringLength(3),
+				`ERROR (code 306): The string length exceeds the limit of 3 bytes
+	in line 1 on file 
+	> {fizz: 1}
+	---^`,
+			},
+			"lexemes": {
+				`[1, 2,]`,
+				MaxLexemes(5),
+				`ERROR (code 309): The number of lexemes exceeds the limit of 5
+	in line 1 on file 
+	> [1, 2,]
+	---^`,
+			},
+		}
+
+		for name, c := range cc {
+			t.Run(name, func(t *testing.T) {
+				err := New("", c.data, JSON5(), c.option).Check()
+				assert.EqualError(t, err, c.expected)
+			})
+		}
+	})
+
+	t.Run("relaxed default depth", func(t *testing.T) {
+		_, err := New("", strings.Repeat("[", 10_000_000), JSON5()).Len()
+
+		var e errors.DocumentError
+		require.ErrorAs(t, err, &e)
+		assert.Equal(t, errors.ErrDepthLimitExceeded, e.Code())
+		assert.Equal(t, "The nesting depth exceeds the limit of 10000", e.Message())
+	})
+}
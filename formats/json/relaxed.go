@@ -0,0 +1,425 @@
+package json
+
+import (
+	"math/big"
+	"strings"
+
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+	"github.com/jsightapi/jsight-schema-go-library/errors"
+	"github.com/jsightapi/jsight-schema-go-library/fs"
+	"github.com/jsightapi/jsight-schema-go-library/internal/limits"
+	"github.com/jsightapi/jsight-schema-go-library/internal/sourcemap"
+)
+
+// relaxedOptions a set of allowed JSONC and JSON5 extensions.
+type relaxedOptions struct {
+	comments         bool
+	trailingCommas   bool
+	singleQuotes     bool
+	unquotedKeys     bool
+	hexNumbers       bool
+	nonFiniteNumbers bool
+}
+
+// relaxer converts JSONC or JSON5 content to the strict JSON content.
+//
+// The relaxer doesn't report errors by itself. When it finds something which it
+// can't convert, it copies the rest of the content as is, so the strict scanner
+// will report the error. The source map allows to report positions in the
+// original content.
+type relaxer struct {
+	data bytes.Bytes
+	out  []byte
+	i    int
+
+	// counter checks the content against the limits while it's converted.
+	counter *limits.Counter
+
+	// err the error of the exceeded limit, which is found at the errAt
+	// position. Conversion stops when it's found.
+	err   errors.Err
+	errAt int
+
+	// comma an index of the last found comma between items.
+	comma int
+
+	sourceMap sourcemap.Map
+
+	opts relaxedOptions
+}
+
+// relax converts the content of the file. Returns the document error if some
+// limit is exceeded.
+func relax(f *fs.File, opts relaxedOptions, counter *limits.Counter) (bytes.Bytes, sourcemap.Map, error) {
+	data := f.Content()
+	r := relaxer{
+		data:    data,
+		out:     make([]byte, 0, len(data)),
+		opts:    opts,
+		counter: counter,
+	}
+
+	r.skipSpaces()
+	ok := r.value()
+	if r.err != nil {
+		e := errors.NewDocumentError(f, r.err)
+		e.SetIndex(bytes.Index(r.errAt))
+		return nil, sourcemap.Map{}, e
+	}
+
+	if ok {
+		begin := r.i
+		r.skipSpaces()
+		if r.i != begin && !r.eof() {
+			// Keep the separator between the value and trailing characters.
+			r.emit(r.i-1, []byte{' '})
+		}
+	}
+	r.copyRest()
+
+	return r.out, r.sourceMap, nil
+}
+
+// value converts a single value. Returns false if the value can't be converted.
+func (r *relaxer) value() bool {
+	if r.eof() || !r.check(r.i, r.counter.Lexemes(2)) {
+		return false
+	}
+
+	switch c := r.data[r.i]; {
+	case c == '{':
+		return r.object()
+
+	case c == '[':
+		return r.array()
+
+	case c == '"':
+		return r.doubleQuotedString()
+
+	case c == '\'' && r.opts.singleQuotes:
+		return r.singleQuotedString()
+
+	case c == '-' || c == '+' || c == '.' || bytes.IsDigit(c) || isIdentifierStart(c):
+		return r.word()
+	}
+	return false
+}
+
+func (r *relaxer) object() bool {
+	if !r.check(r.i, r.counter.Enter()) {
+		return false
+	}
+	defer r.counter.Leave()
+
+	r.emit(r.i, r.data[r.i:r.i+1])
+	r.i++
+
+	for first := true; ; first = false {
+		r.skipSpaces()
+		if r.eof() {
+			return false
+		}
+
+		if r.data[r.i] == '}' && (first || r.opts.trailingCommas) {
+			r.emit(r.i, r.data[r.i:r.i+1])
+			r.i++
+			return true
+		}
+
+		if !first {
+			r.emit(r.comma, r.data[r.comma:r.comma+1])
+		}
+
+		if !r.check(r.i, r.counter.Lexemes(4), r.counter.Key()) || !r.key() {
+			return false
+		}
+
+		r.skipSpaces()
+		if r.eof() || r.data[r.i] != ':' {
+			return false
+		}
+		r.emit(r.i, r.data[r.i:r.i+1])
+		r.i++
+
+		r.skipSpaces()
+		if !r.value() {
+			return false
+		}
+
+		closed, ok := r.afterItem('}')
+		if !ok {
+			return false
+		}
+		if closed {
+			r.emit(r.i, r.data[r.i:r.i+1])
+			r.i++
+			return true
+		}
+	}
+}
+
+func (r *relaxer) array() bool {
+	if !r.check(r.i, r.counter.Enter()) {
+		return false
+	}
+	defer r.counter.Leave()
+
+	r.emit(r.i, r.data[r.i:r.i+1])
+	r.i++
+
+	for first := true; ; first = false {
+		r.skipSpaces()
+		if r.eof() {
+			return false
+		}
+
+		if r.data[r.i] == ']' && (first || r.opts.trailingCommas) {
+			r.emit(r.i, r.data[r.i:r.i+1])
+			r.i++
+			return true
+		}
+
+		if !first {
+			r.emit(r.comma, r.data[r.comma:r.comma+1])
+		}
+
+		if !r.check(r.i, r.counter.Lexemes(2), r.counter.Item()) || !r.value() {
+			return false
+		}
+
+		closed, ok := r.afterItem(']')
+		if !ok {
+			return false
+		}
+		if closed {
+			r.emit(r.i, r.data[r.i:r.i+1])
+			r.i++
+			return true
+		}
+	}
+}
+
+// afterItem skips spaces and the comma after the item of the object or the
+// array. The first returned value is true if the closing character is found,
+// the second one is false if neither the comma nor the closing character found.
+// The comma itself will be written before the next item, so trailing commas are
+// dropped.
+func (r *relaxer) afterItem(closing byte) (closed, ok bool) {
+	r.skipSpaces()
+	if r.eof() {
+		return false, false
+	}
+
+	switch r.data[r.i] {
+	case closing:
+		return true, true
+	case ',':
+		r.comma = r.i
+		r.i++
+		return false, true
+	}
+	return false, false
+}
+
+func (r *relaxer) key() bool {
+	switch c := r.data[r.i]; {
+	case c == '"':
+		return r.doubleQuotedString()
+
+	case c == '\'' && r.opts.singleQuotes:
+		return r.singleQuotedString()
+
+	case isIdentifierStart(c) && r.opts.unquotedKeys:
+		begin := r.i
+		for !r.eof() && isIdentifierPart(r.data[r.i]) {
+			r.i++
+		}
+		if !r.check(begin, r.counter.String(uint(r.i-begin))) {
+			return false
+		}
+		r.sourceMap.Add(bytes.Index(len(r.out)), bytes.Index(begin), false)
+		r.out = append(r.out, '"')
+		r.out = append(r.out, r.data[begin:r.i]...)
+		r.out = append(r.out, '"')
+		return true
+	}
+	return false
+}
+
+func (r *relaxer) doubleQuotedString() bool {
+	begin := r.i
+	r.i++
+	for !r.eof() {
+		switch r.data[r.i] {
+		case '\\':
+			r.i += 2
+			continue
+		case '"':
+			r.i++
+			if !r.check(begin, r.counter.String(uint(r.i-begin-2))) {
+				return false
+			}
+			r.emit(begin, r.data[begin:r.i])
+			return true
+		}
+		r.i++
+	}
+	r.i = begin
+	return false
+}
+
+// singleQuotedString converts the JSON5 single-quoted string to the double-quoted
+// one.
+func (r *relaxer) singleQuotedString() bool {
+	begin := r.i
+	b := make([]byte, 0, 16)
+	b = append(b, '"')
+
+	for r.i++; !r.eof(); r.i++ {
+		switch c := r.data[r.i]; c {
+		case '\\':
+			if r.i+1 >= len(r.data) {
+				r.i = begin
+				return false
+			}
+			r.i++
+			if r.data[r.i] == '\'' {
+				b = append(b, '\'')
+			} else {
+				b = append(b, '\\', r.data[r.i])
+			}
+
+		case '"':
+			b = append(b, '\\', '"')
+
+		case '\'':
+			r.i++
+			if !r.check(begin, r.counter.String(uint(len(b)-1))) {
+				return false
+			}
+			r.sourceMap.Add(bytes.Index(len(r.out)), bytes.Index(begin), false)
+			r.out = append(r.out, b...)
+			r.out = append(r.out, '"')
+			return true
+
+		default:
+			b = append(b, c)
+		}
+	}
+	r.i = begin
+	return false
+}
+
+// word converts numbers and literals such as `true`, `false`, `null`,
+// `Infinity` and `NaN`.
+func (r *relaxer) word() bool {
+	begin := r.i
+	for !r.eof() && isWordPart(r.data[r.i]) {
+		r.i++
+	}
+	w := string(r.data[begin:r.i])
+
+	if r.opts.hexNumbers {
+		if v, ok := hexToDecimal(w); ok {
+			r.sourceMap.Add(bytes.Index(len(r.out)), bytes.Index(begin), false)
+			r.out = append(r.out, v...)
+			return true
+		}
+	}
+
+	r.emit(begin, r.data[begin:r.i])
+	return true
+}
+
+func hexToDecimal(s string) (string, bool) {
+	v := strings.TrimPrefix(s, "+")
+	neg := strings.HasPrefix(v, "-")
+	v = strings.TrimPrefix(v, "-")
+	if len(v) < 3 || v[0] != '0' || (v[1] != 'x' && v[1] != 'X') {
+		return "", false
+	}
+
+	var i big.Int
+	if _, ok := i.SetString(v[2:], 16); !ok {
+		return "", false
+	}
+	if neg {
+		i.Neg(&i)
+	}
+	return i.String(), true
+}
+
+// check returns false and keeps the first found error of the exceeded limit,
+// if any, which is found at specified position.
+func (r *relaxer) check(at int, errs ...errors.Err) bool {
+	for _, err := range errs {
+		if err != nil {
+			r.err = err
+			r.errAt = at
+			return false
+		}
+	}
+	return true
+}
+
+// skipSpaces skips blank characters and comments if they are allowed.
+func (r *relaxer) skipSpaces() {
+	for !r.eof() {
+		c := r.data[r.i]
+		if bytes.IsBlank(c) {
+			r.i++
+			continue
+		}
+
+		if c != '/' || !r.opts.comments || r.i+1 >= len(r.data) {
+			return
+		}
+
+		switch r.data[r.i+1] {
+		case '/':
+			for r.i < len(r.data) && !bytes.IsNewLine(r.data[r.i]) {
+				r.i++
+			}
+
+		case '*':
+			end := strings.Index(string(r.data[r.i+2:]), "*/")
+			if end == -1 {
+				return // Unterminated comment, the strict scanner will report it.
+			}
+			r.i += end + 4
+
+		default:
+			return
+		}
+	}
+}
+
+// emit writes the part of the original content as is.
+func (r *relaxer) emit(begin int, b []byte) {
+	r.sourceMap.Add(bytes.Index(len(r.out)), bytes.Index(begin), true)
+	r.out = append(r.out, b...)
+}
+
+// copyRest writes the rest of the original content as is.
+func (r *relaxer) copyRest() {
+	if !r.eof() {
+		r.emit(r.i, r.data[r.i:])
+		r.i = len(r.data)
+	}
+}
+
+func (r *relaxer) eof() bool {
+	return r.i >= len(r.data)
+}
+
+func isIdentifierStart(c byte) bool {
+	return c == '_' || c == '$' || ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z')
+}
+
+func isIdentifierPart(c byte) bool {
+	return isIdentifierStart(c) || bytes.IsDigit(c)
+}
+
+func isWordPart(c byte) bool {
+	return isIdentifierPart(c) || c == '-' || c == '+' || c == '.'
+}
@@ -0,0 +1,105 @@
+package json
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsightapi/jsight-schema-go-library/fs"
+	"github.com/jsightapi/jsight-schema-go-library/internal/limits"
+)
+
+func TestRelax(t *testing.T) {
+	json5 := relaxedOptions{
+		comments:         true,
+		trailingCommas:   true,
+		singleQuotes:     true,
+		unquotedKeys:     true,
+		hexNumbers:       true,
+		nonFiniteNumbers: true,
+	}
+
+	jsonc := relaxedOptions{
+		comments:       true,
+		trailingCommas: true,
+	}
+
+	cc := map[string]struct {
+		given    string
+		opts     relaxedOptions
+		expected string
+	}{
+		"strict JSON": {
+			given:    `{"foo": [1, "bar", true, null]}`,
+			opts:     json5,
+			expected: `{"foo":[1,"bar",true,null]}`,
+		},
+
+		"comments": {
+			given: `// leading
+{
+	"foo": 1, // line
+	/* block */ "bar": 2
+} /* trailing */`,
+			opts:     jsonc,
+			expected: `{"foo":1,"bar":2}`,
+		},
+
+		"trailing commas": {
+			given:    `{"foo": [1, 2,], "bar": {},}`,
+			opts:     jsonc,
+			expected: `{"foo":[1,2],"bar":{}}`,
+		},
+
+		"trailing commas are not allowed": {
+			given:    `[1, 2,]`,
+			opts:     relaxedOptions{comments: true},
+			expected: `[1,2,]`,
+		},
+
+		"comments are not allowed": {
+			given:    `[1, // foo`,
+			opts:     relaxedOptions{trailingCommas: true},
+			expected: `[1,// foo`,
+		},
+
+		"single-quoted strings": {
+			given:    `['foo', 'it\'s', 'say "hi"', 'a\nb']`,
+			opts:     json5,
+			expected: `["foo","it's","say \"hi\"","a\nb"]`,
+		},
+
+		"unquoted keys": {
+			given:    `{foo: 1, $bar_2: 2}`,
+			opts:     json5,
+			expected: `{"foo":1,"$bar_2":2}`,
+		},
+
+		"hex numbers": {
+			given:    `[0x1F, -0XfF, +0x10, 1e3]`,
+			opts:     json5,
+			expected: `[31,-255,16,1e3]`,
+		},
+
+		"non-finite numbers": {
+			given:    `[Infinity, -Infinity, NaN]`,
+			opts:     json5,
+			expected: `[Infinity,-Infinity,NaN]`,
+		},
+
+		"unterminated string": {
+			given:    `["foo`,
+			opts:     json5,
+			expected: `["foo`,
+		},
+	}
+
+	for name, c := range cc {
+		t.Run(name, func(t *testing.T) {
+			actual, _, err := relax(fs.NewFile("", c.given), c.opts, limits.NewCounter(limits.Limits{}))
+			require.NoError(t, err)
+			assert.Equal(t, c.expected, string(actual))
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package json
 
 import (
+	"fmt"
+
 	"github.com/jsightapi/jsight-schema-go-library/bytes"
 	"github.com/jsightapi/jsight-schema-go-library/errors"
 	"github.com/jsightapi/jsight-schema-go-library/fs"
@@ -80,6 +82,13 @@ type scanner struct {
 	// allowTrailingNonSpaceCharacters allows to have non-empty characters at the
 	// end of the JSON.
 	allowTrailingNonSpaceCharacters bool
+
+	// allowNonFiniteNumbers allows JSON5 `Infinity`, `-Infinity` and `NaN`
+	// literals.
+	allowNonFiniteNumbers bool
+
+	// keyword the rest of the non-finite number literal which is expected.
+	keyword string
 }
 
 func newScanner(file *fs.File) *scanner {
@@ -347,6 +356,11 @@ func stateBeginValue(s *scanner, c byte) state { //nolint:gocyclo // It's okay.
 		s.step = stateN
 		s.unfinishedLiteral = true
 		return scanBeginLiteral
+	case 'I', 'N': // beginning of Infinity or NaN
+		if s.allowNonFiniteNumbers {
+			s.beginKeyword(c)
+			return scanBeginLiteral
+		}
 	}
 	if '1' <= c && c <= '9' { // beginning of 1234.5
 		s.step = state1
@@ -567,9 +581,38 @@ func stateNeg(s *scanner, c byte) state {
 		s.unfinishedLiteral = false
 		return scanContinue
 	}
+	if c == 'I' && s.allowNonFiniteNumbers {
+		s.beginKeyword(c)
+		return scanContinue
+	}
 	panic(s.newDocumentErrorAtCharacter("in numeric literal"))
 }
 
+// beginKeyword starts reading of `Infinity` or `NaN` literal.
+func (s *scanner) beginKeyword(c byte) {
+	if c == 'I' {
+		s.keyword = "nfinity"
+	} else {
+		s.keyword = "aN"
+	}
+	s.step = stateKeyword
+	s.unfinishedLiteral = true
+}
+
+// After reading the beginning of `Infinity` or `NaN`.
+func stateKeyword(s *scanner, c byte) state {
+	if c != s.keyword[0] {
+		panic(s.newDocumentErrorAtCharacter(fmt.Sprintf("in literal (expecting '%c')", s.keyword[0])))
+	}
+
+	s.keyword = s.keyword[1:]
+	if s.keyword == "" {
+		s.step = stateEndValue
+		s.unfinishedLiteral = false
+	}
+	return scanContinue
+}
+
 // After reading a non-zero integer during a number, such as after reading `1` or
 // `100` but not `0`.
 func state1(s *scanner, c byte) state {
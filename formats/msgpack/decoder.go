@@ -0,0 +1,191 @@
+package msgpack
+
+import (
+	"math"
+	"strconv"
+	"unicode/utf8"
+
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+	"github.com/jsightapi/jsight-schema-go-library/errors"
+	"github.com/jsightapi/jsight-schema-go-library/formats/internal/converted"
+	"github.com/jsightapi/jsight-schema-go-library/fs"
+	"github.com/jsightapi/jsight-schema-go-library/internal/limits"
+	"github.com/jsightapi/jsight-schema-go-library/internal/sourcemap"
+)
+
+const formatName = "MessagePack"
+
+// decoder converts the MessagePack content to the equivalent JSON content.
+type decoder struct {
+	r *converted.BinaryReader
+	w converted.Writer
+
+	// counter checks the content against the limits of the document.
+	counter *limits.Counter
+}
+
+func convert(f *fs.File, counter *limits.Counter) (bytes.Bytes, *sourcemap.Map, error) {
+	d := decoder{
+		r:       converted.NewBinaryReader(formatName, f),
+		counter: counter,
+	}
+
+	err := d.r.Do(func() {
+		if d.r.EOF() {
+			return // Empty document.
+		}
+
+		d.item()
+
+		if !d.r.EOF() {
+			d.r.Fail(d.r.Pos(), errors.ErrBinaryTrailingData)
+		}
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return d.w.Bytes(), nil, nil
+}
+
+func (d *decoder) item() {
+	d.r.Check(d.counter.Lexemes(2))
+	offset := d.r.Pos()
+
+	switch c := d.r.Byte(); {
+	case c <= 0x7f: // positive fixint
+		d.w.Raw(strconv.FormatUint(uint64(c), 10))
+
+	case c <= 0x8f: // fixmap
+		d.mapping(uint64(c & 0x0f))
+
+	case c <= 0x9f: // fixarray
+		d.array(uint64(c & 0x0f))
+
+	case c <= 0xbf: // fixstr
+		d.w.String(d.str(uint64(c&0x1f), offset))
+
+	case c >= 0xe0: // negative fixint
+		d.w.Raw(strconv.FormatInt(int64(int8(c)), 10))
+
+	default:
+		d.typed(c, offset)
+	}
+}
+
+// typed converts values which have the type in the separate byte.
+func (d *decoder) typed(c byte, offset int) {
+	switch c {
+	case 0xc0:
+		d.w.Raw("null")
+
+	case 0xc2:
+		d.w.Raw("false")
+
+	case 0xc3:
+		d.w.Raw("true")
+
+	case 0xc4, 0xc5, 0xc6: // bin 8, bin 16, bin 32
+		d.r.Fail(offset, errors.ErrBinaryByteString)
+
+	case 0xc7, 0xc8, 0xc9, 0xd4, 0xd5, 0xd6, 0xd7, 0xd8: // ext and fixext
+		d.r.Fail(offset, errors.ErrBinaryUnsupportedValue, "extension type")
+
+	case 0xca: // float 32
+		d.float(float64(math.Float32frombits(uint32(d.r.Uint(4)))), 32, offset)
+
+	case 0xcb: // float 64
+		d.float(math.Float64frombits(d.r.Uint(8)), 64, offset)
+
+	case 0xcc, 0xcd, 0xce, 0xcf: // uint 8, uint 16, uint 32, uint 64
+		d.w.Raw(strconv.FormatUint(d.r.Uint(1<<(c-0xcc)), 10))
+
+	case 0xd0, 0xd1, 0xd2, 0xd3: // int 8, int 16, int 32, int 64
+		d.w.Raw(strconv.FormatInt(d.int(1<<(c-0xd0)), 10))
+
+	case 0xd9, 0xda, 0xdb: // str 8, str 16, str 32
+		d.w.String(d.str(d.r.Uint(1<<(c-0xd9)), offset))
+
+	case 0xdc, 0xdd: // array 16, array 32
+		d.array(d.r.Uint(2 << (c - 0xdc)))
+
+	case 0xde, 0xdf: // map 16, map 32
+		d.mapping(d.r.Uint(2 << (c - 0xde)))
+
+	default: // 0xc1 is never used.
+		d.r.Fail(offset, errors.ErrBinaryInvalidData, "unknown type 0x"+strconv.FormatUint(uint64(c), 16))
+	}
+}
+
+func (d *decoder) array(n uint64) {
+	d.r.Check(d.counter.Enter())
+	d.w.Byte('[')
+	for i := uint64(0); i < n; i++ {
+		d.r.Check(d.counter.Lexemes(2), d.counter.Item())
+		if i > 0 {
+			d.w.Byte(',')
+		}
+		d.item()
+	}
+	d.w.Byte(']')
+	d.counter.Leave()
+}
+
+func (d *decoder) mapping(n uint64) {
+	d.r.Check(d.counter.Enter())
+	d.w.Byte('{')
+	for i := uint64(0); i < n; i++ {
+		d.r.Check(d.counter.Lexemes(4), d.counter.Key())
+		if i > 0 {
+			d.w.Byte(',')
+		}
+		d.w.String(d.key())
+		d.w.Byte(':')
+		d.item()
+	}
+	d.w.Byte('}')
+	d.counter.Leave()
+}
+
+func (d *decoder) key() string {
+	offset := d.r.Pos()
+
+	switch c := d.r.Byte(); {
+	case c >= 0xa0 && c <= 0xbf: // fixstr
+		return d.str(uint64(c&0x1f), offset)
+
+	case c >= 0xd9 && c <= 0xdb: // str 8, str 16, str 32
+		return d.str(d.r.Uint(1<<(c-0xd9)), offset)
+	}
+
+	d.r.Fail(offset, errors.ErrBinaryNonStringKey)
+	return ""
+}
+
+func (d *decoder) str(n uint64, offset int) string {
+	d.r.Check(d.counter.String(uint(n)))
+	b := d.r.Bytes(n)
+	if !utf8.Valid(b) {
+		d.r.Fail(offset, errors.ErrBinaryInvalidUTF8String)
+	}
+	return string(b)
+}
+
+// int reads the big-endian signed integer of specified size in bytes.
+func (d *decoder) int(size int) int64 {
+	v := d.r.Uint(size)
+	switch size {
+	case 1:
+		return int64(int8(v))
+	case 2:
+		return int64(int16(v))
+	case 4:
+		return int64(int32(v))
+	}
+	return int64(v)
+}
+
+func (d *decoder) float(f float64, bitSize int, offset int) {
+	if !d.w.Float(f, bitSize) {
+		d.r.Fail(offset, errors.ErrBinaryNonFiniteNumber)
+	}
+}
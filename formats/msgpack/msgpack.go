@@ -0,0 +1,32 @@
+package msgpack
+
+import (
+	jschema "github.com/jsightapi/jsight-schema-go-library"
+	"github.com/jsightapi/jsight-schema-go-library/formats/internal/converted"
+	"github.com/jsightapi/jsight-schema-go-library/fs"
+)
+
+// Document a MessagePack document.
+//
+// The document is converted to the equivalent JSON which is used for producing
+// lexemes, so validation errors point to the JSON representation of the
+// document. Values which can't be represented in JSON, such as binary data,
+// extension types, non-string map keys and non-finite floats, are reported as
+// errors.
+type Document struct {
+	converted.Document
+}
+
+var _ jschema.Document = &Document{}
+
+// New creates a MessagePack document with specified name and content.
+func New[T fs.FileContent](name string, content T, oo ...Option) *Document {
+	return FromFile(fs.NewFile(name, content), oo...)
+}
+
+// FromFile creates a MessagePack document from file.
+func FromFile(f *fs.File, oo ...Option) *Document {
+	return &Document{
+		Document: converted.New(f, convert, converted.Limits(oo)),
+	}
+}
@@ -0,0 +1,170 @@
+package msgpack
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsightapi/jsight-schema-go-library/fs"
+	"github.com/jsightapi/jsight-schema-go-library/internal/limits"
+)
+
+func TestConvert(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		cc := map[string]struct {
+			given    []byte
+			expected string
+		}{
+			"empty":           {nil, ""},
+			"positive fixint": {[]byte{0x7f}, "127"},
+			"negative fixint": {[]byte{0xe0}, "-32"},
+			"uint8":           {[]byte{0xcc, 0xff}, "255"},
+			"uint16":          {[]byte{0xcd, 0x03, 0xe8}, "1000"},
+			"uint64 max":      {[]byte{0xcf, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}, "18446744073709551615"},
+			"int8":            {[]byte{0xd0, 0x9c}, "-100"},
+			"int16":           {[]byte{0xd1, 0xfc, 0x18}, "-1000"},
+			"int32":           {[]byte{0xd2, 0xff, 0xff, 0xff, 0xff}, "-1"},
+			"int64 min":       {[]byte{0xd3, 0x80, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00}, "-9223372036854775808"},
+			"nil":             {[]byte{0xc0}, "null"},
+			"false":           {[]byte{0xc2}, "false"},
+			"true":            {[]byte{0xc3}, "true"},
+			"float32":         {[]byte{0xca, 0x3f, 0xc0, 0x00, 0x00}, "1.5"},
+			"float64":         {[]byte{0xcb, 0x40, 0x59, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00}, "100.0"},
+			"fixstr":          {[]byte{0xa3, 'f', 'o', 'o'}, `"foo"`},
+			"str8":            {[]byte{0xd9, 0x02, '<', '"'}, `"<\""`},
+			"fixarray":        {[]byte{0x93, 0x01, 0xa1, 'a', 0xc0}, `[1,"a",null]`},
+			"array16":         {[]byte{0xdc, 0x00, 0x02, 0xc3, 0xc2}, "[true,false]"},
+			"fixmap":          {[]byte{0x82, 0xa1, 'a', 0x01, 0xa1, 'b', 0x91, 0x02}, `{"a":1,"b":[2]}`},
+			"map16":           {[]byte{0xde, 0x00, 0x01, 0xd9, 0x01, 'k', 0x80}, `{"k":{}}`},
+		}
+
+		for name, c := range cc {
+			t.Run(name, func(t *testing.T) {
+				actual, sm, err := convert(fs.NewFile("", c.given), limits.NewCounter(limits.Limits{}))
+				require.NoError(t, err)
+				assert.Nil(t, sm)
+				assert.Equal(t, c.expected, string(actual))
+			})
+		}
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		cc := map[string]struct {
+			given    []byte
+			expected string
+		}{
+			"unexpected EOF": {
+				[]byte{0x92, 0x01},
+				"ERROR (code 1800): Unexpected end of MessagePack data at offset 2",
+			},
+			"never used type": {
+				[]byte{0xc1},
+				"ERROR (code 1801): Invalid MessagePack data at offset 0: unknown type 0xc1",
+			},
+			"binary": {
+				[]byte{0x81, 0xa1, 'a', 0xc4, 0x01, 0x00},
+				"ERROR (code 1802): MessagePack byte string at offset 3 can't be represented in JSON",
+			},
+			"non-string key": {
+				[]byte{0x81, 0x01, 0x02},
+				"ERROR (code 1803): MessagePack map key at offset 1 should be a string",
+			},
+			"extension": {
+				[]byte{0xd4, 0x01, 0x00},
+				"ERROR (code 1804): MessagePack value at offset 0 can't be represented in JSON: extension type",
+			},
+			"trailing data": {
+				[]byte{0xc0, 0xc0},
+				"ERROR (code 1805): Unexpected data after the end of MessagePack value at offset 1",
+			},
+			"NaN": {
+				[]byte{0xca, 0x7f, 0xc0, 0x00, 0x00},
+				"ERROR (code 1806): MessagePack number at offset 0 is infinite or NaN, which can't be represented in JSON",
+			},
+			"invalid UTF-8": {
+				[]byte{0xa2, 0xc3, 0x28},
+				"ERROR (code 1807): MessagePack string at offset 0 is not a valid UTF-8 string",
+			},
+		}
+
+		for name, c := range cc {
+			t.Run(name, func(t *testing.T) {
+				_, _, err := convert(fs.NewFile("file", c.given), limits.NewCounter(limits.Limits{}))
+				require.Error(t, err)
+				assert.Equal(t, c.expected+"\n\tin file file", err.Error())
+			})
+		}
+	})
+}
+
+func TestDocument_Check(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		require.NoError(t, New("", []byte{0x81, 0xa1, 'a', 0x01}).Check())
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		assert.EqualError(t, New("file", []byte{0xc1}).Check(),
+			"ERROR (code 1801): Invalid MessagePack data at offset 0: unknown type 0xc1\n\tin file file")
+	})
+}
+
+func TestDocument_limits(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		// {"a": [1, "bc"]}
+		data := []byte{0x81, 0xa1, 'a', 0x92, 0x01, 0xa2, 'b', 'c'}
+		require.NoError(t, New("", data, MaxDepth(2), MaxStringLength(2), MaxArrayLength(2), MaxLexemes(18)).Check())
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		cc := map[string]struct {
+			given    []byte
+			option   Option
+			expected string
+		}{
+			"depth": {
+				// [[[]]]
+				[]byte{0x91, 0x91, 0x90},
+				MaxDepth(2),
+				"ERROR (code 305): The nesting depth exceeds the limit of 2",
+			},
+			"string length": {
+				[]byte{0xa3, 'a', 'b', 'c'},
+				MaxStringLength(2),
+				"ERROR (code 306): The string length exceeds the limit of 2 bytes",
+			},
+			"keys": {
+				// {"a": 1, "b": 2}
+				[]byte{0x82, 0xa1, 'a', 0x01, 0xa1, 'b', 0x02},
+				MaxKeysPerObject(1),
+				"ERROR (code 307): The number of object keys exceeds the limit of 1",
+			},
+			"array length": {
+				// The array which declares 2^32-1 items, but contains only one.
+				[]byte{0xdd, 0xff, 0xff, 0xff, 0xff, 0x01},
+				MaxArrayLength(1),
+				"ERROR (code 308): The number of array items exceeds the limit of 1",
+			},
+			"lexemes": {
+				[]byte{0x92, 0x01, 0x02},
+				MaxLexemes(5),
+				"ERROR (code 309): The number of lexemes exceeds the limit of 5",
+			},
+		}
+
+		for name, c := range cc {
+			t.Run(name, func(t *testing.T) {
+				assert.EqualError(t, New("file", c.given, c.option).Check(), c.expected+"\n\tin file file")
+			})
+		}
+	})
+}
+
+func TestDocument_nesting(t *testing.T) {
+	t.Run("default depth", func(t *testing.T) {
+		data := append(bytes.Repeat([]byte{0x91}, 1_000_000), 0x01)
+		assert.EqualError(t, New("file", data).Check(),
+			"ERROR (code 305): The nesting depth exceeds the limit of 10000\n\tin file file")
+	})
+}
@@ -0,0 +1,355 @@
+package yaml
+
+import (
+	stdBytes "bytes"
+	stdJson "encoding/json"
+	stdErrors "errors"
+	"io"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+	"github.com/jsightapi/jsight-schema-go-library/errors"
+	"github.com/jsightapi/jsight-schema-go-library/formats/internal/converted"
+	"github.com/jsightapi/jsight-schema-go-library/fs"
+	"github.com/jsightapi/jsight-schema-go-library/internal/limits"
+	"github.com/jsightapi/jsight-schema-go-library/internal/sourcemap"
+)
+
+const (
+	tagNull      = "!!null"
+	tagBool      = "!!bool"
+	tagInt       = "!!int"
+	tagFloat     = "!!float"
+	tagStr       = "!!str"
+	tagTimestamp = "!!timestamp"
+	tagMerge     = "!!merge"
+)
+
+// maxAliasNodes the maximum total number of nodes produced by expanding
+// aliases. Protects against documents like "billion laughs", which expand a
+// few aliases to a huge content.
+const maxAliasNodes = 10_000
+
+// converter converts the YAML content to the equivalent JSON content.
+type converter struct {
+	file *fs.File
+
+	// lines beginnings of lines in the YAML content.
+	lines []bytes.Index
+
+	// expanding the anchored nodes which are currently expanded by aliases.
+	// Is used for detecting recursive aliases.
+	expanding map[*yaml.Node]struct{}
+
+	// aliasNodes the number of nodes which are already produced by expanding
+	// aliases.
+	aliasNodes int
+
+	// counter checks the content against the limits of the document.
+	counter *limits.Counter
+
+	buf       converted.Writer
+	sourceMap sourcemap.Map
+}
+
+func convert(f *fs.File, counter *limits.Counter) (bytes.Bytes, *sourcemap.Map, error) {
+	c := converter{
+		file:      f,
+		lines:     lineBeginnings(f.Content()),
+		expanding: map[*yaml.Node]struct{}{},
+		counter:   counter,
+	}
+
+	if err := c.convert(); err != nil {
+		return nil, nil, err
+	}
+	return c.buf.Bytes(), &c.sourceMap, nil
+}
+
+func lineBeginnings(content bytes.Bytes) []bytes.Index {
+	ll := []bytes.Index{0}
+	for i, c := range content {
+		if c == '\n' {
+			ll = append(ll, bytes.Index(i+1))
+		}
+	}
+	return ll
+}
+
+func (c *converter) convert() error {
+	d := yaml.NewDecoder(stdBytes.NewReader(c.file.Content()))
+
+	var doc yaml.Node
+	if err := d.Decode(&doc); err != nil {
+		if stdErrors.Is(err, io.EOF) {
+			return nil // Empty document.
+		}
+		return c.parseError(err)
+	}
+
+	var next yaml.Node
+	if err := d.Decode(&next); !stdErrors.Is(err, io.EOF) {
+		if err != nil {
+			return c.parseError(err)
+		}
+		return c.error(&next, errors.ErrYAMLMultipleDocuments)
+	}
+
+	if len(doc.Content) == 0 {
+		return nil // Document contains only comments.
+	}
+	return c.node(doc.Content[0], doc.Content[0])
+}
+
+// node writes the JSON equivalent of the YAML node. The first token will be
+// mapped to the position of the `at` node.
+func (c *converter) node(n, at *yaml.Node) error {
+	if len(c.expanding) != 0 {
+		c.aliasNodes++
+		if c.aliasNodes > maxAliasNodes {
+			return c.error(at, errors.Format(errors.ErrYAMLTooManyAliases, strconv.Itoa(maxAliasNodes)))
+		}
+	}
+
+	switch n.Kind {
+	case yaml.AliasNode:
+		return c.alias(n, at)
+
+	case yaml.MappingNode:
+		return c.mapping(n, at)
+
+	case yaml.SequenceNode:
+		return c.sequence(n, at)
+
+	case yaml.ScalarNode:
+		return c.scalar(n, at)
+	}
+	return c.error(n, errors.Format(errors.ErrInvalidYAML, "unexpected node"))
+}
+
+// alias writes the JSON equivalent of the node which the alias refers to.
+func (c *converter) alias(n, at *yaml.Node) error {
+	if _, ok := c.expanding[n.Alias]; ok {
+		return c.error(n, errors.ErrYAMLRecursiveAlias)
+	}
+
+	c.expanding[n.Alias] = struct{}{}
+	defer delete(c.expanding, n.Alias)
+
+	return c.node(n.Alias, at)
+}
+
+func (c *converter) mapping(n, at *yaml.Node) error {
+	if err := c.limit(at, c.counter.Lexemes(2), c.counter.Enter()); err != nil {
+		return err
+	}
+	defer c.counter.Leave()
+
+	c.mark(at)
+	c.buf.Byte('{')
+
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		k, v := n.Content[i], n.Content[i+1]
+
+		if k.ShortTag() == tagMerge {
+			return c.error(k, errors.ErrYAMLMergeKey)
+		}
+		if k.Kind != yaml.ScalarNode || k.ShortTag() != tagStr {
+			return c.error(k, errors.ErrYAMLInvalidKey)
+		}
+		err := c.limit(k, c.counter.Lexemes(4), c.counter.Key(), c.counter.String(uint(len(k.Value))))
+		if err != nil {
+			return err
+		}
+
+		if i > 0 {
+			c.buf.Byte(',')
+		}
+		c.mark(k)
+		c.buf.String(k.Value)
+		c.buf.Byte(':')
+
+		if err := c.node(v, v); err != nil {
+			return err
+		}
+	}
+
+	c.mark(at)
+	c.buf.Byte('}')
+	return nil
+}
+
+func (c *converter) sequence(n, at *yaml.Node) error {
+	if err := c.limit(at, c.counter.Lexemes(2), c.counter.Enter()); err != nil {
+		return err
+	}
+	defer c.counter.Leave()
+
+	c.mark(at)
+	c.buf.Byte('[')
+
+	for i, v := range n.Content {
+		if err := c.limit(v, c.counter.Lexemes(2), c.counter.Item()); err != nil {
+			return err
+		}
+		if i > 0 {
+			c.buf.Byte(',')
+		}
+		if err := c.node(v, v); err != nil {
+			return err
+		}
+	}
+
+	c.mark(at)
+	c.buf.Byte(']')
+	return nil
+}
+
+func (c *converter) scalar(n, at *yaml.Node) error {
+	if err := c.limit(at, c.counter.Lexemes(2)); err != nil {
+		return err
+	}
+
+	c.mark(at)
+
+	switch tag := n.ShortTag(); tag {
+	case tagNull:
+		c.buf.Raw("null")
+
+	case tagBool:
+		var b bool
+		if err := n.Decode(&b); err != nil {
+			return c.error(n, errors.Format(errors.ErrInvalidYAML, err.Error()))
+		}
+		c.buf.Raw(strconv.FormatBool(b))
+
+	case tagInt:
+		v, ok := intToJSON(n.Value)
+		if !ok {
+			return c.error(n, errors.Format(errors.ErrYAMLInvalidNumber, n.Value))
+		}
+		c.buf.Raw(v)
+
+	case tagFloat:
+		v, ok := floatToJSON(n.Value)
+		if !ok {
+			return c.error(n, errors.Format(errors.ErrYAMLInvalidNumber, n.Value))
+		}
+		c.buf.Raw(v)
+
+	case tagStr, tagTimestamp:
+		if err := c.limit(at, c.counter.String(uint(len(n.Value)))); err != nil {
+			return err
+		}
+		c.buf.String(n.Value)
+
+	default:
+		return c.error(n, errors.Format(errors.ErrYAMLUnsupportedTag, tag))
+	}
+	return nil
+}
+
+// mark binds the current position in the JSON content with the position of
+// the YAML node.
+func (c *converter) mark(n *yaml.Node) {
+	c.sourceMap.Add(c.buf.Len(), c.offset(n.Line, n.Column), false)
+}
+
+// offset converts the line and column numbers (both are starting from 1) to
+// the index of the byte in the YAML content.
+func (c *converter) offset(line, column int) bytes.Index {
+	if line < 1 || line > len(c.lines) {
+		return 0
+	}
+
+	content := c.file.Content()
+	i := c.lines[line-1]
+	for ; column > 1 && int(i) < len(content); column-- {
+		_, size := utf8.DecodeRune(content[i:])
+		i += bytes.Index(size)
+	}
+	return i
+}
+
+// limit returns the error of the first exceeded limit, if any, which is found at
+// the node.
+func (c *converter) limit(n *yaml.Node, errs ...errors.Err) error {
+	for _, err := range errs {
+		if err != nil {
+			return c.error(n, err)
+		}
+	}
+	return nil
+}
+
+func (c *converter) error(n *yaml.Node, err errors.Err) error {
+	e := errors.NewDocumentError(c.file, err)
+	e.SetIndex(c.offset(n.Line, n.Column))
+	return e
+}
+
+var reParseErrorLine = regexp.MustCompile(`^yaml: line (\d+): (.*)$`)
+
+// parseError converts an error of the YAML parser to the document error.
+func (c *converter) parseError(err error) error {
+	msg := err.Error()
+
+	m := reParseErrorLine.FindStringSubmatch(msg)
+	if m == nil {
+		return errors.NewDocumentError(c.file, errors.Format(errors.ErrInvalidYAML, strings.TrimPrefix(msg, "yaml: ")))
+	}
+
+	line, _ := strconv.Atoi(m[1]) //nolint:errcheck // The regex guarantees this is a number.
+	e := errors.NewDocumentError(c.file, errors.Format(errors.ErrInvalidYAML, m[2]))
+	e.SetIndex(c.offset(line, 1))
+	return e
+}
+
+func isJSONNumber(s string) bool {
+	if s == "" || (s[0] != '-' && (s[0] < '0' || s[0] > '9')) {
+		return false
+	}
+	return stdJson.Valid([]byte(s))
+}
+
+// intToJSON converts YAML integer (including octal and hexadecimal forms) to
+// the JSON number.
+func intToJSON(s string) (string, bool) {
+	if isJSONNumber(s) {
+		return s, true
+	}
+
+	var i big.Int
+	if _, ok := i.SetString(strings.TrimPrefix(s, "+"), 0); !ok {
+		return "", false
+	}
+	return i.String(), true
+}
+
+// floatToJSON converts YAML float to the JSON number. Infinity and NaN can't be
+// represented in JSON.
+func floatToJSON(s string) (string, bool) {
+	if isJSONNumber(s) {
+		return s, true
+	}
+
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return "", false
+	}
+
+	v := strconv.FormatFloat(f, 'g', -1, 64)
+	if !isJSONNumber(v) {
+		return "", false // Infinity or NaN.
+	}
+	if !strings.ContainsAny(v, ".e") {
+		v += ".0"
+	}
+	return v, true
+}
@@ -0,0 +1,32 @@
+package yaml
+
+import (
+	jschema "github.com/jsightapi/jsight-schema-go-library"
+	"github.com/jsightapi/jsight-schema-go-library/formats/internal/converted"
+	"github.com/jsightapi/jsight-schema-go-library/fs"
+)
+
+// Document a YAML document.
+// Only the JSON-compatible subset of YAML 1.2 is supported: a single document
+// which consists of mappings with string keys, sequences, strings, numbers,
+// booleans and nulls.
+//
+// The document is converted to the equivalent JSON which is used for producing
+// lexemes. Positions of errors are mapped back to the original YAML content.
+type Document struct {
+	converted.Document
+}
+
+var _ jschema.Document = &Document{}
+
+// New creates a YAML document with specified name and content.
+func New[T fs.FileContent](name string, content T, oo ...Option) *Document {
+	return FromFile(fs.NewFile(name, content), oo...)
+}
+
+// FromFile creates a YAML document from file.
+func FromFile(f *fs.File, oo ...Option) *Document {
+	return &Document{
+		Document: converted.New(f, convert, converted.Limits(oo)),
+	}
+}
@@ -0,0 +1,277 @@
+package yaml
+
+import (
+	stdErrors "errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsightapi/jsight-schema-go-library/errors"
+	"github.com/jsightapi/jsight-schema-go-library/fs"
+	"github.com/jsightapi/jsight-schema-go-library/internal/limits"
+)
+
+func TestConvert(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		cc := map[string]string{
+			"":                  "",
+			"# comment":         "",
+			"42":                "42",
+			"-1.5":              "-1.5",
+			"0x1F":              "31",
+			"0o17":              "15",
+			"+12":               "12",
+			".5":                "0.5",
+			"1e3":               "1e3",
+			"true":              "true",
+			"False":             "false",
+			"null":              "null",
+			"~":                 "null",
+			"foo":               `"foo"`,
+			`"foo\tbar"`:        `"foo\tbar"`,
+			"'it''s'":           `"it's"`,
+			"2022-01-01":        `"2022-01-01"`,
+			"'42'":              `"42"`,
+			"<b>":               `"<b>"`,
+			"[1, two, 3.0]":     `[1,"two",3.0]`,
+			"{}":                "{}",
+			"[]":                "[]",
+			"text: |\n  a\n  b": `{"text":"a\nb"}`,
+			`foo:
+  bar: 1
+  baz:
+    - a
+    - b: null
+`: `{"foo":{"bar":1,"baz":["a",{"b":null}]}}`,
+			`anchor: &a
+  x: 1
+alias: *a
+`: `{"anchor":{"x":1},"alias":{"x":1}}`,
+		}
+
+		for given, expected := range cc {
+			t.Run(given, func(t *testing.T) {
+				actual, _, err := convert(fs.NewFile("", given), limits.NewCounter(limits.Limits{}))
+				require.NoError(t, err)
+				assert.Equal(t, expected, string(actual))
+			})
+		}
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		cc := map[string]string{
+			"foo: [": `ERROR (code 1700): Invalid YAML: did not find expected node content
+	in line 1 on file 
+	> foo: [
+	--^`,
+
+			"a: 1\n---\nb: 2": `ERROR (code 1701): YAML stream should contain only one document
+	in line 2 on file 
+	> ---
+	--^`,
+
+			"foo: !!binary aGVsbG8=": `ERROR (code 1702): YAML tag "!!binary" is not supported, only JSON-compatible values are allowed
+	in line 1 on file 
+	> foo: !!binary aGVsbG8=
+	-------^`,
+
+			"foo:\n  1: bar": `ERROR (code 1703): YAML mapping key should be a string
+	in line 2 on file 
+	> 1: bar
+	--^`,
+
+			"[.inf]": `ERROR (code 1704): YAML number ".inf" can't be represented in JSON
+	in line 1 on file 
+	> [.inf]
+	---^`,
+
+			"a: &a {x: 1}\nb:\n  <<: *a": `ERROR (code 1705): YAML merge keys are not supported
+	in line 3 on file 
+	> <<: *a
+	--^`,
+
+			"a: &a [1, *a]": `ERROR (code 1706): YAML alias refers to the node which contains it
+	in line 1 on file 
+	> a: &a [1, *a]
+	------------^`,
+
+			"a: &a\n  b: [*a]": `ERROR (code 1706): YAML alias refers to the node which contains it
+	in line 2 on file 
+	> b: [*a]
+	------^`,
+		}
+
+		for given, expected := range cc {
+			t.Run(given, func(t *testing.T) {
+				_, _, err := convert(fs.NewFile("", given), limits.NewCounter(limits.Limits{}))
+				assert.EqualError(t, err, expected)
+			})
+		}
+	})
+}
+
+func TestConvert_aliasesLimit(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		content := "a: &a [1, 2, 3]\nb: [*a, *a, *a]\n"
+		actual, _, err := convert(fs.NewFile("", content), limits.NewCounter(limits.Limits{}))
+		require.NoError(t, err)
+		assert.Equal(t, `{"a":[1,2,3],"b":[[1,2,3],[1,2,3],[1,2,3]]}`, string(actual))
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		// "Billion laughs": each level refers to the previous one 10 times.
+		var b strings.Builder
+		b.WriteString("l0: &l0 [lol, lol, lol, lol, lol, lol, lol, lol, lol, lol]\n")
+		for i := 1; i < 8; i++ {
+			fmt.Fprintf(&b, "l%d: &l%d [", i, i)
+			for j := 0; j < 10; j++ {
+				if j > 0 {
+					b.WriteString(", ")
+				}
+				fmt.Fprintf(&b, "*l%d", i-1)
+			}
+			b.WriteString("]\n")
+		}
+
+		_, _, err := convert(fs.NewFile("", b.String()), limits.NewCounter(limits.Limits{}))
+		var e errors.DocumentError
+		require.ErrorAs(t, err, &e)
+		assert.Equal(t, errors.ErrYAMLTooManyAliases, e.Code())
+	})
+}
+
+func TestDocument_limits(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		cc := map[string]Option{
+			"depth":         MaxDepth(2),
+			"string length": MaxStringLength(3),
+			"keys":          MaxKeysPerObject(2),
+			"array length":  MaxArrayLength(3),
+			"lexemes":       MaxLexemes(26),
+		}
+
+		for name, o := range cc {
+			t.Run(name, func(t *testing.T) {
+				err := New("", "foo: [1, 2, 3]\nbar: baz\n", o).Check()
+				require.NoError(t, err)
+			})
+		}
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		cc := map[string]struct {
+			data     string
+			option   Option
+			expected string
+		}{
+			"depth": {
+				"foo:\n  - [1]\n",
+				MaxDepth(2),
+				`ERROR (code 305): The nesting depth exceeds the limit of 2
+	in line 2 on file 
+	> - [1]
+	----^`,
+			},
+			"string length": {
+				"- foo\n- fizz\n",
+				MaxStringLength(3),
+				`ERROR (code 306): The string length exceeds the limit of 3 bytes
+	in line 2 on file 
+	> - fizz
+	----^`,
+			},
+			"keys": {
+				"a: 1\nb: 2\n",
+				MaxKeysPerObject(1),
+				`ERROR (code 307): The number of object keys exceeds the limit of 1
+	in line 2 on file 
+	> b: 2
+	--^`,
+			},
+			"expanded aliases": {
+				"a: &a [1, 2]\nb: [*a, *a, *a]\n",
+				MaxLexemes(30),
+				`ERROR (code 309): The number of lexemes exceeds the limit of 30
+	in line 1 on file 
+	> a: &a [1, 2]
+	------------^`,
+			},
+		}
+
+		for name, c := range cc {
+			t.Run(name, func(t *testing.T) {
+				assert.EqualError(t, New("", c.data, c.option).Check(), c.expected)
+			})
+		}
+	})
+}
+
+func TestDocument_NextLexeme(t *testing.T) {
+	d := New("file", "foo: [1, bar]\n")
+
+	var lexemes []string
+	for {
+		lex, err := d.NextLexeme()
+		if stdErrors.Is(err, io.EOF) {
+			break
+		}
+		require.NoError(t, err)
+		lexemes = append(lexemes, lex.Type().String()+" "+lex.Value().String())
+	}
+
+	assert.Equal(t, []string{
+		"object-begin {",
+		"key-begin \"",
+		"key-end \"foo\"",
+		"value-begin [",
+		"array-begin [",
+		"item-begin 1",
+		"literal-begin 1",
+		"literal-end 1",
+		"item-end 1",
+		"item-begin \"",
+		"literal-begin \"",
+		"literal-end \"bar\"",
+		"item-end \"bar\"",
+		"array-end [1,\"bar\"]",
+		"value-end [1,\"bar\"]",
+		"object-end {\"foo\":[1,\"bar\"]}",
+	}, lexemes)
+}
+
+func TestDocument_Len(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		l, err := New("", "foo: bar\n").Len()
+		require.NoError(t, err)
+		assert.Equal(t, uint(9), l)
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		_, err := New("", "foo: [").Len()
+		assert.Error(t, err)
+	})
+}
+
+func TestDocument_Check(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		require.NoError(t, New("", "foo: bar").Check())
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		t.Run("empty", func(t *testing.T) {
+			assert.EqualError(t, New("file", "# comment").Check(), `ERROR (code 203): Empty JSON
+	in file file`)
+		})
+
+		t.Run("invalid", func(t *testing.T) {
+			assert.EqualError(t, New("", "[1, .nan]").Check(), `ERROR (code 1704): YAML number ".nan" can't be represented in JSON
+	in line 1 on file 
+	> [1, .nan]
+	------^`)
+		})
+	})
+}
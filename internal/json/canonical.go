@@ -0,0 +1,113 @@
+package json
+
+import (
+	stdBytes "bytes"
+	stdJson "encoding/json"
+	"sort"
+	"strings"
+
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+)
+
+// Decode decodes the JSON value. Objects are decoded as map[string]interface{},
+// arrays as []interface{} and numbers as encoding/json.Number, so the original
+// precision is preserved.
+func Decode(b bytes.Bytes) (interface{}, error) {
+	d := stdJson.NewDecoder(stdBytes.NewReader(b))
+	d.UseNumber()
+
+	var v interface{}
+	if err := d.Decode(&v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Canonical returns the canonical representation of the JSON value. Values which
+// are equal in terms of JSON have the same canonical representation: numbers
+// are compared by value (1, 1.0 and 1e0 are equal), strings are compared after
+// decoding of escape sequences, and objects are compared regardless of the
+// order of keys.
+func Canonical(b bytes.Bytes) (string, error) {
+	v, err := Decode(b)
+	if err != nil {
+		return "", err
+	}
+	return CanonicalOf(v)
+}
+
+// CanonicalOf returns the canonical representation of the value returned by
+// Decode.
+func CanonicalOf(v interface{}) (string, error) {
+	var sb strings.Builder
+	if err := writeCanonical(&sb, v); err != nil {
+		return "", err
+	}
+	return sb.String(), nil
+}
+
+func writeCanonical(sb *strings.Builder, v interface{}) error {
+	switch vv := v.(type) {
+	case nil:
+		sb.WriteString("null")
+
+	case bool:
+		if vv {
+			sb.WriteString("true")
+		} else {
+			sb.WriteString("false")
+		}
+
+	case stdJson.Number:
+		n, err := NewNumber(bytes.Bytes(vv))
+		if err != nil {
+			return err
+		}
+		s := n.String()
+		if s == "-0" {
+			s = "0"
+		}
+		sb.WriteString(s)
+
+	case string:
+		writeCanonicalString(sb, vv)
+
+	case []interface{}:
+		sb.WriteByte('[')
+		for i, item := range vv {
+			if i > 0 {
+				sb.WriteByte(',')
+			}
+			if err := writeCanonical(sb, item); err != nil {
+				return err
+			}
+		}
+		sb.WriteByte(']')
+
+	case map[string]interface{}:
+		keys := make([]string, 0, len(vv))
+		for k := range vv {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		sb.WriteByte('{')
+		for i, k := range keys {
+			if i > 0 {
+				sb.WriteByte(',')
+			}
+			writeCanonicalString(sb, k)
+			sb.WriteByte(':')
+			if err := writeCanonical(sb, vv[k]); err != nil {
+				return err
+			}
+		}
+		sb.WriteByte('}')
+	}
+	return nil
+}
+
+func writeCanonicalString(sb *strings.Builder, s string) {
+	b, _ := stdJson.Marshal(s) //nolint:errcheck // Marshaling of a string never fails.
+	sb.Write(b)
+}
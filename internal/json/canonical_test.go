@@ -0,0 +1,43 @@
+package json
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+)
+
+func TestCanonical(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		cc := map[string]string{
+			"null":                            "null",
+			"true":                            "true",
+			"false":                           "false",
+			"1":                               "1",
+			"1.0":                             "1",
+			"1e3":                             "1000",
+			"100e-2":                          "1",
+			"-0.0":                            "0",
+			`"foo"`:                           `"foo"`,
+			`"\u0066oo"`:                      `"foo"`,
+			`"\/"`:                            `"/"`,
+			`[1, 2.50, "a"]`:                  `[1,2.5,"a"]`,
+			`{"b": 1, "a": {"d": 2, "c": 3}}`: `{"a":{"c":3,"d":2},"b":1}`,
+		}
+
+		for given, expected := range cc {
+			t.Run(given, func(t *testing.T) {
+				actual, err := Canonical(bytes.Bytes(given))
+				require.NoError(t, err)
+				assert.Equal(t, expected, actual)
+			})
+		}
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		_, err := Canonical(bytes.Bytes(`{"foo"`))
+		assert.Error(t, err)
+	})
+}
@@ -103,6 +103,16 @@ func (g GuessData) IsString() bool {
 	return false
 }
 
+// IsNonFinite returns true for JSON5 `Infinity`, `-Infinity` and `NaN`
+// literals. They aren't numbers of JSON, so they don't have a JSON type.
+func (g GuessData) IsNonFinite() bool {
+	switch string(g.bytes) {
+	case "Infinity", "-Infinity", "+Infinity", "NaN", "-NaN", "+NaN":
+		return true
+	}
+	return false
+}
+
 func (g GuessData) IsShortcut() bool {
 	return g.bytes.IsUserTypeName()
 }
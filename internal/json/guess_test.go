@@ -214,6 +214,20 @@ func TestIsNull(t *testing.T) {
 	}
 }
 
+func TestGuessData_IsNonFinite(t *testing.T) {
+	for _, str := range []string{"Infinity", "-Infinity", "+Infinity", "NaN", "-NaN", "+NaN"} {
+		t.Run(str, func(t *testing.T) {
+			assert.True(t, Guess(bytes.Bytes(str)).IsNonFinite())
+		})
+	}
+
+	for _, str := range []string{"1", "-1.5", `"Infinity"`, "null", "Inf", "nan"} {
+		t.Run(str, func(t *testing.T) {
+			assert.False(t, Guess(bytes.Bytes(str)).IsNonFinite())
+		})
+	}
+}
+
 func TestGuessLiteralNodeType(t *testing.T) {
 	for _, str := range success("string") {
 		t.Run(str, func(t *testing.T) {
@@ -2,6 +2,7 @@ package json
 
 import (
 	"errors"
+	"math/big"
 	"strconv"
 
 	"github.com/jsightapi/jsight-schema-go-library/bytes"
@@ -214,6 +215,26 @@ func (n Number) String() string {
 	return str
 }
 
+// Rat returns the exact value of the number.
+func (n Number) Rat() *big.Rat {
+	num := new(big.Int)
+	if len(n.nat) != 0 {
+		num.SetString(string(n.nat), 10)
+	}
+	if n.neg {
+		num.Neg(num)
+	}
+
+	den := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n.exp)), nil)
+	return new(big.Rat).SetFrac(num, den)
+}
+
+// IsMultipleOf returns true if n is an integer multiple of d. The d shouldn't
+// be zero.
+func (n Number) IsMultipleOf(d *Number) bool {
+	return new(big.Rat).Quo(n.Rat(), d.Rat()).IsInt()
+}
+
 func (n Number) ToFloat() float64 {
 	v, err := strconv.ParseFloat(n.String(), 64)
 	// Normally we shouldn't get an error here cause string value is valid.
@@ -885,3 +885,53 @@ func TestNumber_ToFloat(t *testing.T) {
 		})
 	}
 }
+
+func TestNumber_Rat(t *testing.T) {
+	cc := map[string]string{
+		"0":        "0/1",
+		"42":       "42/1",
+		"-3.14":    "-157/50",
+		"2e3":      "2000/1",
+		"2.50e-3":  "1/400",
+		"0.000100": "1/10000",
+	}
+
+	for number, expected := range cc {
+		t.Run(number, func(t *testing.T) {
+			n, err := NewNumber([]byte(number))
+			require.NoError(t, err)
+			assert.Equal(t, expected, n.Rat().String())
+		})
+	}
+}
+
+func TestNumber_IsMultipleOf(t *testing.T) {
+	cc := []struct {
+		number   string
+		divisor  string
+		expected bool
+	}{
+		{"0", "3", true},
+		{"9", "3", true},
+		{"-9", "3", true},
+		{"10", "3", false},
+		{"0.3", "0.1", true},
+		{"0.35", "0.1", false},
+		{"19.99", "0.01", true},
+		{"19.999", "0.01", false},
+		{"1e3", "250", true},
+		{"7.5", "2.5", true},
+		{"123456789012345678901234567890", "10", true},
+		{"123456789012345678901234567891", "10", false},
+	}
+
+	for _, c := range cc {
+		t.Run(fmt.Sprintf("%s %s", c.number, c.divisor), func(t *testing.T) {
+			n, err := NewNumber([]byte(c.number))
+			require.NoError(t, err)
+			d, err := NewNumber([]byte(c.divisor))
+			require.NoError(t, err)
+			assert.Equal(t, c.expected, n.IsMultipleOf(d))
+		})
+	}
+}
@@ -0,0 +1,214 @@
+package limits
+
+import (
+	"strconv"
+
+	"github.com/jsightapi/jsight-schema-go-library/errors"
+	"github.com/jsightapi/jsight-schema-go-library/internal/lexeme"
+)
+
+// DefaultMaxDepth the limit of the nesting depth which is applied to documents
+// processed by recursive code, such as converters of other formats and the
+// relaxer of JSONC and JSON5 documents, even if no limits are set. Deeper
+// content could exhaust the stack.
+const DefaultMaxDepth = 10_000
+
+// Limits restricts the size of the document. They are the safeguards against
+// untrusted documents which are too large or too deeply nested. The zero value
+// of each limit means there is no limit.
+type Limits struct {
+	// MaxDepth the maximum nesting depth of objects and arrays. The root
+	// object or array has the depth of 1.
+	MaxDepth uint
+
+	// MaxStringLength the maximum length of the string value or the object key
+	// in bytes, as written in the document without quotes.
+	MaxStringLength uint
+
+	// MaxKeysPerObject the maximum number of keys of the single object.
+	MaxKeysPerObject uint
+
+	// MaxArrayLength the maximum number of items of the single array.
+	MaxArrayLength uint
+
+	// MaxLexemes the maximum total number of lexemes of the document.
+	MaxLexemes uint
+}
+
+// IsZero returns true if no limit is set.
+func (l Limits) IsZero() bool {
+	return l == Limits{}
+}
+
+// Stricter returns the limits which contain the smallest non-zero value of
+// each limit of both.
+func (l Limits) Stricter(o Limits) Limits {
+	return Limits{
+		MaxDepth:         stricter(l.MaxDepth, o.MaxDepth),
+		MaxStringLength:  stricter(l.MaxStringLength, o.MaxStringLength),
+		MaxKeysPerObject: stricter(l.MaxKeysPerObject, o.MaxKeysPerObject),
+		MaxArrayLength:   stricter(l.MaxArrayLength, o.MaxArrayLength),
+		MaxLexemes:       stricter(l.MaxLexemes, o.MaxLexemes),
+	}
+}
+
+func stricter(a, b uint) uint {
+	if a == 0 || (b != 0 && b < a) {
+		return b
+	}
+	return a
+}
+
+// Counter counts parts of the document and checks them against the limits.
+// It is used by the Guard for lexemes of JSON documents, and by converters of
+// other formats while the content is decoded, so too large documents fail
+// before the whole JSON content is built. Each method returns the error if
+// some limit is exceeded.
+type Counter struct {
+	limits Limits
+
+	// counters the number of keys or items of each currently open object or
+	// array. The length is the current nesting depth.
+	counters []uint
+
+	// lexemes the number of counted lexemes.
+	lexemes uint
+}
+
+func NewCounter(l Limits) *Counter {
+	return &Counter{
+		limits: l,
+	}
+}
+
+// Lexemes counts n lexemes of the document.
+func (c *Counter) Lexemes(n uint) errors.Err {
+	c.lexemes += n
+	if c.limits.MaxLexemes != 0 && c.lexemes > c.limits.MaxLexemes {
+		return newErr(errors.ErrLexemesLimitExceeded, c.limits.MaxLexemes)
+	}
+	return nil
+}
+
+// Enter counts the beginning of the object or the array.
+func (c *Counter) Enter() errors.Err {
+	if err := c.Depth(uint(len(c.counters)) + 1); err != nil {
+		return err
+	}
+	c.counters = append(c.counters, 0)
+	return nil
+}
+
+// Leave counts the end of the object or the array.
+func (c *Counter) Leave() {
+	if len(c.counters) != 0 {
+		c.counters = c.counters[:len(c.counters)-1]
+	}
+}
+
+// Key counts the key of the current object.
+func (c *Counter) Key() errors.Err {
+	return c.Keys(c.count())
+}
+
+// Item counts the item of the current array.
+func (c *Counter) Item() errors.Err {
+	return c.Items(c.count())
+}
+
+// Depth checks the nesting depth of the object or the array. Unlike Enter, it
+// doesn't change the state of the counter, so it can be used for documents
+// which aren't read in order.
+func (c *Counter) Depth(n uint) errors.Err {
+	if c.limits.MaxDepth != 0 && n > c.limits.MaxDepth {
+		return newErr(errors.ErrDepthLimitExceeded, c.limits.MaxDepth)
+	}
+	return nil
+}
+
+// Keys checks the number of keys of the object.
+func (c *Counter) Keys(n uint) errors.Err {
+	if c.limits.MaxKeysPerObject != 0 && n > c.limits.MaxKeysPerObject {
+		return newErr(errors.ErrKeysLimitExceeded, c.limits.MaxKeysPerObject)
+	}
+	return nil
+}
+
+// Items checks the number of items of the array.
+func (c *Counter) Items(n uint) errors.Err {
+	if c.limits.MaxArrayLength != 0 && n > c.limits.MaxArrayLength {
+		return newErr(errors.ErrArrayLengthLimitExceeded, c.limits.MaxArrayLength)
+	}
+	return nil
+}
+
+// String checks the length of the string value or the object key in bytes.
+func (c *Counter) String(length uint) errors.Err {
+	if c.limits.MaxStringLength != 0 && length > c.limits.MaxStringLength {
+		return newErr(errors.ErrStringLengthLimitExceeded, c.limits.MaxStringLength)
+	}
+	return nil
+}
+
+// count increments the number of keys or items of the current object or array,
+// and returns the new value.
+func (c *Counter) count() uint {
+	if len(c.counters) == 0 {
+		return 0
+	}
+	c.counters[len(c.counters)-1]++
+	return c.counters[len(c.counters)-1]
+}
+
+// Guard checks lexemes of the document against the limits. Lexemes should be
+// passed in the order they are found, so the guard fails before the rest of
+// the document is processed.
+type Guard struct {
+	counter *Counter
+}
+
+func NewGuard(l Limits) *Guard {
+	return &Guard{
+		counter: NewCounter(l),
+	}
+}
+
+// Check checks the next lexeme of the document.
+// Panics with the document error if some limit is exceeded.
+func (g *Guard) Check(lex lexeme.LexEvent) {
+	if err := g.check(lex); err != nil {
+		e := errors.NewDocumentError(lex.File(), err)
+		e.SetIndex(lex.Begin())
+		panic(e)
+	}
+}
+
+func (g *Guard) check(lex lexeme.LexEvent) errors.Err {
+	if err := g.counter.Lexemes(1); err != nil {
+		return err
+	}
+
+	switch lex.Type() { //nolint:exhaustive // Other lexemes don't affect limits.
+	case lexeme.ObjectBegin, lexeme.ArrayBegin:
+		return g.counter.Enter()
+
+	case lexeme.ObjectEnd, lexeme.ArrayEnd:
+		g.counter.Leave()
+
+	case lexeme.ObjectKeyBegin:
+		return g.counter.Key()
+
+	case lexeme.ArrayItemBegin:
+		return g.counter.Item()
+
+	case lexeme.LiteralEnd, lexeme.ObjectKeyEnd:
+		if v := lex.Value(); len(v) >= 2 && v[0] == '"' {
+			return g.counter.String(uint(len(v) - 2))
+		}
+	}
+	return nil
+}
+
+func newErr(code errors.ErrorCode, limit uint) errors.Err {
+	return errors.Format(code, strconv.FormatUint(uint64(limit), 10))
+}
@@ -0,0 +1,190 @@
+package limits
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+	"github.com/jsightapi/jsight-schema-go-library/errors"
+	"github.com/jsightapi/jsight-schema-go-library/fs"
+	"github.com/jsightapi/jsight-schema-go-library/internal/lexeme"
+)
+
+func TestLimits_IsZero(t *testing.T) {
+	assert.True(t, Limits{}.IsZero())
+	assert.False(t, Limits{MaxDepth: 1}.IsZero())
+}
+
+func TestLimits_Stricter(t *testing.T) {
+	actual := Limits{MaxDepth: 2, MaxStringLength: 10, MaxLexemes: 5}.
+		Stricter(Limits{MaxDepth: 3, MaxStringLength: 5, MaxKeysPerObject: 1})
+	assert.Equal(t, Limits{MaxDepth: 2, MaxStringLength: 5, MaxKeysPerObject: 1, MaxLexemes: 5}, actual)
+}
+
+func TestCounter(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		c := NewCounter(Limits{MaxDepth: 1, MaxStringLength: 3, MaxKeysPerObject: 1, MaxLexemes: 8})
+		assert.Nil(t, c.Lexemes(2))
+		assert.Nil(t, c.Enter())
+		assert.Nil(t, c.Lexemes(4))
+		assert.Nil(t, c.Key())
+		assert.Nil(t, c.String(3))
+		assert.Nil(t, c.Lexemes(2))
+		c.Leave()
+		assert.Nil(t, c.Enter())
+		assert.Nil(t, c.Depth(1))
+		assert.Nil(t, c.Keys(1))
+		assert.Nil(t, c.Items(100))
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		cc := map[string]struct {
+			limits   Limits
+			fn       func(c *Counter) errors.Err
+			expected errors.ErrorCode
+		}{
+			"depth": {
+				Limits{MaxDepth: 1},
+				func(c *Counter) errors.Err {
+					_ = c.Enter()
+					return c.Enter()
+				},
+				errors.ErrDepthLimitExceeded,
+			},
+			"depth without entering": {
+				Limits{MaxDepth: 2},
+				func(c *Counter) errors.Err { return c.Depth(3) },
+				errors.ErrDepthLimitExceeded,
+			},
+			"string length": {
+				Limits{MaxStringLength: 3},
+				func(c *Counter) errors.Err { return c.String(4) },
+				errors.ErrStringLengthLimitExceeded,
+			},
+			"keys": {
+				Limits{MaxKeysPerObject: 1},
+				func(c *Counter) errors.Err {
+					_ = c.Enter()
+					_ = c.Key()
+					return c.Key()
+				},
+				errors.ErrKeysLimitExceeded,
+			},
+			"keys without entering": {
+				Limits{MaxKeysPerObject: 2},
+				func(c *Counter) errors.Err { return c.Keys(3) },
+				errors.ErrKeysLimitExceeded,
+			},
+			"array length": {
+				Limits{MaxArrayLength: 1},
+				func(c *Counter) errors.Err {
+					_ = c.Enter()
+					_ = c.Item()
+					return c.Item()
+				},
+				errors.ErrArrayLengthLimitExceeded,
+			},
+			"array length without entering": {
+				Limits{MaxArrayLength: 2},
+				func(c *Counter) errors.Err { return c.Items(3) },
+				errors.ErrArrayLengthLimitExceeded,
+			},
+			"lexemes": {
+				Limits{MaxLexemes: 3},
+				func(c *Counter) errors.Err {
+					_ = c.Lexemes(2)
+					return c.Lexemes(2)
+				},
+				errors.ErrLexemesLimitExceeded,
+			},
+		}
+
+		for name, c := range cc {
+			t.Run(name, func(t *testing.T) {
+				err := c.fn(NewCounter(c.limits))
+				require.NotNil(t, err)
+				assert.Equal(t, c.expected, err.Code())
+			})
+		}
+	})
+}
+
+func TestGuard_Check(t *testing.T) {
+	// Lexemes of the `{"foo": ["bar", 1]}` document.
+	file := fs.NewFile("", `{"foo": ["bar", 1]}`)
+	lex := func(t lexeme.LexEventType, begin, end bytes.Index) lexeme.LexEvent {
+		return lexeme.NewLexEvent(t, begin, end, file)
+	}
+	lexemes := []lexeme.LexEvent{
+		lex(lexeme.ObjectBegin, 0, 0),
+		lex(lexeme.ObjectKeyBegin, 1, 1),
+		lex(lexeme.ObjectKeyEnd, 1, 5),
+		lex(lexeme.ObjectValueBegin, 8, 8),
+		lex(lexeme.ArrayBegin, 8, 8),
+		lex(lexeme.ArrayItemBegin, 9, 9),
+		lex(lexeme.LiteralBegin, 9, 9),
+		lex(lexeme.LiteralEnd, 9, 13),
+		lex(lexeme.ArrayItemEnd, 9, 13),
+		lex(lexeme.ArrayItemBegin, 16, 16),
+		lex(lexeme.LiteralBegin, 16, 16),
+		lex(lexeme.LiteralEnd, 16, 16),
+		lex(lexeme.ArrayItemEnd, 16, 16),
+		lex(lexeme.ArrayEnd, 8, 17),
+		lex(lexeme.ObjectValueEnd, 8, 17),
+		lex(lexeme.ObjectEnd, 0, 18),
+	}
+
+	check := func(l Limits) {
+		g := NewGuard(l)
+		for _, lex := range lexemes {
+			g.Check(lex)
+		}
+	}
+
+	t.Run("positive", func(t *testing.T) {
+		cc := map[string]Limits{
+			"no limits":     {},
+			"depth":         {MaxDepth: 2},
+			"string length": {MaxStringLength: 3},
+			"keys":          {MaxKeysPerObject: 1},
+			"array length":  {MaxArrayLength: 2},
+			"lexemes":       {MaxLexemes: 16},
+		}
+
+		for name, l := range cc {
+			t.Run(name, func(t *testing.T) {
+				assert.NotPanics(t, func() {
+					check(l)
+				})
+			})
+		}
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		cc := map[string]struct {
+			limits   Limits
+			expected errors.ErrorCode
+			index    bytes.Index
+		}{
+			"depth":         {Limits{MaxDepth: 1}, errors.ErrDepthLimitExceeded, 8},
+			"string length": {Limits{MaxStringLength: 2}, errors.ErrStringLengthLimitExceeded, 1},
+			"array length":  {Limits{MaxArrayLength: 1}, errors.ErrArrayLengthLimitExceeded, 16},
+			"lexemes":       {Limits{MaxLexemes: 15}, errors.ErrLexemesLimitExceeded, 0},
+		}
+
+		for name, c := range cc {
+			t.Run(name, func(t *testing.T) {
+				defer func() {
+					err, ok := recover().(errors.DocumentError)
+					require.True(t, ok)
+					assert.Equal(t, c.expected, err.Code())
+					assert.Equal(t, c.index, err.Index())
+				}()
+
+				check(c.limits)
+			})
+		}
+	})
+}
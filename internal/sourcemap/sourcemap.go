@@ -0,0 +1,54 @@
+package sourcemap
+
+import (
+	"sort"
+
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+)
+
+// Map maps positions in the generated content back to the original one.
+// It is used by documents which convert their content to JSON before scanning.
+type Map struct {
+	entries []entry
+}
+
+type entry struct {
+	// generated an index of the first byte of the segment in the generated
+	// content.
+	generated bytes.Index
+
+	// original an index of the related byte in the original content.
+	original bytes.Index
+
+	// verbatim true if the segment is copied from the original content as is,
+	// so positions inside the segment can be mapped one to one.
+	verbatim bool
+}
+
+// Add binds the beginning of the segment in the generated content with the
+// position in the original content. Segments should be added in ascending
+// order of the generated position.
+func (m *Map) Add(generated, original bytes.Index, verbatim bool) {
+	m.entries = append(m.entries, entry{
+		generated: generated,
+		original:  original,
+		verbatim:  verbatim,
+	})
+}
+
+// Find returns the position in the original content for the specified position
+// in the generated content.
+func (m Map) Find(i bytes.Index) bytes.Index {
+	n := sort.Search(len(m.entries), func(j int) bool {
+		return m.entries[j].generated > i
+	})
+	if n == 0 {
+		return i
+	}
+
+	e := m.entries[n-1]
+	if e.verbatim {
+		return e.original + (i - e.generated)
+	}
+	return e.original
+}
@@ -0,0 +1,31 @@
+package sourcemap
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+)
+
+func TestMap_Find(t *testing.T) {
+	var m Map
+	m.Add(2, 3, false)
+	m.Add(4, 10, true)
+	m.Add(10, 20, false)
+
+	cc := map[bytes.Index]bytes.Index{
+		0:  0,
+		1:  1,
+		2:  3,
+		3:  3,
+		4:  10,
+		7:  13,
+		10: 20,
+		15: 20,
+	}
+
+	for given, expected := range cc {
+		assert.Equal(t, expected, m.Find(given), given)
+	}
+}
@@ -0,0 +1,404 @@
+package jschema
+
+import (
+	"context"
+	stdJson "encoding/json"
+	stdErrors "errors"
+	"io"
+
+	jschema "github.com/jsightapi/jsight-schema-go-library"
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+	"github.com/jsightapi/jsight-schema-go-library/errors"
+	"github.com/jsightapi/jsight-schema-go-library/formats/json"
+	"github.com/jsightapi/jsight-schema-go-library/fs"
+	"github.com/jsightapi/jsight-schema-go-library/internal/lexeme"
+	"github.com/jsightapi/jsight-schema-go-library/internal/limits"
+	"github.com/jsightapi/jsight-schema-go-library/internal/panics"
+	"github.com/jsightapi/jsight-schema-go-library/internal/sourcemap"
+	"github.com/jsightapi/jsight-schema-go-library/notations/internal"
+	internalSchema "github.com/jsightapi/jsight-schema-go-library/notations/jschema/internal/schema"
+	"github.com/jsightapi/jsight-schema-go-library/notations/jschema/internal/schema/constraint"
+	"github.com/jsightapi/jsight-schema-go-library/notations/jschema/internal/validator"
+)
+
+// Coerce validates the document like ValidateWithOptions with the CoerceStrings
+// option, and returns its JSON content in which strings are replaced with the
+// values expected by the schema. For example, the form document
+// `page=1&tags=a` for the schema `{"page": 1, "tags": ["tag"]}` is coerced to
+// `{"page":1,"tags":["a"]}`.
+func (s *Schema) Coerce(document jschema.Document, oo ...ValidateOption) (content []byte, err error) {
+	defer func() {
+		err = panics.Handle(recover(), err)
+		if m, ok := document.(errorMapper); ok {
+			err = m.MapError(err)
+		}
+	}()
+	if err := s.compile(); err != nil {
+		return nil, err
+	}
+
+	if err := checkDocumentType(document); err != nil {
+		return nil, err
+	}
+
+	var opts internalSchema.ValidationOptions
+	for _, o := range oo {
+		o(&opts)
+	}
+
+	return s.coerce(context.Background(), document, opts)
+}
+
+// coerce reads the whole document, replaces its strings with the values
+// expected by the schema, and validates the resulting content.
+func (s *Schema) coerce(
+	ctx context.Context,
+	document jschema.Document,
+	opts internalSchema.ValidationOptions,
+) (content []byte, err error) {
+	doc := &recordingDocument{Document: document}
+
+	var guard *limits.Guard
+	if !opts.Limits.IsZero() {
+		setLimits(document, opts.Limits)
+		guard = limits.NewGuard(opts.Limits)
+	}
+
+	for {
+		if err := checkContext(ctx); err != nil {
+			return nil, err
+		}
+
+		lex, err := doc.NextLexeme()
+		if err != nil {
+			if stdErrors.Is(err, io.EOF) {
+				break
+			}
+			return nil, err
+		}
+
+		if guard != nil {
+			guard.Check(lex) // can panic
+		}
+	}
+
+	if len(doc.lexemes) == 0 {
+		return nil, internal.NewValidatorError(errors.ErrEmptyJson, "")
+	}
+
+	c := coercer{
+		rootSchema: *s.inner,
+		lexemes:    doc.lexemes,
+	}
+	c.value(s.inner.RootNode())
+
+	coerced := c.apply(document)
+
+	defer func() {
+		err = coerced.MapError(panics.Handle(recover(), err))
+	}()
+
+	if err := s.validate(ctx, coerced, opts); err != nil {
+		return nil, err
+	}
+	return coerced.content, nil
+}
+
+// coercer walks through lexemes of the document together with nodes of the
+// schema, and collects strings which should be replaced with the values
+// expected by the schema.
+type coercer struct {
+	// rootSchema the scheme from which it is possible to receive type by their
+	// name.
+	rootSchema internalSchema.Schema
+
+	lexemes []lexeme.LexEvent
+
+	// pos the index of the current lexeme.
+	pos int
+
+	// replacements are collected in the order of the document.
+	replacements []coercion
+}
+
+// coercion the content which should replace the bytes of the document from
+// begin to end (exclusive).
+type coercion struct {
+	begin   bytes.Index
+	end     bytes.Index
+	content []byte
+}
+
+// value processes the value which begins at the current lexeme. The node might
+// be nil, then the value is skipped.
+func (c *coercer) value(node internalSchema.Node) {
+	if node == nil {
+		c.pos = valueEnd(c.lexemes, c.pos) + 1
+		return
+	}
+
+	if c.lexemes[c.pos].Type() == lexeme.LiteralBegin && c.literal(node) {
+		return
+	}
+
+	if tl, ok := node.Constraint(constraint.TypesListConstraintType).(*constraint.TypesList); ok {
+		c.typesList(tl)
+		return
+	}
+
+	switch n := node.(type) {
+	case *internalSchema.ObjectNode:
+		if c.lexemes[c.pos].Type() == lexeme.ObjectBegin {
+			c.object(n)
+			return
+		}
+	case *internalSchema.ArrayNode:
+		switch c.lexemes[c.pos].Type() { //nolint:exhaustive // Other values can't be coerced.
+		case lexeme.ArrayBegin:
+			c.array(n)
+			return
+		case lexeme.LiteralBegin:
+			c.wrap(n)
+			return
+		}
+	}
+	c.pos = valueEnd(c.lexemes, c.pos) + 1
+}
+
+// literal coerces the literal value if it's required by the node. Returns false
+// if the literal isn't valid for the node either way.
+func (c *coercer) literal(node internalSchema.Node) bool {
+	end := valueEnd(c.lexemes, c.pos)
+	lex := c.lexemes[end]
+
+	v, ok := c.coerceLiteral(node, lex.Value())
+	if !ok && !isCoercedLiteralNode(node) {
+		return false
+	}
+
+	if string(v) != lex.Value().String() {
+		c.replacements = append(c.replacements, coercion{lex.Begin(), lex.End() + 1, v})
+	}
+	c.pos = end + 1
+	return true
+}
+
+// coerceLiteral returns the literal value which is valid for the node: the
+// value itself, or the value converted from the string. Returns false if there
+// is no such value, then the converted value is preferred, so the error of
+// validation describes it, e.g. the integer which is less than the minimum.
+func (c *coercer) coerceLiteral(node internalSchema.Node, value bytes.Bytes) (bytes.Bytes, bool) {
+	if validator.IsValidValue(node, c.rootSchema, value) {
+		return value, true
+	}
+
+	v, ok := literalFromString(value)
+	if !ok {
+		return value, false
+	}
+	return v, validator.IsValidValue(node, c.rootSchema, v)
+}
+
+// isCoercedLiteralNode returns true if the invalid literal should be coerced
+// for the node anyway. Nodes with types and arrays are processed separately.
+func isCoercedLiteralNode(node internalSchema.Node) bool {
+	if _, ok := node.(*internalSchema.ArrayNode); ok {
+		return false
+	}
+	return node.Constraint(constraint.TypesListConstraintType) == nil
+}
+
+// literalFromString returns the number, boolean or null which is written in the
+// JSON string. Returns false if the value isn't a string or it contains
+// something else.
+func literalFromString(value bytes.Bytes) (bytes.Bytes, bool) {
+	var s string
+	if len(value) == 0 || value[0] != '"' || stdJson.Unmarshal(value, &s) != nil {
+		return nil, false
+	}
+
+	switch s {
+	case "true", "false", "null":
+		return bytes.Bytes(s), true
+	}
+
+	if isJSONNumber(s) {
+		return bytes.Bytes(s), true
+	}
+	return nil, false
+}
+
+// isJSONNumber returns true if the string is a valid JSON number without
+// surrounding spaces.
+func isJSONNumber(s string) bool {
+	if s == "" || (s[0] != '-' && (s[0] < '0' || s[0] > '9')) || s[len(s)-1] < '0' || s[len(s)-1] > '9' {
+		return false
+	}
+	return stdJson.Valid([]byte(s))
+}
+
+// typesList coerces the value for the first type of the user type or the "or"
+// rule which accepts the coerced value. The value isn't coerced if there is no
+// such type.
+func (c *coercer) typesList(tl *constraint.TypesList) {
+	begin, end := c.pos, valueEnd(c.lexemes, c.pos)
+	from := len(c.replacements)
+
+	for _, name := range tl.Names() {
+		root := c.rootSchema.MustType(name).RootNode()
+
+		c.pos = begin
+		c.value(root)
+		if validator.IsValidValue(root, c.rootSchema, c.content(begin, end, from)) {
+			return
+		}
+		c.replacements = c.replacements[:from]
+	}
+	c.pos = end + 1
+}
+
+func (c *coercer) object(node *internalSchema.ObjectNode) {
+	var key string
+	for c.pos++; ; {
+		lex := c.lexemes[c.pos]
+		switch lex.Type() { //nolint:exhaustive // Other lexemes can't be found here.
+		case lexeme.ObjectKeyEnd:
+			key = lex.Value().Unquote().String()
+			c.pos++
+
+		case lexeme.ObjectValueBegin:
+			c.pos++
+			child, _ := node.Child(key, false)
+			c.value(child)
+
+		case lexeme.ObjectEnd:
+			c.pos++
+			return
+
+		default:
+			c.pos++
+		}
+	}
+}
+
+func (c *coercer) array(node *internalSchema.ArrayNode) {
+	var i uint
+	for c.pos++; ; {
+		switch c.lexemes[c.pos].Type() { //nolint:exhaustive // Other lexemes can't be found here.
+		case lexeme.ArrayItemBegin:
+			c.pos++
+			var child internalSchema.Node
+			if node.Len() != 0 && (!node.IsTuple() || i < uint(node.Len())) {
+				child = node.Child(i)
+			}
+			c.value(child)
+			i++
+
+		case lexeme.ArrayEnd:
+			c.pos++
+			return
+
+		default:
+			c.pos++
+		}
+	}
+}
+
+// wrap replaces the string with the array which contains the single item, which
+// is also coerced if required.
+func (c *coercer) wrap(node *internalSchema.ArrayNode) {
+	end := valueEnd(c.lexemes, c.pos)
+	lex := c.lexemes[end]
+	c.pos = end + 1
+
+	item := lex.Value()
+	if item[0] != '"' {
+		return
+	}
+
+	if node.Len() != 0 {
+		item, _ = c.coerceLiteral(node.Child(0), item)
+	}
+
+	content := make([]byte, 0, len(item)+2)
+	content = append(content, '[')
+	content = append(content, item...)
+	content = append(content, ']')
+	c.replacements = append(c.replacements, coercion{lex.Begin(), lex.End() + 1, content})
+}
+
+// content returns the content of the value from the begin to the end lexemes
+// with the replacements starting from specified one.
+func (c *coercer) content(begin, end, from int) bytes.Bytes {
+	src := c.lexemes[0].File().Content()
+	prev := c.lexemes[begin].Begin()
+
+	var res bytes.Bytes
+	for _, r := range c.replacements[from:] {
+		res = append(res, src[prev:r.begin]...)
+		res = append(res, r.content...)
+		prev = r.end
+	}
+	return append(res, src[prev:c.lexemes[end].End()+1]...)
+}
+
+// apply returns the document with the content of the original document in
+// which all collected replacements are made.
+func (c *coercer) apply(original jschema.Document) *coercedDocument {
+	file := c.lexemes[0].File()
+	src := file.Content()
+
+	d := &coercedDocument{
+		original:     original,
+		originalFile: file,
+	}
+
+	var prev bytes.Index
+	for _, r := range c.replacements {
+		d.content = append(d.content, src[prev:r.begin]...)
+		d.sourceMap.Add(bytes.Index(len(d.content)), r.begin, false)
+		d.content = append(d.content, r.content...)
+		d.sourceMap.Add(bytes.Index(len(d.content)), r.end, true)
+		prev = r.end
+	}
+	d.content = append(d.content, src[prev:]...)
+
+	d.file = fs.NewFile(file.Name(), d.content)
+	d.Document = json.FromFile(d.file)
+	return d
+}
+
+// coercedDocument the JSON document with the coerced content. Positions of
+// errors are mapped back to the original document.
+type coercedDocument struct {
+	jschema.Document
+
+	file    *fs.File
+	content []byte
+
+	// original the document which is coerced.
+	original jschema.Document
+
+	// originalFile the file of lexemes of the original document.
+	originalFile *fs.File
+
+	// sourceMap maps positions in the coerced content to the content of the
+	// original file.
+	sourceMap sourcemap.Map
+}
+
+func (d *coercedDocument) MapError(err error) error {
+	var e errors.DocumentError
+	if stdErrors.As(err, &e) && e.File() == d.file {
+		e.SetFile(d.originalFile)
+		if e.HasIndex() {
+			e.SetIndex(d.sourceMap.Find(e.Index()))
+		}
+		err = e
+	}
+
+	if m, ok := d.original.(errorMapper); ok {
+		return m.MapError(err)
+	}
+	return err
+}
@@ -0,0 +1,274 @@
+package jschema
+
+import (
+	"context"
+	stdJson "encoding/json"
+	"sort"
+
+	jschema "github.com/jsightapi/jsight-schema-go-library"
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+	"github.com/jsightapi/jsight-schema-go-library/internal/lexeme"
+	"github.com/jsightapi/jsight-schema-go-library/internal/panics"
+	internalSchema "github.com/jsightapi/jsight-schema-go-library/notations/jschema/internal/schema"
+	"github.com/jsightapi/jsight-schema-go-library/notations/jschema/internal/schema/constraint"
+	"github.com/jsightapi/jsight-schema-go-library/notations/jschema/internal/validator"
+)
+
+// ApplyDefaults validates the document and returns its JSON content in which
+// missing optional keys are filled in with values of the "default" rule. Keys
+// of the document keep their order, the added keys follow them in the order of
+// the schema. Nested objects, arrays and user types are processed recursively.
+func (s *Schema) ApplyDefaults(document jschema.Document) (content []byte, err error) {
+	defer func() {
+		err = panics.Handle(recover(), err)
+		if m, ok := document.(errorMapper); ok {
+			err = m.MapError(err)
+		}
+	}()
+	if err := s.compile(); err != nil {
+		return nil, err
+	}
+
+	if err := checkDocumentType(document); err != nil {
+		return nil, err
+	}
+
+	doc := &recordingDocument{Document: document}
+	if err := s.validate(context.Background(), doc, internalSchema.ValidationOptions{}); err != nil {
+		return nil, err
+	}
+
+	a := defaultsApplier{
+		rootSchema: *s.inner,
+		lexemes:    doc.lexemes,
+	}
+	a.value(s.inner.RootNode())
+	return a.apply(), nil
+}
+
+// recordingDocument remembers all read lexemes of the document.
+type recordingDocument struct {
+	jschema.Document
+	lexemes []lexeme.LexEvent
+}
+
+func (d *recordingDocument) NextLexeme() (lexeme.LexEvent, error) {
+	lex, err := d.Document.NextLexeme()
+	if err == nil {
+		d.lexemes = append(d.lexemes, lex)
+	}
+	return lex, err
+}
+
+// defaultsApplier walks through lexemes of the valid document together with
+// nodes of the schema, and collects missing keys which have default values.
+type defaultsApplier struct {
+	// rootSchema the scheme from which it is possible to receive type by their
+	// name.
+	rootSchema internalSchema.Schema
+
+	lexemes []lexeme.LexEvent
+
+	// pos the index of the current lexeme.
+	pos int
+
+	insertions []defaultsInsertion
+}
+
+// defaultsInsertion the content which should be inserted into the document at
+// the specified index.
+type defaultsInsertion struct {
+	index   bytes.Index
+	content []byte
+}
+
+// value processes the value which begins at the current lexeme. The node might
+// be nil, then the value is skipped.
+func (a *defaultsApplier) value(node internalSchema.Node) {
+	node = a.resolveType(node)
+
+	switch n := node.(type) {
+	case *internalSchema.ObjectNode:
+		if a.lexemes[a.pos].Type() == lexeme.ObjectBegin {
+			a.object(n)
+			return
+		}
+	case *internalSchema.ArrayNode:
+		if a.lexemes[a.pos].Type() == lexeme.ArrayBegin {
+			a.array(n)
+			return
+		}
+	}
+	a.pos = a.valueEnd() + 1
+}
+
+// resolveType returns the root node of the user type, or of the first type of
+// the "or" rule, which accepts the current value. Returns nil if there is no
+// such type.
+func (a *defaultsApplier) resolveType(node internalSchema.Node) internalSchema.Node {
+	for node != nil {
+		tl, ok := node.Constraint(constraint.TypesListConstraintType).(*constraint.TypesList)
+		if !ok {
+			return node
+		}
+
+		value := a.lexemes[a.valueEnd()].Value()
+		node = nil
+		for _, name := range tl.Names() {
+			root := a.rootSchema.MustType(name).RootNode()
+			if validator.IsValidValue(root, a.rootSchema, value) {
+				node = root
+				break
+			}
+		}
+	}
+	return nil
+}
+
+func (a *defaultsApplier) object(node *internalSchema.ObjectNode) {
+	insertAt := a.lexemes[a.pos].Begin() + 1
+	hasKeys := false
+	found := map[string]struct{}{}
+
+	var key string
+	for a.pos++; ; {
+		lex := a.lexemes[a.pos]
+		switch lex.Type() { //nolint:exhaustive // Other lexemes can't be found here.
+		case lexeme.ObjectKeyEnd:
+			key = lex.Value().Unquote().String()
+			found[key] = struct{}{}
+			a.pos++
+
+		case lexeme.ObjectValueBegin:
+			a.pos++
+			child, _ := node.Child(key, false)
+			a.value(child)
+
+		case lexeme.ObjectValueEnd:
+			insertAt = lex.End() + 1
+			hasKeys = true
+			a.pos++
+
+		case lexeme.ObjectEnd:
+			a.pos++
+			a.addMissingKeys(node, found, insertAt, hasKeys)
+			return
+
+		default:
+			a.pos++
+		}
+	}
+}
+
+// addMissingKeys adds the keys of the object node which aren't found in the
+// document, and have default values.
+func (a *defaultsApplier) addMissingKeys(
+	node *internalSchema.ObjectNode,
+	found map[string]struct{},
+	insertAt bytes.Index,
+	hasKeys bool,
+) {
+	var content []byte
+	for _, k := range node.Keys().Data {
+		if _, ok := found[k.Key]; ok || k.IsShortcut {
+			continue
+		}
+
+		d, ok := node.Children()[k.Index].Constraint(constraint.DefaultConstraintType).(*constraint.Default)
+		if !ok {
+			continue
+		}
+
+		key, err := stdJson.Marshal(k.Key)
+		if err != nil {
+			panic(err)
+		}
+
+		if hasKeys {
+			content = append(content, ", "...)
+		}
+		content = append(content, key...)
+		content = append(content, ": "...)
+		content = append(content, d.Value()...)
+		hasKeys = true
+	}
+
+	if content != nil {
+		a.insertions = append(a.insertions, defaultsInsertion{insertAt, content})
+	}
+}
+
+func (a *defaultsApplier) array(node *internalSchema.ArrayNode) {
+	var i uint
+	for a.pos++; ; {
+		switch a.lexemes[a.pos].Type() { //nolint:exhaustive // Other lexemes can't be found here.
+		case lexeme.ArrayItemBegin:
+			a.pos++
+			var child internalSchema.Node
+			if node.Len() != 0 && (!node.IsTuple() || i < uint(node.Len())) {
+				child = node.Child(i)
+			}
+			a.value(child)
+			i++
+
+		case lexeme.ArrayEnd:
+			a.pos++
+			return
+
+		default:
+			a.pos++
+		}
+	}
+}
+
+// valueEnd returns the index of the last lexeme of the value which begins at
+// the current lexeme.
+func (a *defaultsApplier) valueEnd() int {
+	return valueEnd(a.lexemes, a.pos)
+}
+
+// valueEnd returns the index of the last lexeme of the value which begins at
+// the lexeme with specified index.
+func valueEnd(lexemes []lexeme.LexEvent, pos int) int {
+	depth := 0
+	for i := pos; i < len(lexemes); i++ {
+		switch lexemes[i].Type() { //nolint:exhaustive // Other lexemes don't affect the depth.
+		case lexeme.ObjectBegin, lexeme.ArrayBegin, lexeme.LiteralBegin:
+			depth++
+		case lexeme.ObjectEnd, lexeme.ArrayEnd, lexeme.LiteralEnd:
+			depth--
+		}
+		if depth == 0 {
+			return i
+		}
+	}
+	return len(lexemes) - 1
+}
+
+// apply returns the content of the document with all collected insertions.
+func (a *defaultsApplier) apply() []byte {
+	if len(a.lexemes) == 0 {
+		return nil
+	}
+
+	src := a.lexemes[0].File().Content()
+
+	sort.SliceStable(a.insertions, func(i, j int) bool {
+		return a.insertions[i].index < a.insertions[j].index
+	})
+
+	var size int
+	for _, in := range a.insertions {
+		size += len(in.content)
+	}
+
+	res := make([]byte, 0, len(src)+size)
+	var prev bytes.Index
+	for _, in := range a.insertions {
+		res = append(res, src[prev:in.index]...)
+		res = append(res, in.content...)
+		prev = in.index
+	}
+	res = append(res, src[prev:]...)
+	return res
+}
@@ -0,0 +1,68 @@
+package jschema
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/jsightapi/jsight-schema-go-library/internal/lexeme"
+)
+
+// documentPath tracks the position of the current lexeme in the validated
+// document as a JSON Pointer (RFC 6901).
+type documentPath struct {
+	frames []documentPathFrame
+}
+
+type documentPathFrame struct {
+	key     string
+	index   int
+	isArray bool
+	hasKey  bool
+}
+
+func (p *documentPath) feed(lex lexeme.LexEvent) {
+	switch lex.Type() { //nolint:exhaustive // Other lexemes don't affect the path.
+	case lexeme.ObjectBegin:
+		p.frames = append(p.frames, documentPathFrame{})
+
+	case lexeme.ObjectKeyBegin:
+		p.top().hasKey = false
+
+	case lexeme.ObjectKeyEnd:
+		f := p.top()
+		f.key = lex.Value().Unquote().String()
+		f.hasKey = true
+
+	case lexeme.ArrayBegin:
+		p.frames = append(p.frames, documentPathFrame{isArray: true, index: -1})
+
+	case lexeme.ArrayItemBegin:
+		p.top().index++
+
+	case lexeme.ObjectEnd, lexeme.ArrayEnd:
+		p.frames = p.frames[:len(p.frames)-1]
+	}
+}
+
+func (p *documentPath) top() *documentPathFrame {
+	return &p.frames[len(p.frames)-1]
+}
+
+var pointerEscaper = strings.NewReplacer("~", "~0", "/", "~1")
+
+// String returns JSON Pointer to the current lexeme.
+func (p documentPath) String() string {
+	var b strings.Builder
+	for _, f := range p.frames {
+		if f.isArray {
+			if f.index >= 0 {
+				b.WriteByte('/')
+				b.WriteString(strconv.Itoa(f.index))
+			}
+		} else if f.hasKey {
+			b.WriteByte('/')
+			b.WriteString(pointerEscaper.Replace(f.key))
+		}
+	}
+	return b.String()
+}
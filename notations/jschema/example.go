@@ -157,7 +157,8 @@ func (b *exampleBuilder) buildExampleForMixedValueNode(node *internalSchema.Mixe
 
 	t, ok := b.types[typeName]
 	if !ok {
-		return nil, errors.Format(errors.ErrTypeNotFound, typeName)
+		return nil, errors.Format(errors.ErrTypeNotFound, typeName).
+			WithSuggestion(internalSchema.SuggestTypeName(typeName, b.types))
 	}
 	return b.Build(t.Schema().RootNode())
 }
@@ -261,7 +262,8 @@ func buildExampleForMixedValueNode(
 
 	t, ok := types[typeName]
 	if !ok {
-		return nil, errors.Format(errors.ErrTypeNotFound, typeName)
+		return nil, errors.Format(errors.ErrTypeNotFound, typeName).
+			WithSuggestion(internalSchema.SuggestTypeName(typeName, types))
 	}
 	return buildExample(t.Schema().RootNode(), types)
 }
@@ -1,11 +1,13 @@
 package checker
 
 import (
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
 	"github.com/jsightapi/jsight-schema-go-library/errors"
 	"github.com/jsightapi/jsight-schema-go-library/internal/json"
 	"github.com/jsightapi/jsight-schema-go-library/internal/lexeme"
 	"github.com/jsightapi/jsight-schema-go-library/notations/jschema/internal/schema"
 	"github.com/jsightapi/jsight-schema-go-library/notations/jschema/internal/schema/constraint"
+	"github.com/jsightapi/jsight-schema-go-library/notations/jschema/internal/validator"
 )
 
 // Checks the SAMPLE SCHEMA and all TYPES for compliance with all RULES.
@@ -79,6 +81,8 @@ func (c checkSchema) checkNode(node schema.Node, ss map[string]schema.Type) {
 		c.checkLinksOfNode(node, ss) // can panic
 		c.checkArrayItems(node)
 		c.checkArrayNode(node)
+		c.checkAdditionalItemsConstraint(node, ss)
+		c.checkContainsConstraint(node, ss)
 	case *schema.ObjectNode:
 		c.checkCompatibilityOfConstraints(node)
 		c.checkLinksOfNode(node, ss) // can panic
@@ -86,6 +90,10 @@ func (c checkSchema) checkNode(node schema.Node, ss map[string]schema.Type) {
 			panic(err)
 		}
 		c.checkAdditionalPropertiesConstraint(node, ss)
+		c.checkPropertyNamesConstraint(node, ss)
+		c.checkDependentRequiredConstraint(node)
+		c.checkWhenConstraint(node, ss)
+		c.checkObjectNode(node)
 	case *schema.MixedNode:
 		c.checkCompatibilityOfConstraints(node)
 		c.checkLinksOfNode(node, ss) // can panic
@@ -96,6 +104,10 @@ func (c checkSchema) checkNode(node schema.Node, ss map[string]schema.Type) {
 		panic(errors.ErrImpossible)
 	}
 
+	c.checkDiscriminatorConstraint(node, ss)
+	c.checkNotConstraint(node)
+	c.checkDefaultConstraint(node)
+
 	if branchingNode, ok := node.(schema.BranchNode); ok {
 		for _, child := range branchingNode.Children() {
 			c.checkNode(child, ss) // can panic
@@ -148,7 +160,7 @@ func (c checkSchema) checkArrayItems(node schema.Node) {
 	}
 }
 
-func (checkSchema) checkArrayNode(node schema.Node) {
+func (c checkSchema) checkArrayNode(node schema.Node) {
 	arrayNode := node.(*schema.ArrayNode) //nolint:errcheck // We're sure about this type.
 
 	length := uint(arrayNode.Len())
@@ -160,6 +172,78 @@ func (checkSchema) checkArrayNode(node schema.Node) {
 	if cnstr := arrayNode.Constraint(constraint.MaxItemsConstraintType); cnstr != nil {
 		cnstr.(*constraint.MaxItems).ValidateTheArray(length)
 	}
+
+	if cnstr := arrayNode.Constraint(constraint.UniqueItemsConstraintType); cnstr != nil {
+		c.checkUniqueItems(arrayNode, cnstr.(*constraint.UniqueItems))
+	}
+}
+
+// checkUniqueItems checks the example of the array against the "uniqueItems"
+// constraint. Items which examples depend on user types are skipped.
+func (checkSchema) checkUniqueItems(node *schema.ArrayNode, c *constraint.UniqueItems) {
+	set := c.NewSet()
+	if set == nil {
+		return
+	}
+
+	for _, child := range node.Children() {
+		example, ok := exampleOfNode(child)
+		if !ok {
+			set.Skip()
+			continue
+		}
+
+		func() {
+			defer lexeme.CatchLexEventError(child.BasisLexEventOfSchemaForNode())
+			set.Add(example)
+		}()
+	}
+}
+
+// exampleOfNode returns the JSON example of the node. Returns false if the
+// example depends on user types.
+func exampleOfNode(node schema.Node) (bytes.Bytes, bool) {
+	if node.Constraint(constraint.TypesListConstraintType) != nil {
+		return nil, false
+	}
+
+	switch node := node.(type) {
+	case *schema.LiteralNode:
+		return node.Value(), true
+
+	case *schema.ArrayNode:
+		b := bytes.Bytes{'['}
+		for i, child := range node.Children() {
+			ex, ok := exampleOfNode(child)
+			if !ok {
+				return nil, false
+			}
+			if i > 0 {
+				b = append(b, ',')
+			}
+			b = append(b, ex...)
+		}
+		return append(b, ']'), true
+
+	case *schema.ObjectNode:
+		b := bytes.Bytes{'{'}
+		for i, child := range node.Children() {
+			k := node.Key(i)
+			ex, ok := exampleOfNode(child)
+			if !ok || k.IsShortcut {
+				return nil, false
+			}
+			if i > 0 {
+				b = append(b, ',')
+			}
+			b = append(b, '"')
+			b = append(b, k.Key...)
+			b = append(b, '"', ':')
+			b = append(b, ex...)
+		}
+		return append(b, '}'), true
+	}
+	return nil, false
 }
 
 // check all constraints for compatibility with the json-type of the node
@@ -169,7 +253,11 @@ func (checkSchema) checkCompatibilityOfConstraints(node schema.Node) {
 
 	err := node.ConstraintMap().Each(func(k constraint.Type, v constraint.Constraint) error {
 		if !v.IsJsonTypeCompatible(node.Type()) && !isMixed && !isMixedValue {
-			return errors.Format(errors.ErrUnexpectedConstraint, v.Type().String(), node.RealType())
+			name := v.Type().String()
+			if c, ok := v.(*constraint.Custom); ok {
+				name = c.IncompatibleRule(node.Type())
+			}
+			return errors.Format(errors.ErrUnexpectedConstraint, name, node.RealType())
 		}
 		return nil
 	})
@@ -292,6 +380,243 @@ func (c *checkSchema) checkAdditionalPropertiesConstraint(node schema.Node, ss m
 	}
 }
 
+func (c *checkSchema) checkAdditionalItemsConstraint(node schema.Node, ss map[string]schema.Type) {
+	ai, ok := node.Constraint(constraint.AdditionalItemsConstraintType).(*constraint.AdditionalItems)
+	if ok && ai.Mode() == constraint.AdditionalPropertiesMustBeUserType {
+		getType(ai.TypeName().String(), c.rootSchema, ss)
+	}
+}
+
+func (c *checkSchema) checkContainsConstraint(node schema.Node, ss map[string]schema.Type) {
+	cc, ok := node.Constraint(constraint.ContainsConstraintType).(*constraint.Contains)
+	if ok && cc.HasType() {
+		getType(cc.TypeName(), c.rootSchema, ss) // can panic
+	}
+}
+
+func (c *checkSchema) checkPropertyNamesConstraint(node schema.Node, ss map[string]schema.Type) {
+	pn, ok := node.Constraint(constraint.PropertyNamesConstraintType).(*constraint.PropertyNames)
+	if !ok || pn.TypeName() == nil {
+		return
+	}
+
+	name := pn.TypeName().String()
+	root := getType(name, c.rootSchema, ss).RootNode() // can panic
+	if _, ok := root.(*schema.LiteralNode); !ok || root.Type() != json.TypeString {
+		panic(errors.Format(errors.ErrInvalidPropertyNamesType, name))
+	}
+}
+
+func (checkSchema) checkDependentRequiredConstraint(node *schema.ObjectNode) {
+	dr, ok := node.Constraint(constraint.DependentRequiredConstraintType).(*constraint.DependentRequired)
+	if !ok {
+		return
+	}
+
+	for _, k := range dr.Keys() {
+		if !isKeyAllowed(node, k) {
+			panic(errors.Format(errors.ErrUnknownKeyInDependentRequiredRule, k))
+		}
+
+		for _, d := range dr.Dependencies(k) {
+			if d == k {
+				panic(errors.Format(errors.ErrSelfDependencyInDependentRequiredRule, k))
+			}
+			if !isKeyAllowed(node, d) {
+				panic(errors.Format(errors.ErrUnknownKeyInDependentRequiredRule, d))
+			}
+		}
+	}
+}
+
+// checkWhenConstraint checks that every condition of the "when" constraint can
+// be triggered, conditions don't duplicate each other, and applied types are
+// objects.
+func (c *checkSchema) checkWhenConstraint(node *schema.ObjectNode, ss map[string]schema.Type) {
+	w, ok := node.Constraint(constraint.WhenConstraintType).(*constraint.When)
+	if !ok {
+		return
+	}
+
+	found := make(map[string]struct{}, len(w.Conditions()))
+	for _, cond := range w.Conditions() {
+		if !isKeyAllowed(node, cond.Key) {
+			panic(errors.Format(errors.ErrUnknownKeyInWhenRule, cond.Key))
+		}
+
+		if child, ok := node.Child(cond.Key, false); ok && !isValidLiteralValue(child, cond.Const) {
+			panic(errors.Format(errors.ErrInvalidConstInWhenRule, cond.Const.String(), cond.Key))
+		}
+
+		canonical, err := json.Canonical(cond.Const)
+		if err != nil {
+			panic(errors.Format(errors.ErrInvalidConstInWhenRule, cond.Const.String(), cond.Key))
+		}
+		id := cond.Key + "\x00" + canonical
+		if _, ok := found[id]; ok {
+			panic(errors.Format(errors.ErrDuplicateConditionInWhenRule, cond.Key, cond.Const.String()))
+		}
+		found[id] = struct{}{}
+
+		if _, ok := getType(cond.Then, c.rootSchema, ss).RootNode().(*schema.ObjectNode); !ok { // can panic
+			panic(errors.Format(errors.ErrInvalidWhenType, cond.Then))
+		}
+	}
+}
+
+// checkDiscriminatorConstraint checks that every type of the "or" rule is an
+// object with the discriminator key with the "const" rule, and maps values of
+// this key to types.
+func (c *checkSchema) checkDiscriminatorConstraint(node schema.Node, ss map[string]schema.Type) {
+	d, ok := node.Constraint(constraint.DiscriminatorConstraintType).(*constraint.Discriminator)
+	if !ok {
+		return
+	}
+
+	tl, ok := node.Constraint(constraint.TypesListConstraintType).(*constraint.TypesList)
+	if !ok {
+		panic(errors.Format(errors.ErrRuleWithoutOr, constraint.DiscriminatorConstraintType.String()))
+	}
+
+	for _, name := range tl.Names() {
+		root, ok := getType(name, c.rootSchema, ss).RootNode().(*schema.ObjectNode) // can panic
+		if !ok {
+			panic(errors.Format(errors.ErrInvalidDiscriminatorType, name, d.Key()))
+		}
+
+		child, ok := root.Child(d.Key(), false)
+		if !ok || child.Constraint(constraint.ConstConstraintType) == nil {
+			panic(errors.Format(errors.ErrInvalidDiscriminatorType, name, d.Key()))
+		}
+
+		literal, ok := child.(*schema.LiteralNode)
+		if !ok {
+			panic(errors.Format(errors.ErrInvalidDiscriminatorType, name, d.Key()))
+		}
+
+		if other, ok := d.AddBranch(literal.Value(), name); ok {
+			panic(errors.Format(
+				errors.ErrDuplicateDiscriminatorValue,
+				other,
+				name,
+				literal.Value().String(),
+				d.Key(),
+			))
+		}
+	}
+}
+
+// checkNotConstraint checks that the "not" constraint doesn't exclude all
+// values of the node, and doesn't exclude the example.
+func (c *checkSchema) checkNotConstraint(node schema.Node) {
+	not, ok := node.Constraint(constraint.NotConstraintType).(*constraint.Not)
+	if !ok {
+		return
+	}
+
+	if c.isExcludingAllValues(node, not) {
+		panic(errors.ErrUnsatisfiableNotRule)
+	}
+
+	if example, ok := exampleOfNode(node); ok && validator.IsExcludedByNot(not, *c.rootSchema, example) {
+		panic(errors.ErrNotRuleExcludesExample)
+	}
+}
+
+// checkDefaultConstraint checks that the value of the "default" rule is valid
+// for the node.
+func (c *checkSchema) checkDefaultConstraint(node schema.Node) {
+	d, ok := node.Constraint(constraint.DefaultConstraintType).(*constraint.Default)
+	if !ok {
+		return
+	}
+
+	if !validator.IsValidValue(node, *c.rootSchema, d.Value()) {
+		panic(errors.Format(errors.ErrInvalidDefaultValue, d.Value().String()))
+	}
+}
+
+// isExcludingAllValues returns true if the "not" constraint excludes all
+// values allowed by the node. Only obvious cases are detected: the excluded
+// type is the type of the node, any value, or all values of the "enum" rule.
+func (c *checkSchema) isExcludingAllValues(node schema.Node, not *constraint.Not) bool {
+	if enum, ok := node.Constraint(constraint.EnumConstraintType).(*constraint.Enum); ok {
+		for _, v := range enum.Values() {
+			if !validator.IsExcludedByNot(not, *c.rootSchema, v) {
+				return false
+			}
+		}
+		return true
+	}
+
+	if !not.HasType() {
+		return false
+	}
+
+	if tl, ok := node.Constraint(constraint.TypesListConstraintType).(*constraint.TypesList); ok {
+		return tl.Len() == 1 && tl.Names()[0] == not.TypeName()
+	}
+
+	root, ok := c.rootSchema.MustType(not.TypeName()).RootNode().(*schema.MixedNode)
+	if !ok {
+		return false
+	}
+
+	if root.Constraint(constraint.AnyConstraintType) != nil {
+		return true
+	}
+	return root.NumberOfConstraints() == 0 && root.Type() == node.Type()
+}
+
+// isKeyAllowed returns true if the object node declares the key, or allows
+// additional properties.
+func isKeyAllowed(node *schema.ObjectNode, key string) bool {
+	if _, ok := node.Child(key, false); ok {
+		return true
+	}
+
+	ap, ok := node.Constraint(constraint.AdditionalPropertiesConstraintType).(*constraint.AdditionalProperties)
+	return ok && ap.Mode() != constraint.AdditionalPropertiesNotAllowed
+}
+
+// isValidLiteralValue returns true if the value is valid for the node. Nodes
+// which aren't literals, or depend on user types, accept any value.
+func isValidLiteralValue(node schema.Node, value bytes.Bytes) (ok bool) {
+	if _, isLiteral := node.(*schema.LiteralNode); !isLiteral {
+		return true
+	}
+	if node.Constraint(constraint.TypesListConstraintType) != nil {
+		return true
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			ok = false
+		}
+	}()
+
+	validator.ValidateLiteralValue(node, value)
+	return true
+}
+
+// checkObjectNode checks the number of properties in the example. The lower
+// bound isn't checked for objects with additional properties, since their
+// example might not contain all properties.
+func (checkSchema) checkObjectNode(node *schema.ObjectNode) {
+	length := uint(node.Len())
+
+	if cnstr := node.Constraint(constraint.MinPropertiesConstraintType); cnstr != nil {
+		ap, ok := node.Constraint(constraint.AdditionalPropertiesConstraintType).(*constraint.AdditionalProperties)
+		if !ok || ap.Mode() == constraint.AdditionalPropertiesNotAllowed {
+			cnstr.(*constraint.MinProperties).ValidateTheObject(length)
+		}
+	}
+
+	if cnstr := node.Constraint(constraint.MaxPropertiesConstraintType); cnstr != nil {
+		cnstr.(*constraint.MaxProperties).ValidateTheObject(length)
+	}
+}
+
 func getType(n string, rootSchema *schema.Schema, ss map[string]schema.Type) (ret *schema.Schema) {
 	getFromRoot := func() *schema.Schema {
 		return rootSchema.MustType(n)
@@ -300,7 +625,7 @@ func getType(n string, rootSchema *schema.Schema, ss map[string]schema.Type) (re
 	getFromMap := func() *schema.Schema {
 		s, ok := ss[n]
 		if !ok {
-			panic(errors.Format(errors.ErrTypeNotFound, n))
+			panic(errors.Format(errors.ErrTypeNotFound, n).WithSuggestion(schema.SuggestTypeName(n, ss)))
 		}
 		return s.Schema()
 	}
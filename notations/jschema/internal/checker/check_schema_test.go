@@ -521,6 +521,18 @@ func TestCheckRootSchema(t *testing.T) {
 				`"abc" // {type: "enum", enum: [123, "abc"]}`,
 				[]typ{},
 			},
+			{
+				`2.0 // {enum: [2]}`,
+				[]typ{},
+			},
+			{
+				`2 // {enum: [2.0]}`,
+				[]typ{},
+			},
+			{
+				`"ABC" // {enum: ["abc", "def"], caseInsensitive: true}`,
+				[]typ{},
+			},
 			{
 				`"abc" // {type: "mixed", or: [{type:"integer"}, {type:"string"}]}`,
 				[]typ{},
@@ -609,6 +621,36 @@ func TestCheckRootSchema(t *testing.T) {
 				`[] // {type: "array"}`,
 				[]typ{},
 			},
+			{
+				`[ // {tuple: true}
+					1,
+					"foo",
+					true // {optional: true}
+				]`,
+				[]typ{},
+			},
+			{
+				`[ // {tuple: true, additionalItems: "@item"}
+					1
+				]`,
+				[]typ{
+					{"@item", `"foo"`},
+				},
+			},
+			{
+				`[ // {contains: "@item", minContains: 0, maxContains: 2}
+					"bar"
+				]`,
+				[]typ{
+					{"@item", `"foo"`},
+				},
+			},
+			{
+				`[ // {contains: {type: "integer", min: 10}}
+					1
+				]`,
+				[]typ{},
+			},
 			{
 				"@arr",
 				[]typ{
@@ -761,6 +803,38 @@ func TestCheckRootSchema(t *testing.T) {
 			]`, []typ{}, errors.ErrRuleOptionalAppliesOnlyToObjectProperties},
 			{`{ // {optional: true}
             }`, []typ{}, errors.ErrRuleOptionalAppliesOnlyToObjectProperties},
+			{`[ // {tuple: false}
+				1 // {optional: true}
+			]`, []typ{}, errors.ErrRuleOptionalAppliesOnlyToObjectProperties},
+
+			// tupleConstraint
+			{`[ // {tuple: true}
+				1, // {optional: true}
+				2
+			]`, []typ{}, errors.ErrTupleItemAfterOptional},
+			{`[ // {additionalItems: "string"}
+				1
+			]`, []typ{}, errors.ErrRuleWithoutTuple},
+			{`{} // {additionalItems: true}`, []typ{}, errors.ErrRuleWithoutTuple},
+			{`1 // {tuple: true}`, []typ{}, errors.ErrUnexpectedConstraint},
+			{`[ // {tuple: true, additionalItems: "@unknown"}
+				1
+			]`, []typ{}, errors.ErrTypeNotFound},
+
+			// containsConstraint
+			{`[ // {minContains: 1}
+				1
+			]`, []typ{}, errors.ErrRuleWithoutContains},
+			{`[ // {contains: 1, minContains: 2, maxContains: 1}
+				1
+			]`, []typ{}, errors.ErrValueOfOneConstraintGreaterThanAnother},
+			{`[ // {contains: [1]}
+				1
+			]`, []typ{}, errors.ErrInvalidValueInContainsRule},
+			{`1 // {contains: 1}`, []typ{}, errors.ErrUnexpectedConstraint},
+			{`[ // {contains: "@unknown"}
+				1
+			]`, []typ{}, errors.ErrTypeNotFound},
 
 			// You cannot specify children node if you use a type reference.
 			{`{ // {type: "@schema"}
@@ -1064,8 +1138,12 @@ func TestCheckRootSchema(t *testing.T) {
 			{`"abc" // {type: "mixed"}`, []typ{}, errors.ErrNotFoundRuleOr},
 			{`"abc" // {type: "mixed", minLength: 1}`, []typ{}, errors.ErrNotFoundRuleOr},
 
-			{`2.0 // {enum: [2]}`, []typ{}, errors.ErrDoesNotMatchAnyOfTheEnumValues},
-			{`2 // {enum: [2.0]}`, []typ{}, errors.ErrDoesNotMatchAnyOfTheEnumValues},
+			{`2.1 // {enum: [2]}`, []typ{}, errors.ErrDoesNotMatchAnyOfTheEnumValues},
+			{`"2" // {enum: [2]}`, []typ{}, errors.ErrDoesNotMatchAnyOfTheEnumValues},
+			{`2 // {enum: [2, 2.0]}`, []typ{}, errors.ErrDuplicationInEnumRule},
+			{`"ABC" // {enum: ["abc"]}`, []typ{}, errors.ErrDoesNotMatchAnyOfTheEnumValues},
+			{`"abc" // {enum: ["abc", "ABC"], caseInsensitive: true}`, []typ{}, errors.ErrDuplicationInEnumRule},
+			{`"abc" // {caseInsensitive: true}`, []typ{}, errors.ErrRuleWithoutConstOrEnum},
 
 			{`"abc" // {type: "string", enum: [123, "abc"]}`, []typ{}, errors.ErrInvalidValueInTheTypeRule},
 			{`"abc" // {type: "integer", enum: [123, "abc"]}`, []typ{}, errors.ErrInvalidValueInTheTypeRule},
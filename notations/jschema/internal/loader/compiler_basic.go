@@ -1,6 +1,8 @@
 package loader
 
 import (
+	"math/big"
+
 	jschema "github.com/jsightapi/jsight-schema-go-library"
 	"github.com/jsightapi/jsight-schema-go-library/bytes"
 	"github.com/jsightapi/jsight-schema-go-library/errors"
@@ -38,21 +40,30 @@ func (compile schemaCompiler) compileNode(node schema.Node, indexOfNode int) {
 	lex := node.BasisLexEventOfSchemaForNode()
 	defer lexeme.CatchLexEventError(lex)
 
-	compile.falseConstraints(node)    // can panic
-	compile.orConstraint(node)        // can panic. Must be called before compile.typeConstraint()
-	compile.enumConstraint(node)      // can panic. Must be called before compile.typeConstraint()
-	compile.precisionConstraint(node) // can panic. Must be called before compile.typeConstraint()
-	compile.typeConstraint(node)      // can panic
+	compile.falseConstraints(node)        // can panic
+	compile.metadataConstraints(node)     // can panic
+	compile.discriminatorConstraint(node) // can panic. Must be called before compile.orConstraint()
+	compile.exclusiveConstraint(node)     // can panic. Must be called before compile.orConstraint()
+	compile.orConstraint(node)            // can panic. Must be called before compile.typeConstraint()
+	compile.comparisonConstraints(node)   // can panic. Must be called before compile.enumConstraint()
+	compile.enumConstraint(node)          // can panic. Must be called before compile.typeConstraint()
+	compile.precisionConstraint(node)     // can panic. Must be called before compile.typeConstraint()
+	compile.typeConstraint(node)          // can panic
 	if err := compile.allowedConstraintCheck(node); err != nil {
 		panic(err)
 	}
-	compile.anyConstraint(node) // can panic
+	compile.anyConstraint(node)        // can panic
+	compile.dateRangeConstraints(node) // can panic
+	compile.lengthUnitConstraint(node) // can panic
+	compile.tupleConstraint(node)      // can panic
+	compile.containsConstraint(node)   // can panic
 	if err := compile.checkPairConstraints(node); err != nil {
 		panic(err)
 	}
 	compile.exclusiveMinimumConstraint(node)       // can panic
 	compile.exclusiveMaximumConstraint(node)       // can panic
 	compile.optionalConstraints(node, indexOfNode) // can panic
+	compile.defaultConstraint(node, indexOfNode)   // can panic. Must be called after compile.optionalConstraints()
 
 	if branchingNode, ok := node.(schema.BranchNode); ok {
 		compile.emptyArray(node) // can panic
@@ -64,7 +75,14 @@ func (compile schemaCompiler) compileNode(node schema.Node, indexOfNode int) {
 
 func (schemaCompiler) falseConstraints(node schema.Node) {
 	node.ConstraintMap().Filter(func(k constraint.Type, c constraint.Constraint) bool {
-		if k == constraint.NullableConstraintType || k == constraint.ConstConstraintType {
+		if k == constraint.NullableConstraintType ||
+			k == constraint.ConstConstraintType ||
+			k == constraint.ExclusiveConstraintType ||
+			k == constraint.CaseInsensitiveConstraintType ||
+			k == constraint.DeprecatedConstraintType ||
+			k == constraint.ReadOnlyConstraintType ||
+			k == constraint.WriteOnlyConstraintType ||
+			k == constraint.TupleConstraintType {
 			if b, ok := c.(constraint.BoolKeeper); ok && !b.Bool() {
 				return false
 			}
@@ -73,6 +91,37 @@ func (schemaCompiler) falseConstraints(node schema.Node) {
 	})
 }
 
+// metadataConstraintTypes the constraints which only annotate the node. They
+// don't affect validation, so they are allowed together with any other rules.
+var metadataConstraintTypes = []constraint.Type{
+	constraint.DeprecatedConstraintType,
+	constraint.ReadOnlyConstraintType,
+	constraint.WriteOnlyConstraintType,
+	constraint.TitleConstraintType,
+	constraint.DescriptionConstraintType,
+	constraint.ExamplesConstraintType,
+	constraint.DefaultConstraintType,
+}
+
+// numberOfMetadataConstraints returns the number of metadata constraints of
+// the node.
+func numberOfMetadataConstraints(node schema.Node) int {
+	n := 0
+	for _, t := range metadataConstraintTypes {
+		if node.Constraint(t) != nil {
+			n++
+		}
+	}
+	return n
+}
+
+func (schemaCompiler) metadataConstraints(node schema.Node) {
+	if node.Constraint(constraint.ReadOnlyConstraintType) != nil &&
+		node.Constraint(constraint.WriteOnlyConstraintType) != nil {
+		panic(errors.ErrReadOnlyWithWriteOnly)
+	}
+}
+
 func (schemaCompiler) orConstraint(node schema.Node) {
 	if node.Constraint(constraint.OrConstraintType) == nil {
 		return
@@ -85,6 +134,7 @@ func (schemaCompiler) orConstraint(node schema.Node) {
 	// check for a permissible constraints
 	n := node.NumberOfConstraints()
 	n-- // if node.Constraint(constraint.TypesListConstraintType) != nil - checked above
+	n -= numberOfMetadataConstraints(node)
 	if node.Constraint(constraint.OrConstraintType) != nil {
 		n--
 	}
@@ -94,6 +144,15 @@ func (schemaCompiler) orConstraint(node schema.Node) {
 	if node.Constraint(constraint.NullableConstraintType) != nil {
 		n--
 	}
+	if node.Constraint(constraint.DiscriminatorConstraintType) != nil {
+		n--
+	}
+	if node.Constraint(constraint.ExclusiveConstraintType) != nil {
+		n--
+	}
+	if node.Constraint(constraint.NotConstraintType) != nil {
+		n--
+	}
 	if typeConstraint := node.Constraint(constraint.TypeConstraintType); typeConstraint != nil {
 		n--
 		if t := typeConstraint.(*constraint.TypeConstraint).Bytes().String(); t != `"mixed"` {
@@ -108,6 +167,26 @@ func (schemaCompiler) orConstraint(node schema.Node) {
 	node.DeleteConstraint(constraint.OrConstraintType)
 }
 
+func (schemaCompiler) discriminatorConstraint(node schema.Node) {
+	if node.Constraint(constraint.DiscriminatorConstraintType) == nil {
+		return
+	}
+
+	if node.Constraint(constraint.OrConstraintType) == nil {
+		panic(errors.Format(errors.ErrRuleWithoutOr, constraint.DiscriminatorConstraintType.String()))
+	}
+}
+
+func (schemaCompiler) exclusiveConstraint(node schema.Node) {
+	if node.Constraint(constraint.ExclusiveConstraintType) == nil {
+		return
+	}
+
+	if node.Constraint(constraint.OrConstraintType) == nil {
+		panic(errors.Format(errors.ErrRuleWithoutOr, constraint.ExclusiveConstraintType.String()))
+	}
+}
+
 func ensureCanUseORConstraint(node schema.Node) {
 	if branchNode, ok := node.(schema.BranchNode); ok {
 		// Since "req.jschema.rules.type.reference 0.2" we didn't allow
@@ -156,6 +235,38 @@ func checkBranchNodeWithOrConstraint(schemaNode schema.Node, jsonNode schema.Bra
 	}
 }
 
+// comparisonConstraints passes options of the "caseInsensitive" and
+// "unicodeNormalization" rules to the "const" and "enum" constraints.
+func (schemaCompiler) comparisonConstraints(node schema.Node) {
+	var cmp constraint.Comparison
+
+	if c := node.Constraint(constraint.CaseInsensitiveConstraintType); c != nil {
+		if !hasConstOrEnum(node) {
+			panic(errors.Format(errors.ErrRuleWithoutConstOrEnum, constraint.CaseInsensitiveConstraintType.String()))
+		}
+		cmp.SetCaseInsensitive(c.(*constraint.CaseInsensitive).Bool()) //nolint:errcheck // We're sure about this type.
+	}
+
+	if c := node.Constraint(constraint.UnicodeNormalizationConstraintType); c != nil {
+		if !hasConstOrEnum(node) {
+			panic(errors.Format(errors.ErrRuleWithoutConstOrEnum, constraint.UnicodeNormalizationConstraintType.String()))
+		}
+		cmp.SetNormalization(c.(*constraint.UnicodeNormalization).Form()) //nolint:errcheck // We're sure about this type.
+	}
+
+	if c, ok := node.Constraint(constraint.ConstConstraintType).(*constraint.Const); ok {
+		c.SetComparison(cmp)
+	}
+	if c, ok := node.Constraint(constraint.EnumConstraintType).(*constraint.Enum); ok {
+		c.SetComparison(cmp) // can panic
+	}
+}
+
+func hasConstOrEnum(node schema.Node) bool {
+	return node.Constraint(constraint.ConstConstraintType) != nil ||
+		node.Constraint(constraint.EnumConstraintType) != nil
+}
+
 func (schemaCompiler) enumConstraint(node schema.Node) {
 	if node.Constraint(constraint.EnumConstraintType) == nil {
 		return
@@ -164,6 +275,7 @@ func (schemaCompiler) enumConstraint(node schema.Node) {
 	// check for a permissible constraints
 	n := node.NumberOfConstraints()
 	n-- // if node.Constraint(constraint.EnumConstraintType) != nil - checked above
+	n -= numberOfMetadataConstraints(node)
 	if node.Constraint(constraint.OptionalConstraintType) != nil {
 		n--
 	}
@@ -173,6 +285,15 @@ func (schemaCompiler) enumConstraint(node schema.Node) {
 	if node.Constraint(constraint.NullableConstraintType) != nil {
 		n--
 	}
+	if node.Constraint(constraint.NotConstraintType) != nil {
+		n--
+	}
+	if node.Constraint(constraint.CaseInsensitiveConstraintType) != nil {
+		n--
+	}
+	if node.Constraint(constraint.UnicodeNormalizationConstraintType) != nil {
+		n--
+	}
 	if typeConstraint := node.Constraint(constraint.TypeConstraintType); typeConstraint != nil {
 		n--
 		if t := typeConstraint.(*constraint.TypeConstraint).Bytes().String(); t != `"enum"` {
@@ -208,6 +329,7 @@ func (schemaCompiler) typeConstraintForUserType(
 	val string,
 ) {
 	n := node.NumberOfConstraints()
+	n -= numberOfMetadataConstraints(node)
 	if node.Constraint(constraint.OptionalConstraintType) != nil {
 		n--
 	}
@@ -234,25 +356,44 @@ func (schemaCompiler) typeConstraintForUserType(
 	node.AddConstraint(c) // can panic: Unable to add constraint
 }
 
-func (schemaCompiler) typeConstraintForJSONTypes(node schema.Node, val bytes.Bytes) {
+func (compile schemaCompiler) typeConstraintForJSONTypes(node schema.Node, val bytes.Bytes) {
 	valStr := val.String()
 
+	if typ, ok := compile.rootSchema.Registry().Type(valStr); ok {
+		compile.typeConstraintForCustomType(node, constraint.NewCustomType(valStr, typ))
+		return
+	}
+
 	h, ok := jsonTypesHandler[valStr]
 	if ok {
 		h(node)
 	} else {
-		t := json.NewJsonType(val)                         // can panic
-		if mixedNode, ok := node.(*schema.MixedNode); ok { // defined json type for mixed node
-			mixedNode.SetJsonType(t)
-		} else if t != node.Type() { // check json type for non-mixed node
-			panic(errors.Format(errors.ErrIncompatibleTypes, t.String()))
-		}
+		setNodeJsonType(node, json.NewJsonType(val)) // can panic
 	}
 	if !node.SetRealType(valStr) {
 		panic(errors.Format(errors.ErrIncompatibleTypes, valStr))
 	}
 }
 
+// typeConstraintForCustomType handles the type registered in the
+// jschema.Registry.
+func (schemaCompiler) typeConstraintForCustomType(node schema.Node, c *constraint.CustomType) {
+	t := c.JsonType()
+	setNodeJsonType(node, t)
+	if !node.SetRealType(t.String()) {
+		panic(errors.Format(errors.ErrIncompatibleTypes, c.Name()))
+	}
+	node.AddConstraint(c) // can panic: Unable to add constraint
+}
+
+func setNodeJsonType(node schema.Node, t json.Type) {
+	if mixedNode, ok := node.(*schema.MixedNode); ok { // defined json type for mixed node
+		mixedNode.SetJsonType(t)
+	} else if t != node.Type() { // check json type for non-mixed node
+		panic(errors.Format(errors.ErrIncompatibleTypes, t.String()))
+	}
+}
+
 var jsonTypesHandler = map[string]func(node schema.Node){
 	"mixed": func(node schema.Node) {
 		typesListConstraint := node.Constraint(constraint.TypesListConstraintType)
@@ -300,6 +441,70 @@ var jsonTypesHandler = map[string]func(node schema.Node){
 	"datetime": func(node schema.Node) {
 		node.AddConstraint(constraint.NewDateTime())
 	},
+
+	"ipv4": func(node schema.Node) {
+		node.AddConstraint(constraint.NewIpv4())
+	},
+
+	"ipv6": func(node schema.Node) {
+		node.AddConstraint(constraint.NewIpv6())
+	},
+
+	"cidr": func(node schema.Node) {
+		node.AddConstraint(constraint.NewCidr())
+	},
+
+	"hostname": func(node schema.Node) {
+		node.AddConstraint(constraint.NewHostname())
+	},
+
+	"time": func(node schema.Node) {
+		node.AddConstraint(constraint.NewTime())
+	},
+
+	"duration": func(node schema.Node) {
+		node.AddConstraint(constraint.NewDuration())
+	},
+
+	"base64": func(node schema.Node) {
+		node.AddConstraint(constraint.NewBase64())
+	},
+
+	"base64url": func(node schema.Node) {
+		node.AddConstraint(constraint.NewBase64Url())
+	},
+
+	"hex": func(node schema.Node) {
+		node.AddConstraint(constraint.NewHex())
+	},
+
+	"semver": func(node schema.Node) {
+		node.AddConstraint(constraint.NewSemver())
+	},
+
+	"language": func(node schema.Node) {
+		node.AddConstraint(constraint.NewLanguage())
+	},
+
+	"int32": func(node schema.Node) {
+		node.AddConstraint(constraint.NewInt32())
+	},
+
+	"int64": func(node schema.Node) {
+		node.AddConstraint(constraint.NewInt64())
+	},
+
+	"uint32": func(node schema.Node) {
+		node.AddConstraint(constraint.NewUint32())
+	},
+
+	"uint64": func(node schema.Node) {
+		node.AddConstraint(constraint.NewUint64())
+	},
+
+	"safeInteger": func(node schema.Node) {
+		node.AddConstraint(constraint.NewSafeInteger())
+	},
 }
 
 func (schemaCompiler) allowedConstraintCheck(node schema.Node) (err error) {
@@ -335,6 +540,72 @@ func (schemaCompiler) allowedConstraintCheck(node schema.Node) (err error) {
 			constraint.RegexConstraintType,
 		},
 
+		constraint.Ipv4ConstraintType: {
+			constraint.MinLengthConstraintType,
+			constraint.MaxLengthConstraintType,
+			constraint.RegexConstraintType,
+		},
+
+		constraint.Ipv6ConstraintType: {
+			constraint.MinLengthConstraintType,
+			constraint.MaxLengthConstraintType,
+			constraint.RegexConstraintType,
+		},
+
+		constraint.CidrConstraintType: {
+			constraint.MinLengthConstraintType,
+			constraint.MaxLengthConstraintType,
+			constraint.RegexConstraintType,
+		},
+
+		constraint.HostnameConstraintType: {
+			constraint.MinLengthConstraintType,
+			constraint.MaxLengthConstraintType,
+			constraint.RegexConstraintType,
+		},
+
+		constraint.TimeConstraintType: {
+			constraint.MinLengthConstraintType,
+			constraint.MaxLengthConstraintType,
+			constraint.RegexConstraintType,
+		},
+
+		constraint.DurationConstraintType: {
+			constraint.MinLengthConstraintType,
+			constraint.MaxLengthConstraintType,
+			constraint.RegexConstraintType,
+		},
+
+		constraint.Base64ConstraintType: {
+			constraint.MinLengthConstraintType,
+			constraint.MaxLengthConstraintType,
+			constraint.RegexConstraintType,
+		},
+
+		constraint.Base64UrlConstraintType: {
+			constraint.MinLengthConstraintType,
+			constraint.MaxLengthConstraintType,
+			constraint.RegexConstraintType,
+		},
+
+		constraint.HexConstraintType: {
+			constraint.MinLengthConstraintType,
+			constraint.MaxLengthConstraintType,
+			constraint.RegexConstraintType,
+		},
+
+		constraint.SemverConstraintType: {
+			constraint.MinLengthConstraintType,
+			constraint.MaxLengthConstraintType,
+			constraint.RegexConstraintType,
+		},
+
+		constraint.LanguageConstraintType: {
+			constraint.MinLengthConstraintType,
+			constraint.MaxLengthConstraintType,
+			constraint.RegexConstraintType,
+		},
+
 		constraint.AnyConstraintType: {
 			constraint.ConstConstraintType,
 		},
@@ -360,6 +631,7 @@ func (schemaCompiler) anyConstraint(node schema.Node) {
 	// check for a permissible constraints
 	n := node.NumberOfConstraints()
 	n-- // AnyConstraintType - checked above
+	n -= numberOfMetadataConstraints(node)
 	if node.Constraint(constraint.OptionalConstraintType) != nil {
 		n--
 	}
@@ -387,6 +659,10 @@ func (compile schemaCompiler) checkPairConstraints(node schema.Node) error {
 		compile.checkMinAndMax,
 		compile.checkMinLengthAndMaxLength,
 		compile.checkMinItemsAndMaxItems,
+		compile.checkMinContainsAndMaxContains,
+		compile.checkMinPropertiesAndMaxProperties,
+		compile.checkMultipleOfAndRange,
+		compile.checkIntegerWidthAndRange,
 	}
 
 	for _, fn := range checkers {
@@ -408,6 +684,10 @@ func (schemaCompiler) checkMinAndMax(node schema.Node) error {
 	min := minRaw.(*constraint.Min) //nolint:errcheck // We're sure about this type.
 	max := maxRaw.(*constraint.Max) //nolint:errcheck // We're sure about this type.
 
+	if min.DateBound() != nil || max.DateBound() != nil {
+		return checkDateMinAndMax(min, max)
+	}
+
 	if min.Exclusive() || max.Exclusive() {
 		if min.Value().GreaterThanOrEqual(max.Value()) {
 			return errors.Format(
@@ -428,6 +708,181 @@ func (schemaCompiler) checkMinAndMax(node schema.Node) error {
 	return nil
 }
 
+// checkDateMinAndMax compares bounds of the "date" and "datetime" types.
+// Bounds of different kinds are reported by the dateRangeConstraints.
+func checkDateMinAndMax(min *constraint.Min, max *constraint.Max) error {
+	minBound, maxBound := min.DateBound(), max.DateBound()
+	if minBound == nil || maxBound == nil || minBound.IsDateTime() != maxBound.IsDateTime() {
+		return nil
+	}
+
+	if min.Exclusive() || max.Exclusive() {
+		if !minBound.Time().Before(maxBound.Time()) {
+			return errors.Format(errors.ErrValueOfOneConstraintGreaterOrEqualToAnother, "min", "max")
+		}
+	} else if minBound.Time().After(maxBound.Time()) {
+		return errors.Format(errors.ErrValueOfOneConstraintGreaterThanAnother, "min", "max")
+	}
+	return nil
+}
+
+func (schemaCompiler) checkMinPropertiesAndMaxProperties(node schema.Node) error {
+	minPropertiesRaw := node.Constraint(constraint.MinPropertiesConstraintType)
+	maxPropertiesRaw := node.Constraint(constraint.MaxPropertiesConstraintType)
+
+	if minPropertiesRaw == nil || maxPropertiesRaw == nil {
+		return nil
+	}
+
+	minProperties := minPropertiesRaw.(*constraint.MinProperties) //nolint:errcheck // We're sure about this type.
+	maxProperties := maxPropertiesRaw.(*constraint.MaxProperties) //nolint:errcheck // We're sure about this type.
+
+	if minProperties.Value() > maxProperties.Value() {
+		return errors.Format(
+			errors.ErrValueOfOneConstraintGreaterThanAnother,
+			"minProperties",
+			"maxProperties",
+		)
+	}
+	return nil
+}
+
+// checkMultipleOfAndRange checks there is at least one value between `min` and
+// `max` which satisfies the `multipleOf` constraint. Integer and decimal
+// values are multiples of 1 and of 10^-precision respectively, so the actual
+// step is the least common multiple of these values and the `multipleOf`.
+func (schemaCompiler) checkMultipleOfAndRange(node schema.Node) error {
+	multipleOfRaw := node.Constraint(constraint.MultipleOfConstraintType)
+	minRaw := node.Constraint(constraint.MinConstraintType)
+	maxRaw := node.Constraint(constraint.MaxConstraintType)
+
+	if multipleOfRaw == nil || minRaw == nil || maxRaw == nil {
+		return nil
+	}
+
+	multipleOf := multipleOfRaw.(*constraint.MultipleOf) //nolint:errcheck // We're sure about this type.
+	min := minRaw.(*constraint.Min)                      //nolint:errcheck // We're sure about this type.
+	max := maxRaw.(*constraint.Max)                      //nolint:errcheck // We're sure about this type.
+
+	if min.Value() == nil || max.Value() == nil {
+		return nil // Date bounds are reported by the dateRangeConstraints.
+	}
+
+	step := multipleOf.Value().Rat()
+	if node.Type() == json.TypeInteger {
+		step = lcmRat(step, big.NewRat(1, 1))
+	} else if p, ok := node.Constraint(constraint.PrecisionConstraintType).(*constraint.Precision); ok {
+		den := new(big.Int).Exp(big.NewInt(10), new(big.Int).SetUint64(uint64(p.Value())), nil)
+		step = lcmRat(step, new(big.Rat).SetFrac(big.NewInt(1), den))
+	}
+
+	minExclusive := min.Exclusive() || isExclusive(node, constraint.ExclusiveMinimumConstraintType)
+	maxExclusive := max.Exclusive() || isExclusive(node, constraint.ExclusiveMaximumConstraintType)
+
+	// The smallest multiple of the step which is greater than (or equal to)
+	// the `min`.
+	q := new(big.Rat).Quo(min.Value().Rat(), step)
+	k := new(big.Int).Quo(q.Num(), q.Denom())
+	if q.Sign() > 0 && !q.IsInt() {
+		k.Add(k, big.NewInt(1))
+	}
+	if minExclusive && q.IsInt() {
+		k.Add(k, big.NewInt(1))
+	}
+	v := new(big.Rat).Mul(new(big.Rat).SetInt(k), step)
+
+	if cmp := v.Cmp(max.Value().Rat()); cmp > 0 || (cmp == 0 && maxExclusive) {
+		return errors.Format(errors.ErrMultipleOfOutOfRange, multipleOf.Value().String())
+	}
+	return nil
+}
+
+// checkIntegerWidthAndRange checks the `min` and `max` values fit into the
+// fixed-size integer type such as "int32" or "uint64".
+func (schemaCompiler) checkIntegerWidthAndRange(node schema.Node) error {
+	width, ok := node.Constraint(constraint.IntegerWidthConstraintType).(*constraint.IntegerWidth)
+	if !ok {
+		return nil
+	}
+
+	if min, ok := node.Constraint(constraint.MinConstraintType).(*constraint.Min); ok && min.Value() != nil {
+		if !width.InRange(min.Value().Rat()) {
+			return errors.Format(errors.ErrRangeOutOfIntegerWidth, "min", string(width.SchemaType()))
+		}
+	}
+
+	if max, ok := node.Constraint(constraint.MaxConstraintType).(*constraint.Max); ok && max.Value() != nil {
+		if !width.InRange(max.Value().Rat()) {
+			return errors.Format(errors.ErrRangeOutOfIntegerWidth, "max", string(width.SchemaType()))
+		}
+	}
+	return nil
+}
+
+func isExclusive(node schema.Node, t constraint.Type) bool {
+	c, ok := node.Constraint(t).(interface{ IsExclusive() bool })
+	return ok && c.IsExclusive()
+}
+
+// lcmRat returns the least common multiple of two positive rational numbers.
+func lcmRat(a, b *big.Rat) *big.Rat {
+	gcdNum := new(big.Int).GCD(nil, nil, a.Num(), b.Num())
+	lcmNum := new(big.Int).Mul(a.Num(), b.Num())
+	lcmNum.Quo(lcmNum, gcdNum)
+	gcdDen := new(big.Int).GCD(nil, nil, a.Denom(), b.Denom())
+	return new(big.Rat).SetFrac(lcmNum, gcdDen)
+}
+
+// lengthUnitConstraint passes the unit of the "lengthUnit" rule to the
+// "minLength" and "maxLength" constraints.
+func (schemaCompiler) lengthUnitConstraint(node schema.Node) {
+	c := node.Constraint(constraint.LengthUnitConstraintType)
+	if c == nil {
+		return
+	}
+
+	u := c.(*constraint.LengthUnit).Unit() //nolint:errcheck // We're sure about this type.
+
+	minLength := node.Constraint(constraint.MinLengthConstraintType)
+	maxLength := node.Constraint(constraint.MaxLengthConstraintType)
+	if minLength == nil && maxLength == nil {
+		panic(errors.ErrLengthUnitWithoutLength)
+	}
+
+	if minLength != nil {
+		minLength.(*constraint.MinLength).SetUnit(u) //nolint:errcheck // We're sure about this type.
+	}
+	if maxLength != nil {
+		maxLength.(*constraint.MaxLength).SetUnit(u) //nolint:errcheck // We're sure about this type.
+	}
+}
+
+// tupleConstraint checks that the "additionalItems" rule is used only together
+// with the "tuple" rule.
+func (schemaCompiler) tupleConstraint(node schema.Node) {
+	if node.Constraint(constraint.AdditionalItemsConstraintType) == nil {
+		return
+	}
+
+	if arrayNode, ok := node.(*schema.ArrayNode); !ok || !arrayNode.IsTuple() {
+		panic(errors.Format(errors.ErrRuleWithoutTuple, constraint.AdditionalItemsConstraintType.String()))
+	}
+}
+
+// containsConstraint checks that the "minContains" and "maxContains" rules are
+// used only together with the "contains" rule.
+func (schemaCompiler) containsConstraint(node schema.Node) {
+	if node.Constraint(constraint.ContainsConstraintType) != nil {
+		return
+	}
+
+	for _, t := range []constraint.Type{constraint.MinContainsConstraintType, constraint.MaxContainsConstraintType} {
+		if node.Constraint(t) != nil {
+			panic(errors.Format(errors.ErrRuleWithoutContains, t.String()))
+		}
+	}
+}
+
 func (schemaCompiler) checkMinLengthAndMaxLength(node schema.Node) error {
 	minLengthRaw := node.Constraint(constraint.MinLengthConstraintType)
 	maxLengthRaw := node.Constraint(constraint.MaxLengthConstraintType)
@@ -470,6 +925,55 @@ func (schemaCompiler) checkMinItemsAndMaxItems(node schema.Node) error {
 	return nil
 }
 
+func (schemaCompiler) checkMinContainsAndMaxContains(node schema.Node) error {
+	minContainsRaw := node.Constraint(constraint.MinContainsConstraintType)
+	maxContainsRaw := node.Constraint(constraint.MaxContainsConstraintType)
+
+	if minContainsRaw == nil || maxContainsRaw == nil {
+		return nil
+	}
+
+	minContains := minContainsRaw.(*constraint.MinContains) //nolint:errcheck // We're sure about this type.
+	maxContains := maxContainsRaw.(*constraint.MaxContains) //nolint:errcheck // We're sure about this type.
+
+	if minContains.Value() > maxContains.Value() {
+		return errors.Format(
+			errors.ErrValueOfOneConstraintGreaterThanAnother,
+			"minContains",
+			"maxContains",
+		)
+	}
+	return nil
+}
+
+// dateRangeConstraints checks the "min" and "max" rules with the date value.
+// Such rules can only be used with the "date" and "datetime" types, and the
+// value should have the same format as the type.
+func (schemaCompiler) dateRangeConstraints(node schema.Node) {
+	var typeName string
+	switch {
+	case node.Constraint(constraint.DateConstraintType) != nil:
+		typeName = constraint.DateConstraintType.String()
+	case node.Constraint(constraint.DateTimeConstraintType) != nil:
+		typeName = constraint.DateTimeConstraintType.String()
+	}
+
+	for _, t := range []constraint.Type{constraint.MinConstraintType, constraint.MaxConstraintType} {
+		c, ok := node.Constraint(t).(interface{ DateBound() *constraint.DateBound })
+		if !ok || c.DateBound() == nil {
+			continue
+		}
+
+		if typeName == "" {
+			panic(errors.Format(errors.ErrDateRangeWithoutDateType, t.String()))
+		}
+
+		if c.DateBound().TypeName() != typeName {
+			panic(errors.Format(errors.ErrInvalidDateRangeValue, t.String(), typeName))
+		}
+	}
+}
+
 func (schemaCompiler) exclusiveMinimumConstraint(node schema.Node) {
 	exclusiveMin := node.Constraint(constraint.ExclusiveMinimumConstraintType)
 	if exclusiveMin != nil {
@@ -501,6 +1005,10 @@ func (schemaCompiler) exclusiveMaximumConstraint(node schema.Node) {
 func (compile schemaCompiler) optionalConstraints(node schema.Node, indexOfNode int) {
 	optional := node.Constraint(constraint.OptionalConstraintType)
 	parentNode := node.Parent()
+	if arrayNode, ok := parentNode.(*schema.ArrayNode); ok && arrayNode.IsTuple() {
+		compile.optionalTupleItem(node, arrayNode, indexOfNode)
+		return
+	}
 	objectNode, ok := parentNode.(*schema.ObjectNode)
 
 	if optional == nil {
@@ -518,6 +1026,44 @@ func (compile schemaCompiler) optionalConstraints(node schema.Node, indexOfNode
 	}
 }
 
+// optionalTupleItem checks that only trailing items of the tuple are marked by
+// the "optional" rule.
+func (schemaCompiler) optionalTupleItem(node schema.Node, tuple *schema.ArrayNode, indexOfNode int) {
+	if indexOfNode < tuple.RequiredLen() {
+		return
+	}
+
+	if o, ok := node.Constraint(constraint.OptionalConstraintType).(*constraint.Optional); !ok || !o.Bool() {
+		panic(errors.ErrTupleItemAfterOptional)
+	}
+}
+
+// defaultConstraint checks that the "default" rule is used for optional object
+// properties only.
+func (schemaCompiler) defaultConstraint(node schema.Node, indexOfNode int) {
+	if node.Constraint(constraint.DefaultConstraintType) == nil {
+		return
+	}
+
+	objectNode, ok := node.Parent().(*schema.ObjectNode)
+	if !ok {
+		panic(errors.ErrDefaultNotForOptionalKey)
+	}
+
+	k := objectNode.Key(indexOfNode)
+	if k.IsShortcut {
+		panic(errors.ErrDefaultNotForOptionalKey)
+	}
+
+	if c, ok := objectNode.Constraint(constraint.RequiredKeysConstraintType).(*constraint.RequiredKeys); ok {
+		for _, rk := range c.Keys() {
+			if rk == k.Key {
+				panic(errors.ErrDefaultNotForOptionalKey)
+			}
+		}
+	}
+}
+
 func (schemaCompiler) precisionConstraint(node schema.Node) {
 	if node.Constraint(constraint.PrecisionConstraintType) == nil {
 		return
@@ -340,6 +340,74 @@ func TestSchemaCompiler_checkMinItemsAndMaxItems(t *testing.T) {
 	}
 }
 
+func TestSchemaCompiler_checkMinContainsAndMaxContains(t *testing.T) {
+	cc := map[string]struct {
+		node        func(*testing.T) schema.Node
+		expectedErr string
+	}{
+		"nil minContains, nil maxContains": {
+			node: func(t *testing.T) schema.Node {
+				m := mocks.NewNode(t)
+				m.On("Constraint", constraint.MinContainsConstraintType).Return(nil)
+				m.On("Constraint", constraint.MaxContainsConstraintType).Return(nil)
+				return m
+			},
+		},
+		"nil minContains, not nil maxContains": {
+			node: func(t *testing.T) schema.Node {
+				m := mocks.NewNode(t)
+				m.On("Constraint", constraint.MinContainsConstraintType).Return(nil)
+				m.On("Constraint", constraint.MaxContainsConstraintType).Return(constraint.NewMaxContains([]byte("42")))
+				return m
+			},
+		},
+		"not nil minContains, nil maxContains": {
+			node: func(t *testing.T) schema.Node {
+				m := mocks.NewNode(t)
+				m.On("Constraint", constraint.MinContainsConstraintType).Return(constraint.NewMinContains([]byte("42")))
+				m.On("Constraint", constraint.MaxContainsConstraintType).Return(nil)
+				return m
+			},
+		},
+		"minContains < maxContains": {
+			node: func(t *testing.T) schema.Node {
+				m := mocks.NewNode(t)
+				m.On("Constraint", constraint.MinContainsConstraintType).Return(constraint.NewMinContains([]byte("1")))
+				m.On("Constraint", constraint.MaxContainsConstraintType).Return(constraint.NewMaxContains([]byte("2")))
+				return m
+			},
+		},
+		"minContains = maxContains": {
+			node: func(t *testing.T) schema.Node {
+				m := mocks.NewNode(t)
+				m.On("Constraint", constraint.MinContainsConstraintType).Return(constraint.NewMinContains([]byte("2")))
+				m.On("Constraint", constraint.MaxContainsConstraintType).Return(constraint.NewMaxContains([]byte("2")))
+				return m
+			},
+		},
+		"minContains > maxContains": {
+			node: func(t *testing.T) schema.Node {
+				m := mocks.NewNode(t)
+				m.On("Constraint", constraint.MinContainsConstraintType).Return(constraint.NewMinContains([]byte("2")))
+				m.On("Constraint", constraint.MaxContainsConstraintType).Return(constraint.NewMaxContains([]byte("1")))
+				return m
+			},
+			expectedErr: `Value of constraint "minContains" should be less or equal to value of "maxContains" constraint`,
+		},
+	}
+
+	for n, c := range cc {
+		t.Run(n, func(t *testing.T) {
+			err := schemaCompiler{}.checkMinContainsAndMaxContains(c.node(t))
+			if c.expectedErr != "" {
+				assert.EqualError(t, err, c.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestSchemaCompiler_precisionConstraint(t *testing.T) {
 	t.Run("positive", func(t *testing.T) {
 		cc := map[string]func(*mocks.Node){
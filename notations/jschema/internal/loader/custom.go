@@ -0,0 +1,35 @@
+package loader
+
+import (
+	jschema "github.com/jsightapi/jsight-schema-go-library"
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+	"github.com/jsightapi/jsight-schema-go-library/internal/lexeme"
+	"github.com/jsightapi/jsight-schema-go-library/notations/jschema/internal/schema"
+	"github.com/jsightapi/jsight-schema-go-library/notations/jschema/internal/schema/constraint"
+)
+
+// addConstraintFromRule creates a constraint from the rule with the literal
+// value and adds it to the node. Rules unknown to the loader are looked up in
+// the registry of custom rules.
+func addConstraintFromRule(
+	node schema.Node,
+	ruleNameLex lexeme.LexEvent,
+	ruleValue bytes.Bytes,
+	nodeValue bytes.Bytes,
+	registry *jschema.Registry,
+) {
+	name := ruleNameLex.Value().TrimSpaces().Unquote().String()
+
+	if rule, ok := registry.Rule(name); ok {
+		c, ok := node.Constraint(constraint.CustomConstraintType).(*constraint.Custom)
+		if !ok {
+			c = constraint.NewCustom()
+			node.AddConstraint(c)
+		}
+		c.Add(name, rule, ruleValue) // can panic
+		return
+	}
+
+	c := constraint.NewConstraintFromRule(ruleNameLex, ruleValue, nodeValue) // can panic
+	node.AddConstraint(c)
+}
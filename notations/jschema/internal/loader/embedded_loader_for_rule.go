@@ -161,6 +161,41 @@ func (rl *ruleLoader) ruleValue(lex lexeme.LexEvent) {
 		rl.stateFunc = rl.loadEmbeddedValue
 		rl.stateFunc(lex)
 
+	case "dependentRequired":
+		dependentRequiredConstraint := constraint.NewDependentRequired()
+		rl.node.AddConstraint(dependentRequiredConstraint)
+		rl.embeddedValueLoader = newDependentRequiredValueLoader(dependentRequiredConstraint)
+		rl.stateFunc = rl.loadEmbeddedValue
+		rl.stateFunc(lex)
+
+	case "when":
+		whenConstraint := constraint.NewWhen()
+		rl.node.AddConstraint(whenConstraint)
+		rl.embeddedValueLoader = newWhenValueLoader(whenConstraint)
+		rl.stateFunc = rl.loadEmbeddedValue
+		rl.stateFunc(lex)
+
+	case "examples":
+		examplesConstraint := constraint.NewExamples()
+		rl.node.AddConstraint(examplesConstraint)
+		rl.embeddedValueLoader = newExamplesValueLoader(examplesConstraint)
+		rl.stateFunc = rl.loadEmbeddedValue
+		rl.stateFunc(lex)
+
+	case "not":
+		notConstraint := constraint.NewNot()
+		rl.node.AddConstraint(notConstraint)
+		rl.embeddedValueLoader = newNotValueLoader(notConstraint, rl.node, rl.rootSchema, rl.rules)
+		rl.stateFunc = rl.loadEmbeddedValue
+		rl.stateFunc(lex)
+
+	case "contains":
+		containsConstraint := constraint.NewContains()
+		rl.node.AddConstraint(containsConstraint)
+		rl.embeddedValueLoader = newContainsValueLoader(containsConstraint, rl.node, rl.rootSchema, rl.rules)
+		rl.stateFunc = rl.loadEmbeddedValue
+		rl.stateFunc(lex)
+
 	default:
 		if lex.Type() != lexeme.LiteralBegin {
 			panic(errors.ErrIncorrectRuleValueType)
@@ -174,8 +209,7 @@ func (rl *ruleLoader) ruleValueLiteral(ruleValue lexeme.LexEvent) {
 	if ruleValue.Type() != lexeme.LiteralEnd {
 		panic(errors.ErrLoader)
 	}
-	c := constraint.NewConstraintFromRule(rl.ruleNameLex, ruleValue.Value(), rl.node.Value()) // can panic
-	rl.node.AddConstraint(c)
+	addConstraintFromRule(rl.node, rl.ruleNameLex, ruleValue.Value(), rl.node.Value(), rl.rootSchema.Registry()) // can panic
 
 	rl.stateFunc = rl.ruleValueEnd
 }
@@ -0,0 +1,135 @@
+package loader
+
+import (
+	"github.com/jsightapi/jsight-schema-go-library/errors"
+	"github.com/jsightapi/jsight-schema-go-library/internal/lexeme"
+	"github.com/jsightapi/jsight-schema-go-library/notations/jschema/internal/schema/constraint"
+)
+
+// dependentRequiredValueLoader loader for "dependentRequired" rule value
+// (object of key name lists).
+// example: {"endDate": ["startDate"], "cardNumber": ["cardHolder", "cvv"]}
+type dependentRequiredValueLoader struct {
+	dependentRequiredConstraint *constraint.DependentRequired
+
+	// stateFunc a function for running a state machine (the current state of the
+	// state machine).
+	stateFunc func(lexeme.LexEvent)
+
+	// key the last found key of the rule value.
+	key string
+
+	// inProgress indicates loading finished.
+	inProgress bool
+}
+
+var _ embeddedLoader = (*dependentRequiredValueLoader)(nil)
+
+func newDependentRequiredValueLoader(c *constraint.DependentRequired) *dependentRequiredValueLoader {
+	l := &dependentRequiredValueLoader{
+		dependentRequiredConstraint: c,
+		inProgress:                  true,
+	}
+	l.stateFunc = l.begin
+	return l
+}
+
+func (l *dependentRequiredValueLoader) Load(lex lexeme.LexEvent) bool {
+	defer lexeme.CatchLexEventError(lex)
+	l.stateFunc(lex)
+	return l.inProgress
+}
+
+// begin of object "{".
+func (l *dependentRequiredValueLoader) begin(lex lexeme.LexEvent) {
+	if lex.Type() != lexeme.ObjectBegin {
+		panic(errors.ErrInvalidValueInDependentRequiredRule)
+	}
+	l.stateFunc = l.keyOrObjectEnd
+}
+
+// keyOrObjectEnd object key or object end.
+// ex: {"endDate" <--
+// ex: {...} <--
+func (l *dependentRequiredValueLoader) keyOrObjectEnd(lex lexeme.LexEvent) {
+	switch lex.Type() {
+	case lexeme.ObjectKeyBegin:
+		return
+	case lexeme.ObjectKeyEnd:
+		l.key = lex.Value().TrimSpaces().Unquote().String()
+		l.stateFunc = l.valueBegin
+	case lexeme.ObjectEnd:
+		if len(l.dependentRequiredConstraint.Keys()) == 0 {
+			panic(errors.ErrInvalidValueInDependentRequiredRule)
+		}
+		l.stateFunc = l.endOfLoading
+		l.inProgress = false
+	default:
+		panic(errors.ErrLoader)
+	}
+}
+
+func (l *dependentRequiredValueLoader) valueBegin(lex lexeme.LexEvent) {
+	if lex.Type() != lexeme.ObjectValueBegin {
+		panic(errors.ErrLoader)
+	}
+	l.stateFunc = l.arrayBegin
+}
+
+func (l *dependentRequiredValueLoader) arrayBegin(lex lexeme.LexEvent) {
+	if lex.Type() != lexeme.ArrayBegin {
+		panic(errors.ErrInvalidValueInDependentRequiredRule)
+	}
+	l.stateFunc = l.arrayItemBeginOrArrayEnd
+}
+
+// arrayItemBeginOrArrayEnd begin of array item or array end.
+func (l *dependentRequiredValueLoader) arrayItemBeginOrArrayEnd(lex lexeme.LexEvent) {
+	switch lex.Type() {
+	case lexeme.ArrayItemBegin:
+		l.stateFunc = l.arrayItemValue
+	case lexeme.ArrayEnd:
+		if len(l.dependentRequiredConstraint.Dependencies(l.key)) == 0 {
+			panic(errors.ErrInvalidValueInDependentRequiredRule)
+		}
+		l.stateFunc = l.valueEnd
+	default:
+		panic(errors.ErrLoader)
+	}
+}
+
+func (l *dependentRequiredValueLoader) arrayItemValue(lex lexeme.LexEvent) {
+	switch lex.Type() {
+	case lexeme.LiteralBegin:
+		return
+	case lexeme.LiteralEnd:
+		v := lex.Value()
+		if !v.InQuotes() {
+			panic(errors.ErrInvalidValueInDependentRequiredRule)
+		}
+		l.dependentRequiredConstraint.Add(l.key, v.Unquote().String())
+		l.stateFunc = l.arrayItemEnd
+	default:
+		panic(errors.ErrInvalidValueInDependentRequiredRule)
+	}
+}
+
+func (l *dependentRequiredValueLoader) arrayItemEnd(lex lexeme.LexEvent) {
+	if lex.Type() != lexeme.ArrayItemEnd {
+		panic(errors.ErrLoader)
+	}
+	l.stateFunc = l.arrayItemBeginOrArrayEnd
+}
+
+func (l *dependentRequiredValueLoader) valueEnd(lex lexeme.LexEvent) {
+	if lex.Type() != lexeme.ObjectValueEnd {
+		panic(errors.ErrLoader)
+	}
+	l.stateFunc = l.keyOrObjectEnd
+}
+
+// endOfLoading the method should not be called during normal operation. Ensures
+// that the loader will not continue to work after the load is complete.
+func (*dependentRequiredValueLoader) endOfLoading(lexeme.LexEvent) {
+	panic(errors.ErrLoader)
+}
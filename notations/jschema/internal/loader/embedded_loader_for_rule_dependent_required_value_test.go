@@ -0,0 +1,19 @@
+package loader
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jsightapi/jsight-schema-go-library/notations/jschema/internal/schema/constraint"
+)
+
+func Test_newDependentRequiredValueLoader(t *testing.T) {
+	expectedConstraint := constraint.NewDependentRequired()
+
+	l := newDependentRequiredValueLoader(expectedConstraint)
+
+	assert.Same(t, expectedConstraint, l.dependentRequiredConstraint)
+	assert.NotNil(t, l.stateFunc)
+	assert.True(t, l.inProgress)
+}
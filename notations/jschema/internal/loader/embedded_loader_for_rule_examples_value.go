@@ -0,0 +1,85 @@
+package loader
+
+import (
+	"github.com/jsightapi/jsight-schema-go-library/errors"
+	"github.com/jsightapi/jsight-schema-go-library/internal/lexeme"
+	"github.com/jsightapi/jsight-schema-go-library/notations/jschema/internal/schema/constraint"
+)
+
+// examplesValueLoader loader for "examples" rule value (array of literals).
+// Ex: ["foo", 42, true, null]
+type examplesValueLoader struct {
+	examplesConstraint *constraint.Examples
+
+	// stateFunc a function for running a state machine (the current state of the
+	// state machine).
+	stateFunc func(lexeme.LexEvent)
+
+	// inProgress true - if loading in progress, false - if loading finished.
+	inProgress bool
+}
+
+var _ embeddedLoader = (*examplesValueLoader)(nil)
+
+func newExamplesValueLoader(c *constraint.Examples) *examplesValueLoader {
+	l := &examplesValueLoader{
+		examplesConstraint: c,
+		inProgress:         true,
+	}
+	l.stateFunc = l.begin
+	return l
+}
+
+func (l *examplesValueLoader) Load(lex lexeme.LexEvent) bool {
+	defer lexeme.CatchLexEventError(lex)
+	l.stateFunc(lex)
+	return l.inProgress
+}
+
+// begin of array "[".
+func (l *examplesValueLoader) begin(lex lexeme.LexEvent) {
+	if lex.Type() != lexeme.ArrayBegin {
+		panic(errors.ErrInvalidValueInExamplesRule)
+	}
+	l.stateFunc = l.arrayItemBeginOrArrayEnd
+}
+
+// arrayItemBeginOrArrayEnd begin of array item or array end.
+// ex: [1 <--
+// ex: ] <--
+func (l *examplesValueLoader) arrayItemBeginOrArrayEnd(lex lexeme.LexEvent) {
+	switch lex.Type() {
+	case lexeme.ArrayItemBegin:
+		l.stateFunc = l.literal
+	case lexeme.ArrayEnd:
+		l.stateFunc = l.endOfLoading
+		l.inProgress = false
+	default:
+		panic(errors.ErrInvalidValueInExamplesRule)
+	}
+}
+
+// literal array item value.
+func (l *examplesValueLoader) literal(lex lexeme.LexEvent) {
+	switch lex.Type() {
+	case lexeme.LiteralBegin:
+	case lexeme.LiteralEnd:
+		l.examplesConstraint.Append(lex.Value())
+		l.stateFunc = l.arrayItemEnd
+	default:
+		panic(errors.ErrInvalidValueInExamplesRule)
+	}
+}
+
+func (l *examplesValueLoader) arrayItemEnd(lex lexeme.LexEvent) {
+	if lex.Type() != lexeme.ArrayItemEnd {
+		panic(errors.ErrLoader)
+	}
+	l.stateFunc = l.arrayItemBeginOrArrayEnd
+}
+
+// endOfLoading the method should not be called during normal operation. Ensures
+// that the loader will not continue to work after the load is complete.
+func (*examplesValueLoader) endOfLoading(lexeme.LexEvent) {
+	panic(errors.ErrLoader)
+}
@@ -0,0 +1,130 @@
+package loader
+
+import (
+	jschema "github.com/jsightapi/jsight-schema-go-library"
+	"github.com/jsightapi/jsight-schema-go-library/errors"
+	"github.com/jsightapi/jsight-schema-go-library/internal/json"
+	"github.com/jsightapi/jsight-schema-go-library/internal/lexeme"
+	"github.com/jsightapi/jsight-schema-go-library/notations/jschema/internal/schema"
+	"github.com/jsightapi/jsight-schema-go-library/notations/jschema/internal/schema/constraint"
+)
+
+// notValueLoader loader for "not" rule value (value, user type or rule-set).
+// Is also used for the "contains" rule value, which has the same format.
+// example: "admin"
+// example: "@legacy"
+// example: {enum: ["admin", "root"]}
+type notValueLoader struct {
+	notConstraint *constraint.Not
+
+	// invalidValueErr an error for the value of unexpected kind.
+	invalidValueErr errors.ErrorCode
+
+	// A node to add the "not" constraint.
+	node schema.Node
+
+	// A rootSchema into which the type from the rule-set will be added.
+	rootSchema *schema.Schema
+
+	// rules all available rules.
+	rules map[string]jschema.Rule
+
+	// stateFunc a function for running a state machine (the current state of the
+	// state machine).
+	stateFunc func(lexeme.LexEvent)
+
+	// ruleSetLoader a loader for rule-set value. Ex: {type: "integer", min: 0}.
+	ruleSetLoader *orRuleSetLoader
+
+	// inProgress indicates loading finished.
+	inProgress bool
+}
+
+var _ embeddedLoader = (*notValueLoader)(nil)
+
+func newNotValueLoader(
+	c *constraint.Not,
+	node schema.Node,
+	rootSchema *schema.Schema,
+	rules map[string]jschema.Rule,
+) *notValueLoader {
+	l := &notValueLoader{
+		notConstraint:   c,
+		invalidValueErr: errors.ErrInvalidValueInNotRule,
+		node:            node,
+		rootSchema:      rootSchema,
+		rules:           rules,
+		inProgress:      true,
+	}
+	l.stateFunc = l.begin
+	return l
+}
+
+func newContainsValueLoader(
+	c *constraint.Contains,
+	node schema.Node,
+	rootSchema *schema.Schema,
+	rules map[string]jschema.Rule,
+) *notValueLoader {
+	l := newNotValueLoader(&c.Not, node, rootSchema, rules)
+	l.invalidValueErr = errors.ErrInvalidValueInContainsRule
+	return l
+}
+
+func (l *notValueLoader) Load(lex lexeme.LexEvent) bool {
+	defer lexeme.CatchLexEventError(lex)
+	if l.ruleSetLoader != nil {
+		if !l.ruleSetLoader.Load(lex) {
+			l.ruleSetLoader = nil
+			l.stateFunc = l.endOfLoading
+			l.inProgress = false
+		}
+	} else {
+		l.stateFunc(lex)
+	}
+	return l.inProgress
+}
+
+// begin of the literal or the rule-set "{".
+func (l *notValueLoader) begin(lex lexeme.LexEvent) {
+	switch lex.Type() {
+	case lexeme.LiteralBegin:
+		l.stateFunc = l.literal
+	case lexeme.ObjectBegin:
+		l.ruleSetLoader = newOrRuleSetLoader(l.node, l.notConstraint.TypesList(), l.rootSchema, l.rules)
+		l.ruleSetLoader.Load(lex)
+	default:
+		panic(l.invalidValueErr)
+	}
+}
+
+// literal the excluded value or the name of the user type.
+// ex: "admin" <--
+// ex: "@legacy" <--
+func (l *notValueLoader) literal(lex lexeme.LexEvent) {
+	if lex.Type() != lexeme.LiteralEnd {
+		panic(errors.ErrLoader)
+	}
+
+	v := lex.Value()
+	if json.Guess(v).LiteralJsonType() == json.TypeString && v.Unquote().IsUserTypeName() {
+		name := v.Unquote().String()
+		l.notConstraint.TypesList().AddNameWithASTNode(name, name, jschema.RuleASTNode{
+			TokenType:  jschema.TokenTypeShortcut,
+			Value:      name,
+			Properties: &jschema.RuleASTNodes{},
+			Source:     jschema.RuleASTNodeSourceManual,
+		})
+	} else {
+		l.notConstraint.SetValue(v)
+	}
+
+	l.stateFunc = l.endOfLoading
+	l.inProgress = false
+}
+
+// endOfLoading the method should not be called during normal operation. Ensures
+// that the loader will not continue to work after the load is complete.
+func (*notValueLoader) endOfLoading(lexeme.LexEvent) {
+	panic(errors.ErrLoader)
+}
@@ -0,0 +1,35 @@
+package loader
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jsightapi/jsight-schema-go-library/errors"
+	"github.com/jsightapi/jsight-schema-go-library/notations/jschema/internal/schema"
+	"github.com/jsightapi/jsight-schema-go-library/notations/jschema/internal/schema/constraint"
+)
+
+func Test_newNotValueLoader(t *testing.T) {
+	expectedConstraint := constraint.NewNot()
+	rootSchema := schema.New()
+
+	l := newNotValueLoader(expectedConstraint, nil, &rootSchema, nil)
+
+	assert.Same(t, expectedConstraint, l.notConstraint)
+	assert.Same(t, &rootSchema, l.rootSchema)
+	assert.NotNil(t, l.stateFunc)
+	assert.True(t, l.inProgress)
+}
+
+func Test_newContainsValueLoader(t *testing.T) {
+	c := constraint.NewContains()
+	rootSchema := schema.New()
+
+	l := newContainsValueLoader(c, nil, &rootSchema, nil)
+
+	assert.Same(t, &c.Not, l.notConstraint)
+	assert.Equal(t, errors.ErrInvalidValueInContainsRule, l.invalidValueErr)
+	assert.NotNil(t, l.stateFunc)
+	assert.True(t, l.inProgress)
+}
@@ -108,7 +108,7 @@ func (a *orValueLoader) itemInner(lex lexeme.LexEvent) {
 	case lexeme.LiteralBegin:
 		a.stateFunc = a.literal
 	case lexeme.ObjectBegin:
-		a.ruleSetLoader = newOrRuleSetLoader(a.node, a.rootSchema, a.rules)
+		a.ruleSetLoader = newOrRuleSetLoader(a.node, a.nodeTypesListConstraint(), a.rootSchema, a.rules)
 		a.ruleSetLoader.Load(lex)
 		a.stateFunc = a.itemEnd
 	default:
@@ -145,6 +145,7 @@ func (a *orValueLoader) literal(lex lexeme.LexEvent) {
 
 		typ := schema.New()
 		typ.SetRootNode(root)
+		typ.SetRegistry(a.rootSchema.Registry())
 
 		CompileBasic(&typ, false)
 
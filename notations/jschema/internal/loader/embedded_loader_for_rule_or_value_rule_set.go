@@ -9,11 +9,15 @@ import (
 )
 
 // orRuleSetLoader loads data from rule-set into the type. Specifies the name of
-// this user type in the parent node (in types list constraint).
+// this user type in the types list constraint.
 type orRuleSetLoader struct {
 	// The node.
 	node schema.Node
 
+	// typesList a constraint to which the name of the type will be added. The
+	// TypesList constraint of the node for the "or" rule.
+	typesList *constraint.TypesList
+
 	// A rootSchema to which the type from the "or" rule will be added.
 	rootSchema *schema.Schema
 
@@ -44,6 +48,7 @@ var _ embeddedLoader = (*orRuleSetLoader)(nil)
 // Loader for rule-set value. Ex: {type: "integer", min: 0}
 func newOrRuleSetLoader(
 	node schema.Node,
+	typesList *constraint.TypesList,
 	rootSchema *schema.Schema,
 	rules map[string]jschema.Rule,
 ) *orRuleSetLoader {
@@ -53,6 +58,7 @@ func newOrRuleSetLoader(
 
 	s := &orRuleSetLoader{
 		node:       node,
+		typesList:  typesList,
 		rootSchema: rootSchema,
 		rules:      rules,
 		typeRoot:   schema.NewMixedNode(node.BasisLexEventOfSchemaForNode()),
@@ -141,8 +147,7 @@ func (s *orRuleSetLoader) valueLiteral(lex lexeme.LexEvent) {
 	case lexeme.LiteralBegin:
 		return
 	case lexeme.LiteralEnd:
-		c := constraint.NewConstraintFromRule(s.ruleNameLex, lex.Value(), s.node.Value()) // can panic
-		s.typeRoot.AddConstraint(c)
+		addConstraintFromRule(s.typeRoot, s.ruleNameLex, lex.Value(), s.node.Value(), s.rootSchema.Registry()) // can panic
 		s.stateFunc = s.valueEnd
 	default:
 		panic(errors.ErrLiteralValueExpected)
@@ -164,22 +169,13 @@ func (*orRuleSetLoader) endOfLoading(lexeme.LexEvent) {
 	panic(errors.ErrLoader)
 }
 
-// return TypesList constraint for node
-func (s *orRuleSetLoader) nodeTypesListConstraint() *constraint.TypesList {
-	c := s.node.Constraint(constraint.TypesListConstraintType)
-	if c == nil {
-		panic(errors.ErrLoader) // constraint not found
-	}
-	return c.(*constraint.TypesList)
-}
-
 // makeTypeFromRuleSet appends new type based on rule-set.
 func (s *orRuleSetLoader) makeTypeFromRuleSet() {
 	if s.typeRoot.NumberOfConstraints() == 0 {
 		panic(errors.ErrEmptyRuleSet)
 	}
 
-	c := s.nodeTypesListConstraint()
+	c := s.typesList
 	an := s.makeTypeASTNode(c.Source())
 
 	typeConstraint := s.typeRoot.Constraint(constraint.TypeConstraintType)
@@ -193,6 +189,7 @@ func (s *orRuleSetLoader) makeTypeFromRuleSet() {
 
 	typ := schema.New()
 	typ.SetRootNode(s.typeRoot)
+	typ.SetRegistry(s.rootSchema.Registry())
 
 	CompileBasic(&typ, false)
 
@@ -0,0 +1,173 @@
+package loader
+
+import (
+	"github.com/jsightapi/jsight-schema-go-library/errors"
+	"github.com/jsightapi/jsight-schema-go-library/internal/lexeme"
+	"github.com/jsightapi/jsight-schema-go-library/notations/jschema/internal/schema/constraint"
+)
+
+// whenValueLoader loader for "when" rule value (condition or list of
+// conditions).
+// example: {key: "type", const: "card", then: "@card"}
+// example: [{key: "type", const: "card", then: "@card"}, {key: "type", const: "bank", then: "@bank"}]
+type whenValueLoader struct {
+	whenConstraint *constraint.When
+
+	// stateFunc a function for running a state machine (the current state of the
+	// state machine).
+	stateFunc func(lexeme.LexEvent)
+
+	// condition the condition which is loading now.
+	condition constraint.WhenCondition
+
+	// propertyName the last found property name of the condition.
+	propertyName string
+
+	// inProgress indicates loading finished.
+	inProgress bool
+}
+
+var _ embeddedLoader = (*whenValueLoader)(nil)
+
+func newWhenValueLoader(c *constraint.When) *whenValueLoader {
+	l := &whenValueLoader{
+		whenConstraint: c,
+		inProgress:     true,
+	}
+	l.stateFunc = l.begin
+	return l
+}
+
+func (l *whenValueLoader) Load(lex lexeme.LexEvent) bool {
+	defer lexeme.CatchLexEventError(lex)
+	l.stateFunc(lex)
+	return l.inProgress
+}
+
+// begin of array "[" or object "{".
+func (l *whenValueLoader) begin(lex lexeme.LexEvent) {
+	switch lex.Type() {
+	case lexeme.ArrayBegin:
+		l.whenConstraint.SetIsList()
+		l.stateFunc = l.arrayItemBeginOrArrayEnd
+	case lexeme.ObjectBegin:
+		l.stateFunc = l.propertyOrObjectEnd
+	default:
+		panic(errors.ErrInvalidValueInWhenRule)
+	}
+}
+
+// arrayItemBeginOrArrayEnd begin of array item or array end.
+func (l *whenValueLoader) arrayItemBeginOrArrayEnd(lex lexeme.LexEvent) {
+	switch lex.Type() {
+	case lexeme.ArrayItemBegin:
+		l.stateFunc = l.objectBegin
+	case lexeme.ArrayEnd:
+		if len(l.whenConstraint.Conditions()) == 0 {
+			panic(errors.ErrInvalidValueInWhenRule)
+		}
+		l.stateFunc = l.endOfLoading
+		l.inProgress = false
+	default:
+		panic(errors.ErrLoader)
+	}
+}
+
+func (l *whenValueLoader) arrayItemEnd(lex lexeme.LexEvent) {
+	if lex.Type() != lexeme.ArrayItemEnd {
+		panic(errors.ErrLoader)
+	}
+	l.stateFunc = l.arrayItemBeginOrArrayEnd
+}
+
+// objectBegin begin of the condition in the list.
+func (l *whenValueLoader) objectBegin(lex lexeme.LexEvent) {
+	if lex.Type() != lexeme.ObjectBegin {
+		panic(errors.ErrInvalidValueInWhenRule)
+	}
+	l.stateFunc = l.propertyOrObjectEnd
+}
+
+// propertyOrObjectEnd property of the condition or end of the condition.
+// ex: {key <--
+// ex: {...} <--
+func (l *whenValueLoader) propertyOrObjectEnd(lex lexeme.LexEvent) {
+	switch lex.Type() {
+	case lexeme.ObjectKeyBegin:
+		return
+	case lexeme.ObjectKeyEnd:
+		l.propertyName = lex.Value().TrimSpaces().Unquote().String()
+		l.stateFunc = l.valueBegin
+	case lexeme.ObjectEnd:
+		l.appendCondition()
+		if l.whenConstraint.IsList() {
+			l.stateFunc = l.arrayItemEnd
+		} else {
+			l.stateFunc = l.endOfLoading
+			l.inProgress = false
+		}
+	default:
+		panic(errors.ErrLoader)
+	}
+}
+
+func (l *whenValueLoader) valueBegin(lex lexeme.LexEvent) {
+	if lex.Type() != lexeme.ObjectValueBegin {
+		panic(errors.ErrLoader)
+	}
+	l.stateFunc = l.valueLiteral
+}
+
+func (l *whenValueLoader) valueLiteral(lex lexeme.LexEvent) {
+	switch lex.Type() {
+	case lexeme.LiteralBegin:
+		return
+	case lexeme.LiteralEnd:
+		l.setProperty(lex)
+		l.stateFunc = l.valueEnd
+	default:
+		panic(errors.ErrInvalidValueInWhenRule)
+	}
+}
+
+func (l *whenValueLoader) valueEnd(lex lexeme.LexEvent) {
+	if lex.Type() != lexeme.ObjectValueEnd {
+		panic(errors.ErrLoader)
+	}
+	l.stateFunc = l.propertyOrObjectEnd
+}
+
+func (l *whenValueLoader) setProperty(lex lexeme.LexEvent) {
+	v := lex.Value()
+
+	switch l.propertyName {
+	case "key":
+		if !v.InQuotes() {
+			panic(errors.ErrInvalidValueInWhenRule)
+		}
+		l.condition.Key = v.Unquote().String()
+	case "const":
+		l.condition.Const = v
+	case "then":
+		if !v.InQuotes() || !v.Unquote().IsUserTypeName() {
+			panic(errors.ErrInvalidValueInWhenRule)
+		}
+		l.condition.Then = v.Unquote().String()
+	default:
+		panic(errors.ErrInvalidValueInWhenRule)
+	}
+}
+
+func (l *whenValueLoader) appendCondition() {
+	if l.condition.Key == "" || l.condition.Const == nil || l.condition.Then == "" {
+		panic(errors.ErrInvalidValueInWhenRule)
+	}
+	l.whenConstraint.Append(l.condition)
+	l.condition = constraint.WhenCondition{}
+}
+
+// endOfLoading the method should not be called during normal operation. Ensures
+// that the loader will not continue to work after the load is complete.
+func (*whenValueLoader) endOfLoading(lexeme.LexEvent) {
+	panic(errors.ErrLoader)
+}
@@ -0,0 +1,19 @@
+package loader
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jsightapi/jsight-schema-go-library/notations/jschema/internal/schema/constraint"
+)
+
+func Test_newWhenValueLoader(t *testing.T) {
+	expectedConstraint := constraint.NewWhen()
+
+	l := newWhenValueLoader(expectedConstraint)
+
+	assert.Same(t, expectedConstraint, l.whenConstraint)
+	assert.NotNil(t, l.stateFunc)
+	assert.True(t, l.inProgress)
+}
@@ -58,7 +58,7 @@ type loader struct {
 }
 
 func LoadSchema(scan *scanner.Scanner, rootSchema *schema.Schema) *schema.Schema {
-	s := LoadSchemaWithoutCompile(scan, rootSchema, nil)
+	s := LoadSchemaWithoutCompile(scan, rootSchema, nil, nil)
 	CompileBasic(&s, false)
 	return &s
 }
@@ -75,6 +75,7 @@ func LoadSchemaWithoutCompile(
 	scan *scanner.Scanner,
 	rootSchema *schema.Schema,
 	rules map[string]jschema.Rule,
+	registry *jschema.Registry,
 ) schema.Schema {
 	l := loaderPool.Get().(*loader) //nolint:errcheck // We're sure about this type.
 	defer func() {
@@ -84,6 +85,7 @@ func LoadSchemaWithoutCompile(
 
 	l.scanner = scan
 	l.rules = rules
+	l.schema.SetRegistry(registry)
 
 	l.rootSchema = rootSchema
 	if rootSchema == nil {
@@ -27,7 +27,7 @@ func TestLoadSchemaWithoutCompile(t *testing.T) {
 			t.Run(s, func(t *testing.T) {
 				assert.NotPanics(t, func() {
 					scan := scanner.New(fs.NewFile("", s))
-					LoadSchemaWithoutCompile(scan, nil, nil)
+					LoadSchemaWithoutCompile(scan, nil, nil, nil)
 				})
 			})
 		}
@@ -49,7 +49,7 @@ func TestLoadSchemaWithoutCompile(t *testing.T) {
 			t.Run(s, func(t *testing.T) {
 				assert.PanicsWithError(t, expected, func() {
 					scan := scanner.New(fs.NewFile("", s))
-					LoadSchemaWithoutCompile(scan, nil, nil)
+					LoadSchemaWithoutCompile(scan, nil, nil, nil)
 				})
 			})
 		}
@@ -66,6 +66,6 @@ func BenchmarkLoadSchemaWithoutCompile(b *testing.B) {
 	b.ResetTimer()
 
 	for i := 0; i < b.N; i++ {
-		LoadSchemaWithoutCompile(scan, nil, nil)
+		LoadSchemaWithoutCompile(scan, nil, nil, nil)
 	}
 }
@@ -5,6 +5,7 @@ import (
 	"github.com/jsightapi/jsight-schema-go-library/errors"
 	"github.com/jsightapi/jsight-schema-go-library/internal/json"
 	"github.com/jsightapi/jsight-schema-go-library/internal/lexeme"
+	"github.com/jsightapi/jsight-schema-go-library/notations/jschema/internal/schema/constraint"
 )
 
 type ArrayNode struct {
@@ -76,6 +77,24 @@ func (n ArrayNode) Child(i uint) Node {
 	return n.children[i]
 }
 
+// IsTuple returns true if each element of the array example applies to the
+// item at the same position.
+func (n ArrayNode) IsTuple() bool {
+	c, ok := n.Constraint(constraint.TupleConstraintType).(*constraint.Tuple)
+	return ok && c.Bool()
+}
+
+// RequiredLen returns the number of leading elements of the tuple which aren't
+// marked by the "optional" rule.
+func (n ArrayNode) RequiredLen() int {
+	for i, c := range n.children {
+		if o, ok := c.Constraint(constraint.OptionalConstraintType).(*constraint.Optional); ok && o.Bool() {
+			return i
+		}
+	}
+	return len(n.children)
+}
+
 func (n *ArrayNode) ASTNode() (jschema.ASTNode, error) {
 	an := astNodeFromNode(n)
 	l := len(n.children)
@@ -65,6 +65,12 @@ func collectASTRules(cc *Constraints) *jschema.RuleASTNodes {
 		case constraint.TypesListConstraintType:
 			// do nothing
 
+		// Each custom rule is placed in the AST under its own name.
+		case constraint.CustomConstraintType:
+			v.ASTNode().Properties.EachSafe(func(k string, v jschema.RuleASTNode) {
+				nn.Set(k, v)
+			})
+
 		default:
 			nn.Set(k.String(), v.ASTNode())
 		}
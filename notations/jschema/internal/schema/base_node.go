@@ -43,6 +43,14 @@ func (n baseNode) Type() json.Type {
 }
 
 func (n baseNode) SchemaType() jschema.SchemaType {
+	if c, ok := n.Constraint(constraint.CustomTypeConstraintType).(*constraint.CustomType); ok {
+		return jschema.SchemaType(c.Name())
+	}
+
+	if c, ok := n.Constraint(constraint.IntegerWidthConstraintType).(*constraint.IntegerWidth); ok {
+		return c.SchemaType()
+	}
+
 	for k, v := range constraintToSchemaTypeMap {
 		if n.constraints.Has(k) {
 			return v
@@ -52,12 +60,23 @@ func (n baseNode) SchemaType() jschema.SchemaType {
 }
 
 var constraintToSchemaTypeMap = map[constraint.Type]jschema.SchemaType{
-	constraint.AnyConstraintType:      jschema.SchemaTypeAny,
-	constraint.DateConstraintType:     jschema.SchemaTypeDate,
-	constraint.DateTimeConstraintType: jschema.SchemaTypeDateTime,
-	constraint.UuidConstraintType:     jschema.SchemaTypeUUID,
-	constraint.UriConstraintType:      jschema.SchemaTypeURI,
-	constraint.EmailConstraintType:    jschema.SchemaTypeEmail,
+	constraint.AnyConstraintType:       jschema.SchemaTypeAny,
+	constraint.DateConstraintType:      jschema.SchemaTypeDate,
+	constraint.DateTimeConstraintType:  jschema.SchemaTypeDateTime,
+	constraint.UuidConstraintType:      jschema.SchemaTypeUUID,
+	constraint.UriConstraintType:       jschema.SchemaTypeURI,
+	constraint.EmailConstraintType:     jschema.SchemaTypeEmail,
+	constraint.Ipv4ConstraintType:      jschema.SchemaTypeIPv4,
+	constraint.Ipv6ConstraintType:      jschema.SchemaTypeIPv6,
+	constraint.CidrConstraintType:      jschema.SchemaTypeCIDR,
+	constraint.HostnameConstraintType:  jschema.SchemaTypeHostname,
+	constraint.TimeConstraintType:      jschema.SchemaTypeTime,
+	constraint.DurationConstraintType:  jschema.SchemaTypeDuration,
+	constraint.Base64ConstraintType:    jschema.SchemaTypeBase64,
+	constraint.Base64UrlConstraintType: jschema.SchemaTypeBase64URL,
+	constraint.HexConstraintType:       jschema.SchemaTypeHex,
+	constraint.SemverConstraintType:    jschema.SchemaTypeSemver,
+	constraint.LanguageConstraintType:  jschema.SchemaTypeLanguage,
 }
 
 func (n *baseNode) SetRealType(s string) bool {
@@ -103,19 +122,35 @@ var compatibleTypes = map[string]map[json.Type]struct{}{
 		json.TypeNull,
 		json.TypeMixed,
 	),
-	"decimal":  availableJSONTypes(json.TypeFloat),
-	"email":    availableJSONTypes(json.TypeString),
-	"uri":      availableJSONTypes(json.TypeString),
-	"uuid":     availableJSONTypes(json.TypeString),
-	"date":     availableJSONTypes(json.TypeString),
-	"datetime": availableJSONTypes(json.TypeString),
-	"object":   availableJSONTypes(json.TypeObject),
-	"array":    availableJSONTypes(json.TypeArray),
-	"string":   availableJSONTypes(json.TypeString),
-	"integer":  availableJSONTypes(json.TypeInteger),
-	"float":    availableJSONTypes(json.TypeFloat),
-	"boolean":  availableJSONTypes(json.TypeBoolean),
-	"null":     availableJSONTypes(json.TypeNull),
+	"decimal":     availableJSONTypes(json.TypeFloat),
+	"email":       availableJSONTypes(json.TypeString),
+	"uri":         availableJSONTypes(json.TypeString),
+	"uuid":        availableJSONTypes(json.TypeString),
+	"date":        availableJSONTypes(json.TypeString),
+	"datetime":    availableJSONTypes(json.TypeString),
+	"ipv4":        availableJSONTypes(json.TypeString),
+	"ipv6":        availableJSONTypes(json.TypeString),
+	"cidr":        availableJSONTypes(json.TypeString),
+	"hostname":    availableJSONTypes(json.TypeString),
+	"time":        availableJSONTypes(json.TypeString),
+	"duration":    availableJSONTypes(json.TypeString),
+	"base64":      availableJSONTypes(json.TypeString),
+	"base64url":   availableJSONTypes(json.TypeString),
+	"hex":         availableJSONTypes(json.TypeString),
+	"semver":      availableJSONTypes(json.TypeString),
+	"language":    availableJSONTypes(json.TypeString),
+	"object":      availableJSONTypes(json.TypeObject),
+	"array":       availableJSONTypes(json.TypeArray),
+	"string":      availableJSONTypes(json.TypeString),
+	"integer":     availableJSONTypes(json.TypeInteger),
+	"int32":       availableJSONTypes(json.TypeInteger),
+	"int64":       availableJSONTypes(json.TypeInteger),
+	"uint32":      availableJSONTypes(json.TypeInteger),
+	"uint64":      availableJSONTypes(json.TypeInteger),
+	"safeInteger": availableJSONTypes(json.TypeInteger),
+	"float":       availableJSONTypes(json.TypeFloat),
+	"boolean":     availableJSONTypes(json.TypeBoolean),
+	"null":        availableJSONTypes(json.TypeNull),
 }
 
 func availableJSONTypes(tt ...json.Type) map[json.Type]struct{} {
@@ -0,0 +1,48 @@
+package constraint
+
+import (
+	"strings"
+
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+	"github.com/jsightapi/jsight-schema-go-library/internal/json"
+)
+
+// AdditionalItems defines which items are allowed after the last element of
+// the tuple. The value of the rule has the same format as the value of the
+// "additionalProperties" rule.
+//
+// Example:
+//
+//	[ // {tuple: true, additionalItems: "string"}
+//	  404,
+//	  "Not found"
+//	]
+type AdditionalItems struct {
+	AdditionalProperties
+}
+
+var (
+	_ Constraint = AdditionalItems{}
+	_ Constraint = (*AdditionalItems)(nil)
+)
+
+// NewAdditionalItems creates an additional items constraint.
+// Might panic if got unknown JSON type.
+func NewAdditionalItems(ruleValue bytes.Bytes) *AdditionalItems {
+	return &AdditionalItems{
+		AdditionalProperties: *NewAdditionalProperties(ruleValue),
+	}
+}
+
+func (AdditionalItems) IsJsonTypeCompatible(t json.Type) bool {
+	return t == json.TypeArray
+}
+
+func (AdditionalItems) Type() Type {
+	return AdditionalItemsConstraintType
+}
+
+func (c AdditionalItems) String() string {
+	return AdditionalItemsConstraintType.String() +
+		strings.TrimPrefix(c.AdditionalProperties.String(), AdditionalPropertiesConstraintType.String())
+}
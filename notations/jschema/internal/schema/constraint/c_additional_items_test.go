@@ -0,0 +1,67 @@
+package constraint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	jschema "github.com/jsightapi/jsight-schema-go-library"
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+	"github.com/jsightapi/jsight-schema-go-library/internal/json"
+)
+
+func TestNewAdditionalItems(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		cc := map[string]AdditionalPropertiesMode{
+			"any":       AdditionalPropertiesCanBeAny,
+			"true":      AdditionalPropertiesCanBeAny,
+			"false":     AdditionalPropertiesNotAllowed,
+			`"@type"`:   AdditionalPropertiesMustBeUserType,
+			`"integer"`: AdditionalPropertiesMustBeSchemaType,
+		}
+
+		for given, expected := range cc {
+			t.Run(given, func(t *testing.T) {
+				assert.Equal(t, expected, NewAdditionalItems(bytes.Bytes(given)).Mode())
+			})
+		}
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		assert.PanicsWithError(t, `Unknown JSchema type "foo"`, func() {
+			NewAdditionalItems(bytes.Bytes(`"foo"`))
+		})
+	})
+}
+
+func TestAdditionalItems_IsJsonTypeCompatible(t *testing.T) {
+	testIsJsonTypeCompatible(t, AdditionalItems{}, json.TypeArray)
+}
+
+func TestAdditionalItems_Type(t *testing.T) {
+	assert.Equal(t, AdditionalItemsConstraintType, NewAdditionalItems(bytes.Bytes("true")).Type())
+}
+
+func TestAdditionalItems_String(t *testing.T) {
+	cc := map[string]string{
+		"true":      "additionalItems: any",
+		"false":     "additionalItems: false",
+		`"@type"`:   "additionalItems: @type",
+		`"integer"`: "additionalItems: integer",
+	}
+
+	for given, expected := range cc {
+		t.Run(given, func(t *testing.T) {
+			assert.Equal(t, expected, NewAdditionalItems(bytes.Bytes(given)).String())
+		})
+	}
+}
+
+func TestAdditionalItems_ASTNode(t *testing.T) {
+	assert.Equal(t, jschema.RuleASTNode{
+		TokenType:  jschema.TokenTypeString,
+		Value:      "@type",
+		Properties: &jschema.RuleASTNodes{},
+		Source:     jschema.RuleASTNodeSourceManual,
+	}, NewAdditionalItems(bytes.Bytes(`"@type"`)).ASTNode())
+}
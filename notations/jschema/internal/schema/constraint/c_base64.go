@@ -0,0 +1,47 @@
+package constraint
+
+import (
+	"encoding/base64"
+
+	jschema "github.com/jsightapi/jsight-schema-go-library"
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+	"github.com/jsightapi/jsight-schema-go-library/errors"
+	"github.com/jsightapi/jsight-schema-go-library/internal/json"
+)
+
+// Base64 a string encoded with the standard base64 alphabet (RFC 4648, section 4)
+// with the padding. Example: "aGVsbG8=".
+type Base64 struct{}
+
+var (
+	_ Constraint       = Base64{}
+	_ Constraint       = (*Base64)(nil)
+	_ LiteralValidator = Base64{}
+	_ LiteralValidator = (*Base64)(nil)
+)
+
+func NewBase64() *Base64 {
+	return &Base64{}
+}
+
+func (Base64) IsJsonTypeCompatible(t json.Type) bool {
+	return t == json.TypeString
+}
+
+func (Base64) Type() Type {
+	return Base64ConstraintType
+}
+
+func (Base64) String() string {
+	return Base64ConstraintType.String()
+}
+
+func (Base64) Validate(value bytes.Bytes) {
+	if _, err := base64.StdEncoding.Strict().DecodeString(value.Unquote().String()); err != nil {
+		panic(errors.Format(errors.ErrInvalidBase64, err))
+	}
+}
+
+func (Base64) ASTNode() jschema.RuleASTNode {
+	return newEmptyRuleASTNode()
+}
@@ -0,0 +1,63 @@
+package constraint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+	"github.com/jsightapi/jsight-schema-go-library/internal/json"
+)
+
+func TestBase64_IsJsonTypeCompatible(t *testing.T) {
+	testIsJsonTypeCompatible(t, Base64{}, json.TypeString)
+}
+
+func TestBase64_Type(t *testing.T) {
+	assert.Equal(t, Base64ConstraintType, NewBase64().Type())
+}
+
+func TestBase64_String(t *testing.T) {
+	assert.Equal(t, "base64", NewBase64().String())
+}
+
+func TestBase64_Validate(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		tests := []string{
+			``,
+			`aGVsbG8=`,
+			`aGVsbG8gd29ybGQ=`,
+			`+/+/`,
+			`"YQ=="`,
+		}
+
+		for _, value := range tests {
+			t.Run(value, func(t *testing.T) {
+				assert.NotPanics(t, func() {
+					NewBase64().Validate(bytes.Bytes(value))
+				})
+			})
+		}
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		tests := map[string]string{
+			"aGVsbG8":  "Invalid base64 string (illegal base64 data at input byte 4)",
+			"aGVsbG8-": "Invalid base64 string (illegal base64 data at input byte 7)",
+			"a===":     "Invalid base64 string (illegal base64 data at input byte 1)",
+			"YR==":     "Invalid base64 string (illegal base64 data at input byte 2)",
+		}
+
+		for given, expected := range tests {
+			t.Run(given, func(t *testing.T) {
+				assert.PanicsWithError(t, expected, func() {
+					NewBase64().Validate(bytes.Bytes(given))
+				})
+			})
+		}
+	})
+}
+
+func TestBase64_ASTNode(t *testing.T) {
+	assert.Equal(t, newEmptyRuleASTNode(), Base64{}.ASTNode())
+}
@@ -0,0 +1,55 @@
+package constraint
+
+import (
+	"encoding/base64"
+	"strings"
+
+	jschema "github.com/jsightapi/jsight-schema-go-library"
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+	"github.com/jsightapi/jsight-schema-go-library/errors"
+	"github.com/jsightapi/jsight-schema-go-library/internal/json"
+)
+
+// Base64URL a string encoded with the URL and filename safe base64 alphabet
+// (RFC 4648, section 5). The padding is optional. Example: "aGVsbG8", "aGVsbG8=".
+type Base64URL struct{}
+
+var (
+	_ Constraint       = Base64URL{}
+	_ Constraint       = (*Base64URL)(nil)
+	_ LiteralValidator = Base64URL{}
+	_ LiteralValidator = (*Base64URL)(nil)
+)
+
+func NewBase64Url() *Base64URL {
+	return &Base64URL{}
+}
+
+func (Base64URL) IsJsonTypeCompatible(t json.Type) bool {
+	return t == json.TypeString
+}
+
+func (Base64URL) Type() Type {
+	return Base64UrlConstraintType
+}
+
+func (Base64URL) String() string {
+	return Base64UrlConstraintType.String()
+}
+
+func (Base64URL) Validate(value bytes.Bytes) {
+	s := value.Unquote().String()
+
+	enc := base64.RawURLEncoding
+	if strings.HasSuffix(s, "=") {
+		enc = base64.URLEncoding
+	}
+
+	if _, err := enc.Strict().DecodeString(s); err != nil {
+		panic(errors.Format(errors.ErrInvalidBase64URL, err))
+	}
+}
+
+func (Base64URL) ASTNode() jschema.RuleASTNode {
+	return newEmptyRuleASTNode()
+}
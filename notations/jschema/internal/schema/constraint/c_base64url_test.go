@@ -0,0 +1,63 @@
+package constraint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+	"github.com/jsightapi/jsight-schema-go-library/internal/json"
+)
+
+func TestBase64URL_IsJsonTypeCompatible(t *testing.T) {
+	testIsJsonTypeCompatible(t, Base64URL{}, json.TypeString)
+}
+
+func TestBase64URL_Type(t *testing.T) {
+	assert.Equal(t, Base64UrlConstraintType, NewBase64Url().Type())
+}
+
+func TestBase64URL_String(t *testing.T) {
+	assert.Equal(t, "base64url", NewBase64Url().String())
+}
+
+func TestBase64URL_Validate(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		tests := []string{
+			``,
+			`aGVsbG8`,
+			`aGVsbG8=`,
+			`-_-_`,
+			`"YQ"`,
+		}
+
+		for _, value := range tests {
+			t.Run(value, func(t *testing.T) {
+				assert.NotPanics(t, func() {
+					NewBase64Url().Validate(bytes.Bytes(value))
+				})
+			})
+		}
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		tests := map[string]string{
+			"aGVsbG8+": "Invalid base64url string (illegal base64 data at input byte 7)",
+			"aGVsbG8/": "Invalid base64url string (illegal base64 data at input byte 7)",
+			"a":        "Invalid base64url string (illegal base64 data at input byte 0)",
+			"YR":       "Invalid base64url string (illegal base64 data at input byte 0)",
+		}
+
+		for given, expected := range tests {
+			t.Run(given, func(t *testing.T) {
+				assert.PanicsWithError(t, expected, func() {
+					NewBase64Url().Validate(bytes.Bytes(given))
+				})
+			})
+		}
+	})
+}
+
+func TestBase64URL_ASTNode(t *testing.T) {
+	assert.Equal(t, newEmptyRuleASTNode(), Base64URL{}.ASTNode())
+}
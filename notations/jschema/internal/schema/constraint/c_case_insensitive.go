@@ -0,0 +1,60 @@
+package constraint
+
+import (
+	"strconv"
+
+	jschema "github.com/jsightapi/jsight-schema-go-library"
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+	"github.com/jsightapi/jsight-schema-go-library/errors"
+	"github.com/jsightapi/jsight-schema-go-library/internal/json"
+)
+
+// CaseInsensitive makes the "const" and "enum" rules to compare strings
+// regardless of case.
+//
+// Example:
+//
+//	"GET" // {enum: ["GET", "POST"], caseInsensitive: true}
+type CaseInsensitive struct {
+	value bool
+}
+
+var (
+	_ Constraint = CaseInsensitive{}
+	_ Constraint = (*CaseInsensitive)(nil)
+	_ BoolKeeper = CaseInsensitive{}
+	_ BoolKeeper = (*CaseInsensitive)(nil)
+)
+
+func NewCaseInsensitive(ruleValue bytes.Bytes) *CaseInsensitive {
+	c := CaseInsensitive{}
+
+	var err error
+	if c.value, err = ruleValue.ParseBool(); err != nil {
+		panic(errors.Format(errors.ErrInvalidValueOfConstraint, CaseInsensitiveConstraintType.String()))
+	}
+	return &c
+}
+
+func (CaseInsensitive) IsJsonTypeCompatible(t json.Type) bool {
+	return t.IsLiteralType()
+}
+
+func (CaseInsensitive) Type() Type {
+	return CaseInsensitiveConstraintType
+}
+
+func (c CaseInsensitive) String() string {
+	if c.value {
+		return CaseInsensitiveConstraintType.String() + ": true"
+	}
+	return CaseInsensitiveConstraintType.String() + ": false"
+}
+
+func (c CaseInsensitive) Bool() bool {
+	return c.value
+}
+
+func (c CaseInsensitive) ASTNode() jschema.RuleASTNode {
+	return newRuleASTNode(jschema.TokenTypeBoolean, strconv.FormatBool(c.value), jschema.RuleASTNodeSourceManual)
+}
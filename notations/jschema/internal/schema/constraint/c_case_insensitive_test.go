@@ -0,0 +1,84 @@
+package constraint
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	jschema "github.com/jsightapi/jsight-schema-go-library"
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+	"github.com/jsightapi/jsight-schema-go-library/internal/json"
+)
+
+func TestNewCaseInsensitive(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		cc := map[string]bool{
+			"true":  true,
+			"false": false,
+		}
+
+		for given, expected := range cc {
+			t.Run(given, func(t *testing.T) {
+				c := NewCaseInsensitive([]byte(given))
+				assert.Equal(t, expected, c.value)
+			})
+		}
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		assert.PanicsWithError(t, `Invalid value of "caseInsensitive" constraint`, func() {
+			NewCaseInsensitive([]byte("foo"))
+		})
+	})
+}
+
+func TestCaseInsensitive_IsJsonTypeCompatible(t *testing.T) {
+	testIsJsonTypeCompatible(
+		t,
+		CaseInsensitive{},
+		json.TypeString,
+		json.TypeInteger,
+		json.TypeFloat,
+		json.TypeBoolean,
+		json.TypeNull,
+		json.TypeMixed,
+	)
+}
+
+func TestCaseInsensitive_Type(t *testing.T) {
+	assert.Equal(t, CaseInsensitiveConstraintType, NewCaseInsensitive(bytes.Bytes("true")).Type())
+}
+
+func TestCaseInsensitive_String(t *testing.T) {
+	cc := map[bool]string{
+		true:  "caseInsensitive: true",
+		false: "caseInsensitive: false",
+	}
+
+	for given, expected := range cc {
+		t.Run(expected, func(t *testing.T) {
+			assert.Equal(t, expected, CaseInsensitive{value: given}.String())
+		})
+	}
+}
+
+func TestCaseInsensitive_Bool(t *testing.T) {
+	assert.True(t, CaseInsensitive{value: true}.Bool())
+	assert.False(t, CaseInsensitive{value: false}.Bool())
+}
+
+func TestCaseInsensitive_ASTNode(t *testing.T) {
+	cc := []bool{true, false}
+
+	for _, c := range cc {
+		t.Run(strconv.FormatBool(c), func(t *testing.T) {
+			assert.Equal(t, jschema.RuleASTNode{
+				TokenType:  jschema.TokenTypeBoolean,
+				Value:      strconv.FormatBool(c),
+				Properties: &jschema.RuleASTNodes{},
+				Source:     jschema.RuleASTNodeSourceManual,
+			}, CaseInsensitive{value: c}.ASTNode())
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package constraint
+
+import (
+	"fmt"
+	"net/netip"
+
+	jschema "github.com/jsightapi/jsight-schema-go-library"
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+	"github.com/jsightapi/jsight-schema-go-library/errors"
+	"github.com/jsightapi/jsight-schema-go-library/internal/json"
+)
+
+// CIDR an IPv4 or IPv6 network in the CIDR notation. Host bits of the address
+// should be zero. Example: "10.0.0.0/8", "2001:db8::/32".
+type CIDR struct{}
+
+var (
+	_ Constraint       = CIDR{}
+	_ Constraint       = (*CIDR)(nil)
+	_ LiteralValidator = CIDR{}
+	_ LiteralValidator = (*CIDR)(nil)
+)
+
+func NewCidr() *CIDR {
+	return &CIDR{}
+}
+
+func (CIDR) IsJsonTypeCompatible(t json.Type) bool {
+	return t == json.TypeString
+}
+
+func (CIDR) Type() Type {
+	return CidrConstraintType
+}
+
+func (CIDR) String() string {
+	return CidrConstraintType.String()
+}
+
+func (CIDR) Validate(value bytes.Bytes) {
+	prefix, err := netip.ParsePrefix(value.Unquote().String())
+	if err == nil && prefix.Masked() != prefix {
+		err = fmt.Errorf("host bits are set, %s expected", prefix.Masked())
+	}
+	if err != nil {
+		panic(errors.Format(errors.ErrInvalidCIDR, err))
+	}
+}
+
+func (CIDR) ASTNode() jschema.RuleASTNode {
+	return newEmptyRuleASTNode()
+}
@@ -0,0 +1,64 @@
+package constraint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+	"github.com/jsightapi/jsight-schema-go-library/internal/json"
+)
+
+func TestCIDR_IsJsonTypeCompatible(t *testing.T) {
+	testIsJsonTypeCompatible(t, CIDR{}, json.TypeString)
+}
+
+func TestCIDR_Type(t *testing.T) {
+	assert.Equal(t, CidrConstraintType, NewCidr().Type())
+}
+
+func TestCIDR_String(t *testing.T) {
+	assert.Equal(t, "cidr", NewCidr().String())
+}
+
+func TestCIDR_Validate(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		tests := []string{
+			`10.0.0.0/8`,
+			`192.168.1.0/24`,
+			`0.0.0.0/0`,
+			`2001:db8::/32`,
+			`192.168.1.1/32`,
+		}
+
+		for _, value := range tests {
+			t.Run(value, func(t *testing.T) {
+				assert.NotPanics(t, func() {
+					NewCidr().Validate(bytes.Bytes(value))
+				})
+			})
+		}
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		tests := map[string]string{
+			"":               "Invalid CIDR notation (netip.ParsePrefix(\"\"): no '/')",
+			"10.0.0.0":       "Invalid CIDR notation (netip.ParsePrefix(\"10.0.0.0\"): no '/')",
+			"192.168.1.1/24": "Invalid CIDR notation (host bits are set, 192.168.1.0/24 expected)",
+			"10.0.0.0/33":    "Invalid CIDR notation (netip.ParsePrefix(\"10.0.0.0/33\"): prefix length out of range)",
+			"2001:db8::1/32": "Invalid CIDR notation (host bits are set, 2001:db8::/32 expected)",
+		}
+
+		for given, expected := range tests {
+			t.Run(given, func(t *testing.T) {
+				assert.PanicsWithError(t, expected, func() {
+					NewCidr().Validate(bytes.Bytes(given))
+				})
+			})
+		}
+	})
+}
+
+func TestCIDR_ASTNode(t *testing.T) {
+	assert.Equal(t, newEmptyRuleASTNode(), CIDR{}.ASTNode())
+}
@@ -10,8 +10,9 @@ import (
 )
 
 type Const struct {
-	nodeValue bytes.Bytes
-	apply     bool
+	nodeValue  bytes.Bytes
+	apply      bool
+	comparison Comparison
 }
 
 var (
@@ -59,11 +60,16 @@ func (c Const) Validate(v bytes.Bytes) {
 		return
 	}
 
-	if v.String() != c.nodeValue.String() {
+	if !c.comparison.Equal(v, c.nodeValue) {
 		panic(errors.Format(errors.ErrInvalidConst, c.nodeValue.String()))
 	}
 }
 
+// SetComparison sets the way in which values are compared.
+func (c *Const) SetComparison(cmp Comparison) {
+	c.comparison = cmp
+}
+
 func (c Const) ASTNode() jschema.RuleASTNode {
 	return newRuleASTNode(jschema.TokenTypeBoolean, strconv.FormatBool(c.apply), jschema.RuleASTNodeSourceManual)
 }
@@ -120,3 +120,18 @@ func TestConst_ASTNode(t *testing.T) {
 func fakeConst(v, nv string) *Const {
 	return NewConst(bytes.Bytes(v), bytes.Bytes(nv))
 }
+
+func TestConst_SetComparison(t *testing.T) {
+	var cmp Comparison
+	cmp.SetCaseInsensitive(true)
+
+	c := fakeConst("true", `"foo"`)
+	c.SetComparison(cmp)
+
+	assert.NotPanics(t, func() {
+		c.Validate(bytes.Bytes(`"FOO"`))
+	})
+	assert.PanicsWithError(t, `Does not match expected value ("foo")`, func() {
+		c.Validate(bytes.Bytes(`"bar"`))
+	})
+}
@@ -0,0 +1,45 @@
+package constraint
+
+import (
+	"strings"
+
+	"github.com/jsightapi/jsight-schema-go-library/internal/json"
+)
+
+// Contains describes items which the array should contain. Such items are
+// described the same way as the excluded values of the "not" rule: by the
+// value, or by the type (user type or type created from the rule-set). The
+// number of matching items is restricted by the "minContains" and
+// "maxContains" rules, at least one item is required by default.
+//
+// Example:
+//
+//	"roles": [ // {contains: "admin", maxContains: 1}
+//	  "user"
+//	]
+type Contains struct {
+	Not
+}
+
+var (
+	_ Constraint = Contains{}
+	_ Constraint = (*Contains)(nil)
+)
+
+func NewContains() *Contains {
+	return &Contains{
+		Not: *NewNot(),
+	}
+}
+
+func (Contains) IsJsonTypeCompatible(t json.Type) bool {
+	return t == json.TypeArray
+}
+
+func (Contains) Type() Type {
+	return ContainsConstraintType
+}
+
+func (c Contains) String() string {
+	return ContainsConstraintType.String() + strings.TrimPrefix(c.Not.String(), NotConstraintType.String())
+}
@@ -0,0 +1,43 @@
+package constraint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	jschema "github.com/jsightapi/jsight-schema-go-library"
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+	"github.com/jsightapi/jsight-schema-go-library/internal/json"
+)
+
+func TestContains_IsJsonTypeCompatible(t *testing.T) {
+	testIsJsonTypeCompatible(t, Contains{}, json.TypeArray)
+}
+
+func TestContains_Type(t *testing.T) {
+	assert.Equal(t, ContainsConstraintType, NewContains().Type())
+}
+
+func TestContains_String(t *testing.T) {
+	value := NewContains()
+	value.SetValue(bytes.Bytes(`"admin"`))
+
+	typ := NewContains()
+	typ.TypesList().AddNameWithASTNode("@admin", "@admin", jschema.RuleASTNode{
+		TokenType:  jschema.TokenTypeShortcut,
+		Value:      "@admin",
+		Properties: &jschema.RuleASTNodes{},
+		Source:     jschema.RuleASTNodeSourceManual,
+	})
+
+	cc := map[string]*Contains{
+		`contains: "admin"`: value,
+		`contains: @admin`:  typ,
+	}
+
+	for expected, c := range cc {
+		t.Run(expected, func(t *testing.T) {
+			assert.Equal(t, expected, c.String())
+		})
+	}
+}
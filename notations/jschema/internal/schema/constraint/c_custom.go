@@ -0,0 +1,134 @@
+package constraint
+
+import (
+	"strings"
+
+	jschema "github.com/jsightapi/jsight-schema-go-library"
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+	"github.com/jsightapi/jsight-schema-go-library/errors"
+	"github.com/jsightapi/jsight-schema-go-library/internal/json"
+)
+
+// Custom the rules implemented in Go and registered in the jschema.Registry.
+// All custom rules of the node are kept in the single constraint, because the
+// node can't contain several constraints of the same type.
+type Custom struct {
+	rules []customRule
+}
+
+type customRule struct {
+	name  string
+	rule  jschema.CustomRule
+	raw   bytes.Bytes
+	value interface{}
+}
+
+var (
+	_ Constraint       = Custom{}
+	_ Constraint       = (*Custom)(nil)
+	_ LiteralValidator = Custom{}
+	_ LiteralValidator = (*Custom)(nil)
+)
+
+func NewCustom() *Custom {
+	return &Custom{}
+}
+
+// Add adds the custom rule with specified value. Panics if the rule can't parse
+// the value.
+func (c *Custom) Add(name string, rule jschema.CustomRule, ruleValue bytes.Bytes) {
+	for _, r := range c.rules {
+		if r.name == name {
+			panic(errors.Format(errors.ErrDuplicateRule, name))
+		}
+	}
+
+	var value interface{} = ruleValue
+	if rule.ParseValue != nil {
+		var err error
+		if value, err = rule.ParseValue(ruleValue); err != nil {
+			panic(errors.Format(errors.ErrInvalidValueInCustomRule, name, err))
+		}
+	}
+
+	c.rules = append(c.rules, customRule{
+		name:  name,
+		rule:  rule,
+		raw:   ruleValue,
+		value: value,
+	})
+}
+
+// Names returns names of the added rules.
+func (c Custom) Names() []string {
+	nn := make([]string, 0, len(c.rules))
+	for _, r := range c.rules {
+		nn = append(nn, r.name)
+	}
+	return nn
+}
+
+func (c Custom) IsJsonTypeCompatible(t json.Type) bool {
+	return c.IncompatibleRule(t) == ""
+}
+
+// IncompatibleRule returns the name of the first rule which can't be used with
+// specified JSON type. Returns an empty string if all rules are compatible.
+func (c Custom) IncompatibleRule(t json.Type) string {
+	for _, r := range c.rules {
+		if !r.isJsonTypeCompatible(t) {
+			return r.name
+		}
+	}
+	return ""
+}
+
+func (r customRule) isJsonTypeCompatible(t json.Type) bool {
+	if r.rule.IsJsonTypeCompatible == nil {
+		return t != json.TypeObject && t != json.TypeArray
+	}
+	return r.rule.IsJsonTypeCompatible(jschema.SchemaType(t.String()))
+}
+
+func (Custom) Type() Type {
+	return CustomConstraintType
+}
+
+func (c Custom) String() string {
+	ss := make([]string, 0, len(c.rules))
+	for _, r := range c.rules {
+		ss = append(ss, r.name+": "+r.raw.String())
+	}
+	return strings.Join(ss, ", ")
+}
+
+func (c Custom) Validate(value bytes.Bytes) {
+	for _, r := range c.rules {
+		if err := r.rule.Validate(r.value, value); err != nil {
+			panic(errors.Format(errors.ErrConstraintCustomValidation, r.name, err))
+		}
+	}
+}
+
+// ASTNode returns an object AST node, where each property is the AST node of
+// the custom rule.
+func (c Custom) ASTNode() jschema.RuleASTNode {
+	an := newEmptyRuleASTNode()
+	an.TokenType = jschema.TokenTypeObject
+	for _, r := range c.rules {
+		an.Properties.Set(r.name, r.astNode())
+	}
+	return an
+}
+
+func (r customRule) astNode() jschema.RuleASTNode {
+	if r.rule.ASTNode != nil {
+		return r.rule.ASTNode(r.value)
+	}
+
+	return newRuleASTNode(
+		json.Guess(r.raw).LiteralJsonType().ToTokenType(),
+		r.raw.Unquote().String(),
+		jschema.RuleASTNodeSourceManual,
+	)
+}
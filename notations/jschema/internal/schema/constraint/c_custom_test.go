@@ -0,0 +1,149 @@
+package constraint
+
+import (
+	stdErrors "errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	jschema "github.com/jsightapi/jsight-schema-go-library"
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+	"github.com/jsightapi/jsight-schema-go-library/internal/json"
+)
+
+func newTestCustomRule() jschema.CustomRule {
+	return jschema.CustomRule{
+		Validate: func(ruleValue interface{}, value []byte) error {
+			if string(value) != string(ruleValue.(bytes.Bytes)) {
+				return stdErrors.New("fake error")
+			}
+			return nil
+		},
+	}
+}
+
+func TestCustom_Add(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		c := NewCustom()
+		c.Add("foo", newTestCustomRule(), bytes.Bytes(`"DE"`))
+		c.Add("bar", jschema.CustomRule{
+			ParseValue: func(v []byte) (interface{}, error) { return string(v), nil },
+			Validate:   func(interface{}, []byte) error { return nil },
+		}, bytes.Bytes(`42`))
+
+		assert.Equal(t, []string{"foo", "bar"}, c.Names())
+		assert.Equal(t, "42", c.rules[1].value)
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		t.Run("duplicate", func(t *testing.T) {
+			c := NewCustom()
+			c.Add("foo", newTestCustomRule(), bytes.Bytes(`"DE"`))
+
+			assert.PanicsWithError(t, `Duplicate "foo" rule`, func() {
+				c.Add("foo", newTestCustomRule(), bytes.Bytes(`"DE"`))
+			})
+		})
+
+		t.Run("invalid value", func(t *testing.T) {
+			assert.PanicsWithError(t, `Invalid value of the "foo" rule (fake error)`, func() {
+				NewCustom().Add("foo", jschema.CustomRule{
+					ParseValue: func([]byte) (interface{}, error) { return nil, stdErrors.New("fake error") },
+					Validate:   func(interface{}, []byte) error { return nil },
+				}, bytes.Bytes(`42`))
+			})
+		})
+	})
+}
+
+func TestCustom_IsJsonTypeCompatible(t *testing.T) {
+	t.Run("default", func(t *testing.T) {
+		c := NewCustom()
+		c.Add("foo", newTestCustomRule(), bytes.Bytes(`"DE"`))
+
+		assert.True(t, c.IsJsonTypeCompatible(json.TypeString))
+		assert.True(t, c.IsJsonTypeCompatible(json.TypeInteger))
+		assert.False(t, c.IsJsonTypeCompatible(json.TypeObject))
+		assert.False(t, c.IsJsonTypeCompatible(json.TypeArray))
+	})
+
+	t.Run("custom", func(t *testing.T) {
+		r := newTestCustomRule()
+		r.IsJsonTypeCompatible = func(t jschema.SchemaType) bool {
+			return t == jschema.SchemaTypeArray
+		}
+
+		c := NewCustom()
+		c.Add("foo", r, bytes.Bytes(`"DE"`))
+
+		testIsJsonTypeCompatible(t, c, json.TypeArray)
+	})
+}
+
+func TestCustom_Type(t *testing.T) {
+	assert.Equal(t, CustomConstraintType, NewCustom().Type())
+}
+
+func TestCustom_String(t *testing.T) {
+	c := NewCustom()
+	c.Add("foo", newTestCustomRule(), bytes.Bytes(`"DE"`))
+	c.Add("bar", newTestCustomRule(), bytes.Bytes(`42`))
+
+	assert.Equal(t, `foo: "DE", bar: 42`, c.String())
+}
+
+func TestCustom_Validate(t *testing.T) {
+	c := NewCustom()
+	c.Add("foo", newTestCustomRule(), bytes.Bytes(`"DE"`))
+
+	t.Run("positive", func(t *testing.T) {
+		assert.NotPanics(t, func() {
+			c.Validate(bytes.Bytes(`"DE"`))
+		})
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		assert.PanicsWithError(t, `The value doesn't match the "foo" rule (fake error)`, func() {
+			c.Validate(bytes.Bytes(`"FR"`))
+		})
+	})
+}
+
+func TestCustom_ASTNode(t *testing.T) {
+	r := newTestCustomRule()
+	r.ASTNode = func(interface{}) jschema.RuleASTNode {
+		return newRuleASTNode(jschema.TokenTypeBoolean, "true", jschema.RuleASTNodeSourceGenerated)
+	}
+
+	c := NewCustom()
+	c.Add("foo", newTestCustomRule(), bytes.Bytes(`"DE"`))
+	c.Add("bar", r, bytes.Bytes(`42`))
+
+	expected := newEmptyRuleASTNode()
+	expected.TokenType = jschema.TokenTypeObject
+	expected.Properties.Set(
+		"foo",
+		newRuleASTNode(jschema.TokenTypeString, "DE", jschema.RuleASTNodeSourceManual),
+	)
+	expected.Properties.Set(
+		"bar",
+		newRuleASTNode(jschema.TokenTypeBoolean, "true", jschema.RuleASTNodeSourceGenerated),
+	)
+
+	assert.Equal(t, expected, c.ASTNode())
+}
+
+func TestCustom_IncompatibleRule(t *testing.T) {
+	r := newTestCustomRule()
+	r.IsJsonTypeCompatible = func(t jschema.SchemaType) bool {
+		return t == jschema.SchemaTypeString
+	}
+
+	c := NewCustom()
+	c.Add("foo", newTestCustomRule(), bytes.Bytes(`"DE"`))
+	c.Add("bar", r, bytes.Bytes(`42`))
+
+	assert.Equal(t, "", c.IncompatibleRule(json.TypeString))
+	assert.Equal(t, "bar", c.IncompatibleRule(json.TypeInteger))
+	assert.Equal(t, "foo", c.IncompatibleRule(json.TypeObject))
+}
@@ -0,0 +1,65 @@
+package constraint
+
+import (
+	jschema "github.com/jsightapi/jsight-schema-go-library"
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+	"github.com/jsightapi/jsight-schema-go-library/errors"
+	"github.com/jsightapi/jsight-schema-go-library/internal/json"
+)
+
+// CustomType the type implemented in Go and registered in the
+// jschema.Registry.
+type CustomType struct {
+	name string
+	typ  jschema.CustomType
+}
+
+var (
+	_ Constraint       = CustomType{}
+	_ Constraint       = (*CustomType)(nil)
+	_ LiteralValidator = CustomType{}
+	_ LiteralValidator = (*CustomType)(nil)
+)
+
+func NewCustomType(name string, typ jschema.CustomType) *CustomType {
+	if typ.BaseType == jschema.SchemaTypeUndefined {
+		typ.BaseType = jschema.SchemaTypeString
+	}
+
+	return &CustomType{
+		name: name,
+		typ:  typ,
+	}
+}
+
+// Name returns the name of the type.
+func (c CustomType) Name() string {
+	return c.name
+}
+
+// JsonType returns the JSON type of the values.
+func (c CustomType) JsonType() json.Type {
+	return json.NewJsonType(bytes.Bytes(c.typ.BaseType))
+}
+
+func (c CustomType) IsJsonTypeCompatible(t json.Type) bool {
+	return t == c.JsonType()
+}
+
+func (CustomType) Type() Type {
+	return CustomTypeConstraintType
+}
+
+func (c CustomType) String() string {
+	return CustomTypeConstraintType.String() + ": " + c.name
+}
+
+func (c CustomType) Validate(value bytes.Bytes) {
+	if err := c.typ.Validate(value); err != nil {
+		panic(errors.Format(errors.ErrCustomTypeValidation, c.name, err))
+	}
+}
+
+func (CustomType) ASTNode() jschema.RuleASTNode {
+	return newEmptyRuleASTNode()
+}
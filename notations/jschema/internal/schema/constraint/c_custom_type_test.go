@@ -0,0 +1,55 @@
+package constraint
+
+import (
+	stdErrors "errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	jschema "github.com/jsightapi/jsight-schema-go-library"
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+	"github.com/jsightapi/jsight-schema-go-library/internal/json"
+)
+
+func newTestCustomType(base jschema.SchemaType) *CustomType {
+	return NewCustomType("foo", jschema.CustomType{
+		BaseType: base,
+		Validate: func(value []byte) error {
+			if len(value) < 3 {
+				return stdErrors.New("fake error")
+			}
+			return nil
+		},
+	})
+}
+
+func TestCustomType_IsJsonTypeCompatible(t *testing.T) {
+	testIsJsonTypeCompatible(t, newTestCustomType(jschema.SchemaTypeUndefined), json.TypeString)
+	testIsJsonTypeCompatible(t, newTestCustomType(jschema.SchemaTypeInteger), json.TypeInteger)
+}
+
+func TestCustomType_Type(t *testing.T) {
+	assert.Equal(t, CustomTypeConstraintType, newTestCustomType("").Type())
+}
+
+func TestCustomType_String(t *testing.T) {
+	assert.Equal(t, "customType: foo", newTestCustomType("").String())
+}
+
+func TestCustomType_Validate(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		assert.NotPanics(t, func() {
+			newTestCustomType("").Validate(bytes.Bytes(`"DE"`))
+		})
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		assert.PanicsWithError(t, `Invalid value of the "foo" type (fake error)`, func() {
+			newTestCustomType("").Validate(bytes.Bytes(`""`))
+		})
+	})
+}
+
+func TestCustomType_ASTNode(t *testing.T) {
+	assert.Equal(t, newEmptyRuleASTNode(), newTestCustomType("").ASTNode())
+}
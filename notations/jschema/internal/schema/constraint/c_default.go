@@ -0,0 +1,55 @@
+package constraint
+
+import (
+	jschema "github.com/jsightapi/jsight-schema-go-library"
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+	"github.com/jsightapi/jsight-schema-go-library/internal/json"
+)
+
+// Default the value of the optional object property which is used when the key
+// is missing. Doesn't affect validation, but is used to fill in the missing keys
+// of documents.
+//
+// Example:
+//
+//	"limit": 10 // {optional: true, default: 20}
+type Default struct {
+	value bytes.Bytes
+}
+
+var (
+	_ Constraint = Default{}
+	_ Constraint = (*Default)(nil)
+)
+
+func NewDefault(ruleValue bytes.Bytes) *Default {
+	return &Default{
+		value: ruleValue.TrimSpaces(),
+	}
+}
+
+func (Default) IsJsonTypeCompatible(json.Type) bool {
+	return true
+}
+
+func (Default) Type() Type {
+	return DefaultConstraintType
+}
+
+func (c Default) String() string {
+	return DefaultConstraintType.String() + ": " + c.value.String()
+}
+
+// Value returns the default value as is.
+func (c Default) Value() bytes.Bytes {
+	return c.value
+}
+
+func (c Default) ASTNode() jschema.RuleASTNode {
+	t := json.Guess(c.value).JsonType()
+	v := c.value
+	if t == json.TypeString {
+		v = v.Unquote()
+	}
+	return newRuleASTNode(t.ToTokenType(), v.String(), jschema.RuleASTNodeSourceManual)
+}
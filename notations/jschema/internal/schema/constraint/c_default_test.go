@@ -0,0 +1,76 @@
+package constraint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	jschema "github.com/jsightapi/jsight-schema-go-library"
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+)
+
+func TestNewDefault(t *testing.T) {
+	c := NewDefault(bytes.Bytes(` "foo" `))
+	assert.Equal(t, bytes.Bytes(`"foo"`), c.value)
+}
+
+func TestDefault_IsJsonTypeCompatible(t *testing.T) {
+	testIsJsonTypeCompatible(t, Default{}, allJSONTypes...)
+}
+
+func TestDefault_Type(t *testing.T) {
+	assert.Equal(t, DefaultConstraintType, NewDefault(bytes.Bytes("42")).Type())
+}
+
+func TestDefault_String(t *testing.T) {
+	cc := map[string]string{
+		"42":    "default: 42",
+		`"foo"`: `default: "foo"`,
+		"null":  "default: null",
+	}
+
+	for given, expected := range cc {
+		t.Run(given, func(t *testing.T) {
+			assert.Equal(t, expected, NewDefault(bytes.Bytes(given)).String())
+		})
+	}
+}
+
+func TestDefault_Value(t *testing.T) {
+	assert.Equal(t, bytes.Bytes("true"), NewDefault(bytes.Bytes("true")).Value())
+}
+
+func TestDefault_ASTNode(t *testing.T) {
+	cc := map[string]jschema.RuleASTNode{
+		"42": {
+			TokenType:  jschema.TokenTypeNumber,
+			Value:      "42",
+			Properties: &jschema.RuleASTNodes{},
+			Source:     jschema.RuleASTNodeSourceManual,
+		},
+		`"foo"`: {
+			TokenType:  jschema.TokenTypeString,
+			Value:      "foo",
+			Properties: &jschema.RuleASTNodes{},
+			Source:     jschema.RuleASTNodeSourceManual,
+		},
+		"false": {
+			TokenType:  jschema.TokenTypeBoolean,
+			Value:      "false",
+			Properties: &jschema.RuleASTNodes{},
+			Source:     jschema.RuleASTNodeSourceManual,
+		},
+		"null": {
+			TokenType:  jschema.TokenTypeNull,
+			Value:      "null",
+			Properties: &jschema.RuleASTNodes{},
+			Source:     jschema.RuleASTNodeSourceManual,
+		},
+	}
+
+	for given, expected := range cc {
+		t.Run(given, func(t *testing.T) {
+			assert.Equal(t, expected, NewDefault(bytes.Bytes(given)).ASTNode())
+		})
+	}
+}
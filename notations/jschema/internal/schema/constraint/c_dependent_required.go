@@ -0,0 +1,113 @@
+package constraint
+
+import (
+	"strings"
+
+	jschema "github.com/jsightapi/jsight-schema-go-library"
+	"github.com/jsightapi/jsight-schema-go-library/internal/json"
+)
+
+// DependentRequired makes keys of the object required when another key is
+// present.
+//
+// Example:
+//
+//	{dependentRequired: {"endDate": ["startDate"]}} - the "startDate" key is
+//	  required when the "endDate" key is present.
+type DependentRequired struct {
+	// dependencies a map of the key to the keys which it depends on.
+	dependencies map[string][]string
+
+	// keys a list of keys with dependencies, in order of declaration.
+	keys []string
+}
+
+var (
+	_ Constraint = DependentRequired{}
+	_ Constraint = (*DependentRequired)(nil)
+)
+
+func NewDependentRequired() *DependentRequired {
+	return &DependentRequired{
+		dependencies: make(map[string][]string, 5),
+	}
+}
+
+func (DependentRequired) IsJsonTypeCompatible(t json.Type) bool {
+	return t == json.TypeObject
+}
+
+func (DependentRequired) Type() Type {
+	return DependentRequiredConstraintType
+}
+
+func (c DependentRequired) String() string {
+	var str strings.Builder
+	str.WriteString(DependentRequiredConstraintType.String())
+	str.WriteString(": ")
+	for i, k := range c.keys {
+		if i > 0 {
+			str.WriteString(", ")
+		}
+		str.WriteString(k)
+		str.WriteString(" -> ")
+		str.WriteString(strings.Join(c.dependencies[k], " "))
+	}
+	return str.String()
+}
+
+// Add adds the dependency of the key.
+func (c *DependentRequired) Add(key, dependency string) {
+	dd, ok := c.dependencies[key]
+	if !ok {
+		c.keys = append(c.keys, key)
+	}
+	c.dependencies[key] = append(dd, dependency)
+}
+
+// Keys returns the list of keys with dependencies.
+func (c DependentRequired) Keys() []string {
+	return c.keys
+}
+
+// Dependencies returns the keys which the key depends on.
+func (c DependentRequired) Dependencies(key string) []string {
+	return c.dependencies[key]
+}
+
+// FindMissing returns the first found key which dependency isn't found. The
+// has function reports whether the key is found in the object.
+func (c DependentRequired) FindMissing(has func(key string) bool) (key, dependency string, ok bool) {
+	for _, k := range c.keys {
+		if !has(k) {
+			continue
+		}
+
+		for _, d := range c.dependencies[k] {
+			if !has(d) {
+				return k, d, true
+			}
+		}
+	}
+	return "", "", false
+}
+
+func (c DependentRequired) ASTNode() jschema.RuleASTNode {
+	const source = jschema.RuleASTNodeSourceManual
+
+	n := newRuleASTNode(jschema.TokenTypeObject, "", source)
+	n.Properties = jschema.MakeRuleASTNodes(len(c.keys))
+
+	for _, k := range c.keys {
+		dd := c.dependencies[k]
+
+		an := newRuleASTNode(jschema.TokenTypeArray, "", source)
+		an.Items = make([]jschema.RuleASTNode, 0, len(dd))
+		for _, d := range dd {
+			an.Items = append(an.Items, newRuleASTNode(jschema.TokenTypeString, d, source))
+		}
+		n.Properties.Set(k, an)
+	}
+
+	return n
+}
@@ -0,0 +1,110 @@
+package constraint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	jschema "github.com/jsightapi/jsight-schema-go-library"
+	"github.com/jsightapi/jsight-schema-go-library/internal/json"
+)
+
+func newTestDependentRequired() *DependentRequired {
+	c := NewDependentRequired()
+	c.Add("endDate", "startDate")
+	c.Add("cardNumber", "cardHolder")
+	c.Add("cardNumber", "cvv")
+	return c
+}
+
+func TestDependentRequired_IsJsonTypeCompatible(t *testing.T) {
+	testIsJsonTypeCompatible(t, DependentRequired{}, json.TypeObject)
+}
+
+func TestDependentRequired_Type(t *testing.T) {
+	assert.Equal(t, DependentRequiredConstraintType, NewDependentRequired().Type())
+}
+
+func TestDependentRequired_String(t *testing.T) {
+	assert.Equal(
+		t,
+		"dependentRequired: endDate -> startDate, cardNumber -> cardHolder cvv",
+		newTestDependentRequired().String(),
+	)
+}
+
+func TestDependentRequired_Keys(t *testing.T) {
+	c := newTestDependentRequired()
+
+	assert.Equal(t, []string{"endDate", "cardNumber"}, c.Keys())
+	assert.Equal(t, []string{"cardHolder", "cvv"}, c.Dependencies("cardNumber"))
+	assert.Nil(t, c.Dependencies("startDate"))
+}
+
+func TestDependentRequired_FindMissing(t *testing.T) {
+	c := newTestDependentRequired()
+
+	has := func(kk ...string) func(string) bool {
+		return func(key string) bool {
+			for _, k := range kk {
+				if k == key {
+					return true
+				}
+			}
+			return false
+		}
+	}
+
+	t.Run("positive", func(t *testing.T) {
+		cc := map[string][]string{
+			"no keys":          nil,
+			"dependency only":  {"startDate"},
+			"all dependencies": {"endDate", "startDate", "cardNumber", "cardHolder", "cvv"},
+		}
+
+		for name, kk := range cc {
+			t.Run(name, func(t *testing.T) {
+				_, _, missing := c.FindMissing(has(kk...))
+				assert.False(t, missing)
+			})
+		}
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		key, dependency, missing := c.FindMissing(has("endDate", "startDate", "cardNumber", "cardHolder"))
+
+		assert.True(t, missing)
+		assert.Equal(t, "cardNumber", key)
+		assert.Equal(t, "cvv", dependency)
+	})
+}
+
+func TestDependentRequired_ASTNode(t *testing.T) {
+	const source = jschema.RuleASTNodeSourceManual
+
+	c := NewDependentRequired()
+	c.Add("endDate", "startDate")
+
+	assert.Equal(t, jschema.RuleASTNode{
+		TokenType: jschema.TokenTypeObject,
+		Properties: jschema.NewRuleASTNodes(
+			map[string]jschema.RuleASTNode{
+				"endDate": {
+					TokenType:  jschema.TokenTypeArray,
+					Properties: &jschema.RuleASTNodes{},
+					Items: []jschema.RuleASTNode{
+						{
+							TokenType:  jschema.TokenTypeString,
+							Value:      "startDate",
+							Properties: &jschema.RuleASTNodes{},
+							Source:     source,
+						},
+					},
+					Source: source,
+				},
+			},
+			[]string{"endDate"},
+		),
+		Source: source,
+	}, c.ASTNode())
+}
@@ -0,0 +1,60 @@
+package constraint
+
+import (
+	"strconv"
+
+	jschema "github.com/jsightapi/jsight-schema-go-library"
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+	"github.com/jsightapi/jsight-schema-go-library/errors"
+	"github.com/jsightapi/jsight-schema-go-library/internal/json"
+)
+
+// Deprecated marks the value as deprecated. Doesn't affect validation, but
+// deprecated object keys found in the document can be reported.
+//
+// Example:
+//
+//	"fax": "" // {deprecated: true}
+type Deprecated struct {
+	value bool
+}
+
+var (
+	_ Constraint = Deprecated{}
+	_ Constraint = (*Deprecated)(nil)
+	_ BoolKeeper = Deprecated{}
+	_ BoolKeeper = (*Deprecated)(nil)
+)
+
+func NewDeprecated(ruleValue bytes.Bytes) *Deprecated {
+	c := Deprecated{}
+
+	var err error
+	if c.value, err = ruleValue.ParseBool(); err != nil {
+		panic(errors.Format(errors.ErrInvalidValueOfConstraint, DeprecatedConstraintType.String()))
+	}
+	return &c
+}
+
+func (Deprecated) IsJsonTypeCompatible(json.Type) bool {
+	return true
+}
+
+func (Deprecated) Type() Type {
+	return DeprecatedConstraintType
+}
+
+func (c Deprecated) String() string {
+	if c.value {
+		return DeprecatedConstraintType.String() + ": true"
+	}
+	return DeprecatedConstraintType.String() + ": false"
+}
+
+func (c Deprecated) Bool() bool {
+	return c.value
+}
+
+func (c Deprecated) ASTNode() jschema.RuleASTNode {
+	return newRuleASTNode(jschema.TokenTypeBoolean, strconv.FormatBool(c.value), jschema.RuleASTNodeSourceManual)
+}
@@ -0,0 +1,82 @@
+package constraint
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	jschema "github.com/jsightapi/jsight-schema-go-library"
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+)
+
+func TestNewDeprecated(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		cc := map[string]bool{
+			"true":  true,
+			"false": false,
+		}
+
+		for given, expected := range cc {
+			t.Run(given, func(t *testing.T) {
+				c := NewDeprecated([]byte(given))
+				assert.Equal(t, expected, c.value)
+			})
+		}
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		assert.PanicsWithError(t, `Invalid value of "deprecated" constraint`, func() {
+			NewDeprecated([]byte("foo"))
+		})
+	})
+}
+
+func TestDeprecated_IsJsonTypeCompatible(t *testing.T) {
+	testIsJsonTypeCompatible(t, Deprecated{}, allJSONTypes...)
+}
+
+func TestDeprecated_Type(t *testing.T) {
+	assert.Equal(t, DeprecatedConstraintType, NewDeprecated(bytes.Bytes("true")).Type())
+}
+
+func TestDeprecated_String(t *testing.T) {
+	cc := map[string]string{
+		"false": "deprecated: false",
+		"true":  "deprecated: true",
+	}
+
+	for given, expected := range cc {
+		t.Run(given, func(t *testing.T) {
+			assert.Equal(t, expected, NewDeprecated([]byte(given)).String())
+		})
+	}
+}
+
+func TestDeprecated_Bool(t *testing.T) {
+	cc := map[string]bool{
+		"false": false,
+		"true":  true,
+	}
+
+	for given, expected := range cc {
+		t.Run(given, func(t *testing.T) {
+			assert.Equal(t, expected, NewDeprecated([]byte(given)).Bool())
+		})
+	}
+}
+
+func TestDeprecated_ASTNode(t *testing.T) {
+	cc := []bool{true, false}
+
+	for _, c := range cc {
+		t.Run(strconv.FormatBool(c), func(t *testing.T) {
+			assert.Equal(t, jschema.RuleASTNode{
+				TokenType:  jschema.TokenTypeBoolean,
+				Value:      strconv.FormatBool(c),
+				Properties: &jschema.RuleASTNodes{},
+				Source:     jschema.RuleASTNodeSourceManual,
+			}, Deprecated{value: c}.ASTNode())
+		})
+	}
+}
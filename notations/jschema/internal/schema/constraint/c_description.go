@@ -0,0 +1,57 @@
+package constraint
+
+import (
+	"encoding/json"
+
+	jschema "github.com/jsightapi/jsight-schema-go-library"
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+	"github.com/jsightapi/jsight-schema-go-library/errors"
+	internalJSON "github.com/jsightapi/jsight-schema-go-library/internal/json"
+)
+
+// Description a human-readable explanation of the value. Doesn't affect
+// validation.
+//
+// Example:
+//
+//	"id": 1 // {description: "The unique identifier of the user"}
+type Description struct {
+	value string
+}
+
+var (
+	_ Constraint = Description{}
+	_ Constraint = (*Description)(nil)
+)
+
+func NewDescription(ruleValue bytes.Bytes) *Description {
+	if !ruleValue.InQuotes() {
+		panic(errors.Format(errors.ErrInvalidValueOfConstraint, DescriptionConstraintType.String()))
+	}
+
+	c := Description{}
+	if err := json.Unmarshal(ruleValue, &c.value); err != nil {
+		panic(errors.Format(errors.ErrInvalidValueOfConstraint, DescriptionConstraintType.String()))
+	}
+	return &c
+}
+
+func (Description) IsJsonTypeCompatible(internalJSON.Type) bool {
+	return true
+}
+
+func (Description) Type() Type {
+	return DescriptionConstraintType
+}
+
+func (c Description) String() string {
+	return DescriptionConstraintType.String() + ": " + c.value
+}
+
+func (c Description) Value() string {
+	return c.value
+}
+
+func (c Description) ASTNode() jschema.RuleASTNode {
+	return newRuleASTNode(jschema.TokenTypeString, c.value, jschema.RuleASTNodeSourceManual)
+}
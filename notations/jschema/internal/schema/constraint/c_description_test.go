@@ -0,0 +1,63 @@
+package constraint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	jschema "github.com/jsightapi/jsight-schema-go-library"
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+)
+
+func TestNewDescription(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		cc := map[string]string{
+			`"foo"`:         "foo",
+			`""`:            "",
+			`"foo \"bar\""`: `foo "bar"`,
+		}
+
+		for given, expected := range cc {
+			t.Run(given, func(t *testing.T) {
+				assert.Equal(t, expected, NewDescription(bytes.Bytes(given)).Value())
+			})
+		}
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		ss := []string{
+			`foo`,
+			`42`,
+			`true`,
+		}
+
+		for _, s := range ss {
+			t.Run(s, func(t *testing.T) {
+				assert.PanicsWithError(t, `Invalid value of "description" constraint`, func() {
+					NewDescription(bytes.Bytes(s))
+				})
+			})
+		}
+	})
+}
+
+func TestDescription_IsJsonTypeCompatible(t *testing.T) {
+	testIsJsonTypeCompatible(t, Description{}, allJSONTypes...)
+}
+
+func TestDescription_Type(t *testing.T) {
+	assert.Equal(t, DescriptionConstraintType, NewDescription(bytes.Bytes(`"foo"`)).Type())
+}
+
+func TestDescription_String(t *testing.T) {
+	assert.Equal(t, "description: foo", NewDescription(bytes.Bytes(`"foo"`)).String())
+}
+
+func TestDescription_ASTNode(t *testing.T) {
+	assert.Equal(t, jschema.RuleASTNode{
+		TokenType:  jschema.TokenTypeString,
+		Value:      "foo",
+		Properties: &jschema.RuleASTNodes{},
+		Source:     jschema.RuleASTNodeSourceManual,
+	}, NewDescription(bytes.Bytes(`"foo"`)).ASTNode())
+}
@@ -0,0 +1,105 @@
+package constraint
+
+import (
+	"encoding/json"
+
+	jschema "github.com/jsightapi/jsight-schema-go-library"
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+	"github.com/jsightapi/jsight-schema-go-library/errors"
+	internalJSON "github.com/jsightapi/jsight-schema-go-library/internal/json"
+)
+
+// Discriminator selects the type from the "or" rule by the value of the object
+// key.
+//
+// Example:
+//
+//	{or: ["@cat", "@dog"], discriminator: "kind"} - the "@cat" type is used
+//	  when the "kind" key is equal to the value of the "kind" key of this type.
+type Discriminator struct {
+	// branches a map of the canonical value of the key to the type name.
+	branches map[string]string
+
+	key string
+
+	// values a list of values of the key, in order of addition.
+	values []string
+}
+
+var (
+	_ Constraint = Discriminator{}
+	_ Constraint = (*Discriminator)(nil)
+)
+
+func NewDiscriminator(ruleValue bytes.Bytes) *Discriminator {
+	c := Discriminator{
+		branches: make(map[string]string, 5),
+	}
+
+	if !ruleValue.InQuotes() {
+		panic(errors.Format(errors.ErrInvalidValueOfConstraint, DiscriminatorConstraintType.String()))
+	}
+
+	if err := json.Unmarshal(ruleValue, &c.key); err != nil {
+		panic(err)
+	}
+
+	if c.key == "" {
+		panic(errors.Format(errors.ErrInvalidValueOfConstraint, DiscriminatorConstraintType.String()))
+	}
+	return &c
+}
+
+func (Discriminator) IsJsonTypeCompatible(internalJSON.Type) bool {
+	return true
+}
+
+func (Discriminator) Type() Type {
+	return DiscriminatorConstraintType
+}
+
+func (c Discriminator) String() string {
+	return DiscriminatorConstraintType.String() + ": " + c.key
+}
+
+// Key returns the name of the object key which selects the type.
+func (c Discriminator) Key() string {
+	return c.key
+}
+
+// AddBranch adds the type which is selected by the value of the key. Returns
+// the name of the other type with the same value, if any.
+func (c *Discriminator) AddBranch(value bytes.Bytes, typeName string) (string, bool) {
+	k, err := internalJSON.Canonical(value)
+	if err != nil {
+		panic(err)
+	}
+
+	if n, ok := c.branches[k]; ok {
+		return n, n != typeName
+	}
+
+	c.branches[k] = typeName
+	c.values = append(c.values, value.String())
+	return "", false
+}
+
+// Branch returns the name of the type selected by the value of the key.
+func (c Discriminator) Branch(value bytes.Bytes) (string, bool) {
+	k, err := internalJSON.Canonical(value)
+	if err != nil {
+		return "", false
+	}
+
+	n, ok := c.branches[k]
+	return n, ok
+}
+
+// Values returns all known values of the key.
+func (c Discriminator) Values() []string {
+	return c.values
+}
+
+func (c Discriminator) ASTNode() jschema.RuleASTNode {
+	return newRuleASTNode(jschema.TokenTypeString, c.key, jschema.RuleASTNodeSourceManual)
+}
@@ -0,0 +1,102 @@
+package constraint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	jschema "github.com/jsightapi/jsight-schema-go-library"
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+)
+
+func TestNewDiscriminator(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		c := NewDiscriminator(bytes.Bytes(`"kind"`))
+		assert.Equal(t, "kind", c.Key())
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		ss := []string{`kind`, `""`, `1`}
+
+		for _, given := range ss {
+			t.Run(given, func(t *testing.T) {
+				assert.PanicsWithError(t, `Invalid value of "discriminator" constraint`, func() {
+					NewDiscriminator(bytes.Bytes(given))
+				})
+			})
+		}
+	})
+}
+
+func TestDiscriminator_IsJsonTypeCompatible(t *testing.T) {
+	testIsJsonTypeCompatible(t, Discriminator{}, allJSONTypes...)
+}
+
+func TestDiscriminator_Type(t *testing.T) {
+	assert.Equal(t, DiscriminatorConstraintType, Discriminator{}.Type())
+}
+
+func TestDiscriminator_String(t *testing.T) {
+	assert.Equal(t, "discriminator: kind", NewDiscriminator(bytes.Bytes(`"kind"`)).String())
+}
+
+func TestDiscriminator_AddBranch(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		c := NewDiscriminator(bytes.Bytes(`"kind"`))
+
+		_, conflict := c.AddBranch(bytes.Bytes(`"cat"`), "@cat")
+		assert.False(t, conflict)
+
+		_, conflict = c.AddBranch(bytes.Bytes(`"dog"`), "@dog")
+		assert.False(t, conflict)
+
+		_, conflict = c.AddBranch(bytes.Bytes(`"cat"`), "@cat")
+		assert.False(t, conflict)
+
+		assert.Equal(t, []string{`"cat"`, `"dog"`}, c.Values())
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		c := NewDiscriminator(bytes.Bytes(`"kind"`))
+		c.AddBranch(bytes.Bytes(`"cat"`), "@cat")
+
+		other, conflict := c.AddBranch(bytes.Bytes(`"cat"`), "@dog")
+		assert.True(t, conflict)
+		assert.Equal(t, "@cat", other)
+	})
+}
+
+func TestDiscriminator_Branch(t *testing.T) {
+	c := NewDiscriminator(bytes.Bytes(`"kind"`))
+	c.AddBranch(bytes.Bytes(`"cat"`), "@cat")
+	c.AddBranch(bytes.Bytes(`1`), "@dog")
+
+	cc := map[string]struct {
+		name string
+		ok   bool
+	}{
+		`"cat"`:       {"@cat", true},
+		`"\u0063at"`:  {"@cat", true},
+		`1.0`:         {"@dog", true},
+		`"1"`:         {"", false},
+		`"cow"`:       {"", false},
+		`{"foo": 42}`: {"", false},
+	}
+
+	for given, expected := range cc {
+		t.Run(given, func(t *testing.T) {
+			name, ok := c.Branch(bytes.Bytes(given))
+			assert.Equal(t, expected.ok, ok)
+			assert.Equal(t, expected.name, name)
+		})
+	}
+}
+
+func TestDiscriminator_ASTNode(t *testing.T) {
+	assert.Equal(t, jschema.RuleASTNode{
+		TokenType:  jschema.TokenTypeString,
+		Value:      "kind",
+		Properties: &jschema.RuleASTNodes{},
+		Source:     jschema.RuleASTNodeSourceManual,
+	}, NewDiscriminator(bytes.Bytes(`"kind"`)).ASTNode())
+}
@@ -0,0 +1,144 @@
+package constraint
+
+import (
+	stdErrors "errors"
+	"fmt"
+	"strings"
+
+	jschema "github.com/jsightapi/jsight-schema-go-library"
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+	"github.com/jsightapi/jsight-schema-go-library/errors"
+	"github.com/jsightapi/jsight-schema-go-library/internal/json"
+)
+
+// Duration an ISO 8601 duration. Example: "P1Y2M10DT2H30M", "PT0.5S", "P3W".
+type Duration struct{}
+
+var (
+	_ Constraint       = Duration{}
+	_ Constraint       = (*Duration)(nil)
+	_ LiteralValidator = Duration{}
+	_ LiteralValidator = (*Duration)(nil)
+)
+
+func NewDuration() *Duration {
+	return &Duration{}
+}
+
+func (Duration) IsJsonTypeCompatible(t json.Type) bool {
+	return t == json.TypeString
+}
+
+func (Duration) Type() Type {
+	return DurationConstraintType
+}
+
+func (Duration) String() string {
+	return DurationConstraintType.String()
+}
+
+func (Duration) Validate(value bytes.Bytes) {
+	if err := parseDuration(value.Unquote()); err != nil {
+		panic(errors.Format(errors.ErrInvalidDuration, err))
+	}
+}
+
+func (Duration) ASTNode() jschema.RuleASTNode {
+	return newEmptyRuleASTNode()
+}
+
+// parseDuration checks the "PnYnMnDTnHnMnS" or "PnW" string. Components should
+// follow in the order of decreasing. Only the last component might have a
+// fraction.
+func parseDuration(b bytes.Bytes) error {
+	if len(b) == 0 || b[0] != 'P' {
+		return stdErrors.New(`should start with "P"`)
+	}
+	b = b[1:]
+
+	var (
+		designators = "YMWD"
+		timePart    bool
+		components  int
+		weeks       bool
+		fraction    bool
+	)
+
+	for len(b) != 0 {
+		if b[0] == 'T' {
+			if timePart {
+				return stdErrors.New(`duplicate "T"`)
+			}
+			timePart = true
+			designators = "HMS"
+			b = b[1:]
+			if len(b) == 0 {
+				return stdErrors.New(`at least one time component expected after "T"`)
+			}
+			continue
+		}
+
+		if fraction {
+			return stdErrors.New("only the last component might have a fraction")
+		}
+
+		n, hasFraction, err := durationNumber(b)
+		if err != nil {
+			return err
+		}
+		b = b[n:]
+		fraction = hasFraction
+
+		if len(b) == 0 {
+			return stdErrors.New("designator expected after the number")
+		}
+
+		i := strings.IndexByte(designators, b[0])
+		if i == -1 {
+			return fmt.Errorf("unexpected designator %q", b[0])
+		}
+
+		if b[0] == 'W' && !timePart {
+			weeks = true
+		}
+		designators = designators[i+1:]
+		components++
+		b = b[1:]
+	}
+
+	if components == 0 {
+		return stdErrors.New("at least one component expected")
+	}
+
+	if weeks && components != 1 {
+		return stdErrors.New("weeks can't be combined with other components")
+	}
+	return nil
+}
+
+// durationNumber returns the length of the number at the beginning of the
+// bytes, and whether the number has a fraction. Both "." and "," can be used
+// as a decimal sign.
+func durationNumber(b bytes.Bytes) (int, bool, error) {
+	i := 0
+	for i < len(b) && isDigit(b[i]) {
+		i++
+	}
+	if i == 0 {
+		return 0, false, stdErrors.New("number expected")
+	}
+
+	if i == len(b) || (b[i] != '.' && b[i] != ',') {
+		return i, false, nil
+	}
+
+	i++
+	start := i
+	for i < len(b) && isDigit(b[i]) {
+		i++
+	}
+	if i == start {
+		return 0, false, stdErrors.New("invalid fraction")
+	}
+	return i, true, nil
+}
@@ -0,0 +1,76 @@
+package constraint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+	"github.com/jsightapi/jsight-schema-go-library/internal/json"
+)
+
+func TestDuration_IsJsonTypeCompatible(t *testing.T) {
+	testIsJsonTypeCompatible(t, Duration{}, json.TypeString)
+}
+
+func TestDuration_Type(t *testing.T) {
+	assert.Equal(t, DurationConstraintType, NewDuration().Type())
+}
+
+func TestDuration_String(t *testing.T) {
+	assert.Equal(t, "duration", NewDuration().String())
+}
+
+func TestDuration_Validate(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		tests := []string{
+			`P1Y`,
+			`P1Y2M10DT2H30M`,
+			`PT0.5S`,
+			`PT1,5S`,
+			`P3W`,
+			`P0D`,
+			`PT36H`,
+			`P1DT12H`,
+			`"PT1M"`,
+		}
+
+		for _, value := range tests {
+			t.Run(value, func(t *testing.T) {
+				assert.NotPanics(t, func() {
+					NewDuration().Validate(bytes.Bytes(value))
+				})
+			})
+		}
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		tests := map[string]string{
+			"":          "Invalid ISO 8601 duration (should start with \"P\")",
+			"1Y":        "Invalid ISO 8601 duration (should start with \"P\")",
+			"P":         "Invalid ISO 8601 duration (at least one component expected)",
+			"PT":        "Invalid ISO 8601 duration (at least one time component expected after \"T\")",
+			"P1D2Y":     "Invalid ISO 8601 duration (unexpected designator 'Y')",
+			"P1W2D":     "Invalid ISO 8601 duration (weeks can't be combined with other components)",
+			"PT1.5H30M": "Invalid ISO 8601 duration (only the last component might have a fraction)",
+			"P1YT":      "Invalid ISO 8601 duration (at least one time component expected after \"T\")",
+			"P1H":       "Invalid ISO 8601 duration (unexpected designator 'H')",
+			"P-1D":      "Invalid ISO 8601 duration (number expected)",
+			"P1.Y":      "Invalid ISO 8601 duration (invalid fraction)",
+			"PT1M1M":    "Invalid ISO 8601 duration (unexpected designator 'M')",
+			"P1DTT1H":   "Invalid ISO 8601 duration (duplicate \"T\")",
+		}
+
+		for given, expected := range tests {
+			t.Run(given, func(t *testing.T) {
+				assert.PanicsWithError(t, expected, func() {
+					NewDuration().Validate(bytes.Bytes(given))
+				})
+			})
+		}
+	})
+}
+
+func TestDuration_ASTNode(t *testing.T) {
+	assert.Equal(t, newEmptyRuleASTNode(), Duration{}.ASTNode())
+}
@@ -11,9 +11,11 @@ import (
 )
 
 type Enum struct {
-	uniqueIdx map[enumItemValue]struct{}
-	ruleName  string
-	items     []EnumItem
+	// uniqueIdx a set of comparison keys of the items.
+	uniqueIdx  map[string]struct{}
+	ruleName   string
+	items      []EnumItem
+	comparison Comparison
 }
 
 type EnumItem struct {
@@ -59,7 +61,7 @@ var (
 
 func NewEnum() *Enum {
 	return &Enum{
-		uniqueIdx: make(map[enumItemValue]struct{}),
+		uniqueIdx: make(map[string]struct{}),
 		items:     make([]EnumItem, 0, 5),
 	}
 }
@@ -87,15 +89,30 @@ func (c Enum) String() string {
 }
 
 func (c *Enum) Append(i EnumItem) int {
-	if _, ok := c.uniqueIdx[i.enumItemValue]; ok {
-		panic(errors.Format(errors.ErrDuplicationInEnumRule, i.src.String()))
-	}
+	c.addToUniqueIdx(i)
 	idx := len(c.items)
 	c.items = append(c.items, i)
-	c.uniqueIdx[i.enumItemValue] = struct{}{}
 	return idx
 }
 
+func (c *Enum) addToUniqueIdx(i EnumItem) {
+	k := c.comparison.Key(i.src)
+	if _, ok := c.uniqueIdx[k]; ok {
+		panic(errors.Format(errors.ErrDuplicationInEnumRule, i.src.String()))
+	}
+	c.uniqueIdx[k] = struct{}{}
+}
+
+// SetComparison sets the way in which values are compared. Panics if some
+// items become equal.
+func (c *Enum) SetComparison(cmp Comparison) {
+	c.comparison = cmp
+	c.uniqueIdx = make(map[string]struct{}, len(c.items))
+	for _, i := range c.items {
+		c.addToUniqueIdx(i)
+	}
+}
+
 func (c *Enum) SetComment(idx int, comment string) {
 	c.items[idx].comment = comment
 }
@@ -108,14 +125,19 @@ func (c *Enum) RuleName() string {
 	return c.ruleName
 }
 
+// Values returns JSON values of all items.
+func (c Enum) Values() []jbytes.Bytes {
+	vv := make([]jbytes.Bytes, 0, len(c.items))
+	for _, i := range c.items {
+		vv = append(vv, jbytes.Bytes(i.enumItemValue.String()))
+	}
+	return vv
+}
+
 func (c Enum) Validate(a jbytes.Bytes) {
-	aa := NewEnumItem(a, "")
-	for _, b := range c.items {
-		if aa.enumItemValue == b.enumItemValue {
-			return
-		}
+	if _, ok := c.uniqueIdx[c.comparison.Key(a)]; !ok {
+		panic(errors.ErrDoesNotMatchAnyOfTheEnumValues)
 	}
-	panic(errors.ErrDoesNotMatchAnyOfTheEnumValues)
 }
 
 func (c Enum) ASTNode() jschema.RuleASTNode {
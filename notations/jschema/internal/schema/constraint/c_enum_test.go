@@ -149,25 +149,20 @@ func TestEnum_SetRuleName(t *testing.T) {
 }
 
 func TestEnum_Validate(t *testing.T) {
+	newEnum := func() *Enum {
+		e := NewEnum()
+		e.Append(NewEnumItem(bytes.Bytes(`"foo"`), ""))
+		e.Append(NewEnumItem(bytes.Bytes(`"bar"`), ""))
+		return e
+	}
+
 	t.Run("positive", func(t *testing.T) {
-		Enum{
-			items: []EnumItem{
-				NewEnumItem(bytes.Bytes(`"foo"`), ""),
-				NewEnumItem(bytes.Bytes(`"bar"`), ""),
-			},
-		}.
-			Validate(bytes.Bytes(`"bar"`))
+		newEnum().Validate(bytes.Bytes(`"bar"`))
 	})
 
 	t.Run("negative", func(t *testing.T) {
 		assert.PanicsWithValue(t, errors.ErrDoesNotMatchAnyOfTheEnumValues, func() {
-			Enum{
-				items: []EnumItem{
-					NewEnumItem(bytes.Bytes(`"foo"`), ""),
-					NewEnumItem(bytes.Bytes(`"bar"`), ""),
-				},
-			}.
-				Validate(bytes.Bytes(`"fizz"`))
+			newEnum().Validate(bytes.Bytes(`"fizz"`))
 		})
 	})
 }
@@ -244,3 +239,30 @@ func TestEnum_ASTNode(t *testing.T) {
 		}, e.ASTNode())
 	})
 }
+
+func TestEnum_SetComparison(t *testing.T) {
+	var cmp Comparison
+	cmp.SetCaseInsensitive(true)
+
+	t.Run("positive", func(t *testing.T) {
+		e := NewEnum()
+		e.Append(NewEnumItem(bytes.Bytes(`"foo"`), ""))
+		e.Append(NewEnumItem(bytes.Bytes(`1`), ""))
+		e.SetComparison(cmp)
+
+		assert.NotPanics(t, func() {
+			e.Validate(bytes.Bytes(`"FOO"`))
+			e.Validate(bytes.Bytes(`1.0`))
+		})
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		e := NewEnum()
+		e.Append(NewEnumItem(bytes.Bytes(`"foo"`), ""))
+		e.Append(NewEnumItem(bytes.Bytes(`"Foo"`), ""))
+
+		assert.PanicsWithError(t, `"Foo" value duplicates in "enum"`, func() {
+			e.SetComparison(cmp)
+		})
+	})
+}
@@ -0,0 +1,79 @@
+package constraint
+
+import (
+	"strings"
+
+	jschema "github.com/jsightapi/jsight-schema-go-library"
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+	"github.com/jsightapi/jsight-schema-go-library/internal/json"
+)
+
+// Examples a list of additional example values. Doesn't affect validation.
+//
+// Example:
+//
+//	"status": "active" // {examples: ["blocked", "deleted"]}
+type Examples struct {
+	items []bytes.Bytes
+}
+
+var (
+	_ Constraint = Examples{}
+	_ Constraint = (*Examples)(nil)
+)
+
+func NewExamples() *Examples {
+	return &Examples{
+		items: make([]bytes.Bytes, 0, 5),
+	}
+}
+
+func (Examples) IsJsonTypeCompatible(json.Type) bool {
+	return true
+}
+
+func (Examples) Type() Type {
+	return ExamplesConstraintType
+}
+
+func (c Examples) String() string {
+	var str strings.Builder
+	str.WriteString(ExamplesConstraintType.String())
+	str.WriteString(": [")
+	for i, b := range c.items {
+		if i > 0 {
+			str.WriteString(", ")
+		}
+		str.WriteString(b.String())
+	}
+	str.WriteString("]")
+	return str.String()
+}
+
+// Append adds the literal value to the list of examples.
+func (c *Examples) Append(b bytes.Bytes) {
+	c.items = append(c.items, b.TrimSpaces())
+}
+
+// Items returns the list of examples.
+func (c Examples) Items() []bytes.Bytes {
+	return c.items
+}
+
+func (c Examples) ASTNode() jschema.RuleASTNode {
+	const source = jschema.RuleASTNodeSourceManual
+
+	n := newRuleASTNode(jschema.TokenTypeArray, "", source)
+	n.Items = make([]jschema.RuleASTNode, 0, len(c.items))
+
+	for _, b := range c.items {
+		t := json.Guess(b).JsonType()
+		v := b
+		if t == json.TypeString {
+			v = v.Unquote()
+		}
+		n.Items = append(n.Items, newRuleASTNode(t.ToTokenType(), v.String(), source))
+	}
+
+	return n
+}
@@ -0,0 +1,98 @@
+package constraint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	jschema "github.com/jsightapi/jsight-schema-go-library"
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+)
+
+func TestNewExamples(t *testing.T) {
+	c := NewExamples()
+
+	assert.NotNil(t, c.items)
+	assert.Empty(t, c.items)
+}
+
+func TestExamples_IsJsonTypeCompatible(t *testing.T) {
+	testIsJsonTypeCompatible(t, Examples{}, allJSONTypes...)
+}
+
+func TestExamples_Type(t *testing.T) {
+	assert.Equal(t, ExamplesConstraintType, NewExamples().Type())
+}
+
+func TestExamples_String(t *testing.T) {
+	cc := map[string][]string{
+		"examples: []":                      {},
+		`examples: ["foo"]`:                 {`"foo"`},
+		`examples: ["foo", 42, true, null]`: {`"foo"`, "42", " true ", "null"},
+	}
+
+	for expected, given := range cc {
+		t.Run(expected, func(t *testing.T) {
+			c := NewExamples()
+			for _, b := range given {
+				c.Append(bytes.Bytes(b))
+			}
+			assert.Equal(t, expected, c.String())
+		})
+	}
+}
+
+func TestExamples_Items(t *testing.T) {
+	c := NewExamples()
+	c.Append(bytes.Bytes(`"foo"`))
+	c.Append(bytes.Bytes(" 42 "))
+
+	assert.Equal(t, []bytes.Bytes{bytes.Bytes(`"foo"`), bytes.Bytes("42")}, c.Items())
+}
+
+func TestExamples_ASTNode(t *testing.T) {
+	c := NewExamples()
+	c.Append(bytes.Bytes(`"foo"`))
+	c.Append(bytes.Bytes("42"))
+	c.Append(bytes.Bytes("3.14"))
+	c.Append(bytes.Bytes("true"))
+	c.Append(bytes.Bytes("null"))
+
+	assert.Equal(t, jschema.RuleASTNode{
+		TokenType:  jschema.TokenTypeArray,
+		Properties: &jschema.RuleASTNodes{},
+		Items: []jschema.RuleASTNode{
+			{
+				TokenType:  jschema.TokenTypeString,
+				Value:      "foo",
+				Properties: &jschema.RuleASTNodes{},
+				Source:     jschema.RuleASTNodeSourceManual,
+			},
+			{
+				TokenType:  jschema.TokenTypeNumber,
+				Value:      "42",
+				Properties: &jschema.RuleASTNodes{},
+				Source:     jschema.RuleASTNodeSourceManual,
+			},
+			{
+				TokenType:  jschema.TokenTypeNumber,
+				Value:      "3.14",
+				Properties: &jschema.RuleASTNodes{},
+				Source:     jschema.RuleASTNodeSourceManual,
+			},
+			{
+				TokenType:  jschema.TokenTypeBoolean,
+				Value:      "true",
+				Properties: &jschema.RuleASTNodes{},
+				Source:     jschema.RuleASTNodeSourceManual,
+			},
+			{
+				TokenType:  jschema.TokenTypeNull,
+				Value:      "null",
+				Properties: &jschema.RuleASTNodes{},
+				Source:     jschema.RuleASTNodeSourceManual,
+			},
+		},
+		Source: jschema.RuleASTNodeSourceManual,
+	}, c.ASTNode())
+}
@@ -0,0 +1,56 @@
+package constraint
+
+import (
+	"strconv"
+
+	jschema "github.com/jsightapi/jsight-schema-go-library"
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+	"github.com/jsightapi/jsight-schema-go-library/errors"
+	"github.com/jsightapi/jsight-schema-go-library/internal/json"
+)
+
+// Exclusive switches the "or" rule to the exclusive mode: the value should be
+// valid for exactly one type of the "or" rule.
+type Exclusive struct {
+	value bool
+}
+
+var (
+	_ Constraint = Exclusive{}
+	_ Constraint = (*Exclusive)(nil)
+	_ BoolKeeper = Exclusive{}
+	_ BoolKeeper = (*Exclusive)(nil)
+)
+
+func NewExclusive(ruleValue bytes.Bytes) *Exclusive {
+	c := Exclusive{}
+
+	var err error
+	if c.value, err = ruleValue.ParseBool(); err != nil {
+		panic(errors.Format(errors.ErrInvalidValueOfConstraint, ExclusiveConstraintType.String()))
+	}
+	return &c
+}
+
+func (Exclusive) IsJsonTypeCompatible(json.Type) bool {
+	return true
+}
+
+func (Exclusive) Type() Type {
+	return ExclusiveConstraintType
+}
+
+func (c Exclusive) String() string {
+	if c.value {
+		return ExclusiveConstraintType.String() + ": true"
+	}
+	return ExclusiveConstraintType.String() + ": false"
+}
+
+func (c Exclusive) Bool() bool {
+	return c.value
+}
+
+func (c Exclusive) ASTNode() jschema.RuleASTNode {
+	return newRuleASTNode(jschema.TokenTypeBoolean, strconv.FormatBool(c.value), jschema.RuleASTNodeSourceManual)
+}
@@ -0,0 +1,82 @@
+package constraint
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	jschema "github.com/jsightapi/jsight-schema-go-library"
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+)
+
+func TestNewExclusive(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		cc := map[string]bool{
+			"true":  true,
+			"false": false,
+		}
+
+		for given, expected := range cc {
+			t.Run(given, func(t *testing.T) {
+				c := NewExclusive([]byte(given))
+				assert.Equal(t, expected, c.value)
+			})
+		}
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		assert.PanicsWithError(t, `Invalid value of "exclusive" constraint`, func() {
+			NewExclusive([]byte("foo"))
+		})
+	})
+}
+
+func TestExclusive_IsJsonTypeCompatible(t *testing.T) {
+	testIsJsonTypeCompatible(t, Exclusive{}, allJSONTypes...)
+}
+
+func TestExclusive_Type(t *testing.T) {
+	assert.Equal(t, ExclusiveConstraintType, NewExclusive(bytes.Bytes("true")).Type())
+}
+
+func TestExclusive_String(t *testing.T) {
+	cc := map[string]string{
+		"false": "exclusive: false",
+		"true":  "exclusive: true",
+	}
+
+	for given, expected := range cc {
+		t.Run(given, func(t *testing.T) {
+			assert.Equal(t, expected, NewExclusive([]byte(given)).String())
+		})
+	}
+}
+
+func TestExclusive_Bool(t *testing.T) {
+	cc := map[string]bool{
+		"false": false,
+		"true":  true,
+	}
+
+	for given, expected := range cc {
+		t.Run(given, func(t *testing.T) {
+			assert.Equal(t, expected, NewExclusive([]byte(given)).Bool())
+		})
+	}
+}
+
+func TestExclusive_ASTNode(t *testing.T) {
+	cc := []bool{true, false}
+
+	for _, c := range cc {
+		t.Run(strconv.FormatBool(c), func(t *testing.T) {
+			assert.Equal(t, jschema.RuleASTNode{
+				TokenType:  jschema.TokenTypeBoolean,
+				Value:      strconv.FormatBool(c),
+				Properties: &jschema.RuleASTNodes{},
+				Source:     jschema.RuleASTNodeSourceManual,
+			}, Exclusive{value: c}.ASTNode())
+		})
+	}
+}
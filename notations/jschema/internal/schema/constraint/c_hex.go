@@ -0,0 +1,47 @@
+package constraint
+
+import (
+	"encoding/hex"
+
+	jschema "github.com/jsightapi/jsight-schema-go-library"
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+	"github.com/jsightapi/jsight-schema-go-library/errors"
+	"github.com/jsightapi/jsight-schema-go-library/internal/json"
+)
+
+// Hex a string of hexadecimal digits, two digits per byte. Both the lower and
+// the upper cases are allowed. Example: "48656c6c6f".
+type Hex struct{}
+
+var (
+	_ Constraint       = Hex{}
+	_ Constraint       = (*Hex)(nil)
+	_ LiteralValidator = Hex{}
+	_ LiteralValidator = (*Hex)(nil)
+)
+
+func NewHex() *Hex {
+	return &Hex{}
+}
+
+func (Hex) IsJsonTypeCompatible(t json.Type) bool {
+	return t == json.TypeString
+}
+
+func (Hex) Type() Type {
+	return HexConstraintType
+}
+
+func (Hex) String() string {
+	return HexConstraintType.String()
+}
+
+func (Hex) Validate(value bytes.Bytes) {
+	if _, err := hex.DecodeString(value.Unquote().String()); err != nil {
+		panic(errors.Format(errors.ErrInvalidHex, err))
+	}
+}
+
+func (Hex) ASTNode() jschema.RuleASTNode {
+	return newEmptyRuleASTNode()
+}
@@ -0,0 +1,63 @@
+package constraint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+	"github.com/jsightapi/jsight-schema-go-library/internal/json"
+)
+
+func TestHex_IsJsonTypeCompatible(t *testing.T) {
+	testIsJsonTypeCompatible(t, Hex{}, json.TypeString)
+}
+
+func TestHex_Type(t *testing.T) {
+	assert.Equal(t, HexConstraintType, NewHex().Type())
+}
+
+func TestHex_String(t *testing.T) {
+	assert.Equal(t, "hex", NewHex().String())
+}
+
+func TestHex_Validate(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		tests := []string{
+			``,
+			`00`,
+			`48656c6c6f`,
+			`DEADBEEF`,
+			`"cafe"`,
+		}
+
+		for _, value := range tests {
+			t.Run(value, func(t *testing.T) {
+				assert.NotPanics(t, func() {
+					NewHex().Validate(bytes.Bytes(value))
+				})
+			})
+		}
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		tests := map[string]string{
+			"0":     "Invalid hex string (encoding/hex: odd length hex string)",
+			"0x00":  "Invalid hex string (encoding/hex: invalid byte: U+0078 'x')",
+			"zz":    "Invalid hex string (encoding/hex: invalid byte: U+007A 'z')",
+			"48 65": "Invalid hex string (encoding/hex: invalid byte: U+0020 ' ')",
+		}
+
+		for given, expected := range tests {
+			t.Run(given, func(t *testing.T) {
+				assert.PanicsWithError(t, expected, func() {
+					NewHex().Validate(bytes.Bytes(given))
+				})
+			})
+		}
+	})
+}
+
+func TestHex_ASTNode(t *testing.T) {
+	assert.Equal(t, newEmptyRuleASTNode(), Hex{}.ASTNode())
+}
@@ -0,0 +1,102 @@
+package constraint
+
+import (
+	stdErrors "errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/net/idna"
+
+	jschema "github.com/jsightapi/jsight-schema-go-library"
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+	"github.com/jsightapi/jsight-schema-go-library/errors"
+	"github.com/jsightapi/jsight-schema-go-library/internal/json"
+)
+
+// Hostname a host name as described in RFC 1123. Internationalized labels are
+// allowed both in the Unicode form ("пример.рф") and in the ASCII form
+// ("xn--e1afmkfd.xn--p1ai").
+type Hostname struct{}
+
+var (
+	_ Constraint       = Hostname{}
+	_ Constraint       = (*Hostname)(nil)
+	_ LiteralValidator = Hostname{}
+	_ LiteralValidator = (*Hostname)(nil)
+)
+
+const (
+	maxHostnameLength      = 253
+	maxHostnameLabelLength = 63
+)
+
+func NewHostname() *Hostname {
+	return &Hostname{}
+}
+
+func (Hostname) IsJsonTypeCompatible(t json.Type) bool {
+	return t == json.TypeString
+}
+
+func (Hostname) Type() Type {
+	return HostnameConstraintType
+}
+
+func (Hostname) String() string {
+	return HostnameConstraintType.String()
+}
+
+func (Hostname) Validate(value bytes.Bytes) {
+	if err := validateHostname(value.Unquote().String()); err != nil {
+		panic(errors.Format(errors.ErrInvalidHostname, err))
+	}
+}
+
+func (Hostname) ASTNode() jschema.RuleASTNode {
+	return newEmptyRuleASTNode()
+}
+
+// validateHostname checks the host name. The length limits are applied to the
+// ASCII form of the host name.
+func validateHostname(s string) error {
+	if s == "" {
+		return stdErrors.New("empty hostname")
+	}
+
+	labels := strings.Split(s, ".")
+	length := len(labels) - 1 // dots
+	for _, label := range labels {
+		ascii, err := hostnameLabelToASCII(label)
+		if err != nil {
+			return err
+		}
+		length += len(ascii)
+	}
+
+	if length > maxHostnameLength {
+		return fmt.Errorf("hostname is longer than %d characters", maxHostnameLength)
+	}
+	return nil
+}
+
+// hostnameLabelToASCII checks the label and returns its ASCII form. Labels are
+// validated and converted as described in UTS #46 (IDNA).
+func hostnameLabelToASCII(label string) (string, error) {
+	if label == "" {
+		return "", stdErrors.New("empty label")
+	}
+
+	if strings.HasPrefix(label, "-") || strings.HasSuffix(label, "-") {
+		return "", fmt.Errorf("label %q starts or ends with a hyphen", label)
+	}
+
+	ascii, err := idna.Lookup.ToASCII(label)
+	if err != nil {
+		return "", fmt.Errorf("label %q: %w", label, err)
+	}
+
+	if len(ascii) > maxHostnameLabelLength {
+		return "", fmt.Errorf("label %q is longer than %d characters", ascii, maxHostnameLabelLength)
+	}
+	return ascii, nil
+}
@@ -0,0 +1,75 @@
+package constraint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+	"github.com/jsightapi/jsight-schema-go-library/internal/json"
+)
+
+func TestHostname_IsJsonTypeCompatible(t *testing.T) {
+	testIsJsonTypeCompatible(t, Hostname{}, json.TypeString)
+}
+
+func TestHostname_Type(t *testing.T) {
+	assert.Equal(t, HostnameConstraintType, NewHostname().Type())
+}
+
+func TestHostname_String(t *testing.T) {
+	assert.Equal(t, "hostname", NewHostname().String())
+}
+
+func TestHostname_Validate(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		tests := []string{
+			`localhost`,
+			`example.com`,
+			`a-b.example.com`,
+			`xn--e1afmkfd.xn--p1ai`,
+			`пример.рф`,
+			`bücher.de`,
+			`Example.COM`,
+			`1.2.3.example`,
+			`"example.com"`,
+		}
+
+		for _, value := range tests {
+			t.Run(value, func(t *testing.T) {
+				assert.NotPanics(t, func() {
+					NewHostname().Validate(bytes.Bytes(value))
+				})
+			})
+		}
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		tests := map[string]string{
+			"":                  "Invalid hostname (empty hostname)",
+			"example..com":      "Invalid hostname (empty label)",
+			"-example.com":      "Invalid hostname (label \"-example\" starts or ends with a hyphen)",
+			"example-.com":      "Invalid hostname (label \"example-\" starts or ends with a hyphen)",
+			"exa_mple.com":      "Invalid hostname (label \"exa_mple\": idna: disallowed rune U+005F)",
+			"example.com.":      "Invalid hostname (empty label)",
+			"xn--zzzzzzzz-.com": "Invalid hostname (label \"xn--zzzzzzzz-\" starts or ends with a hyphen)",
+			"xn--example.com":   "Invalid hostname (label \"xn--example\": idna: invalid label \"Ωίθηδ\")",
+			"ab--cd.com":        "Invalid hostname (label \"ab--cd\": idna: invalid label \"ab--cd\")",
+			"\u0301a.com":       "Invalid hostname (label \"\u0301a\": idna: invalid label \"\u0301a\")",
+			"aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa.com": "Invalid hostname (label \"aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa\" is longer than 63 characters)",
+			"aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa.aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa.aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa.aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa": "Invalid hostname (hostname is longer than 253 characters)",
+		}
+
+		for given, expected := range tests {
+			t.Run(given, func(t *testing.T) {
+				assert.PanicsWithError(t, expected, func() {
+					NewHostname().Validate(bytes.Bytes(given))
+				})
+			})
+		}
+	})
+}
+
+func TestHostname_ASTNode(t *testing.T) {
+	assert.Equal(t, newEmptyRuleASTNode(), Hostname{}.ASTNode())
+}
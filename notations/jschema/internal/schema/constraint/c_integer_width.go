@@ -0,0 +1,107 @@
+package constraint
+
+import (
+	"math/big"
+
+	jschema "github.com/jsightapi/jsight-schema-go-library"
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+	"github.com/jsightapi/jsight-schema-go-library/errors"
+	"github.com/jsightapi/jsight-schema-go-library/internal/json"
+)
+
+// IntegerWidth restricts the integer to the range of the fixed-size integer
+// type of the target language. The value is compared exactly, so even huge
+// numbers aren't rounded.
+//
+// Example:
+//
+//	"id": 42 // {type: "uint32"}
+type IntegerWidth struct {
+	schemaType jschema.SchemaType
+	min        *big.Int
+	max        *big.Int
+}
+
+var (
+	_ Constraint       = IntegerWidth{}
+	_ Constraint       = (*IntegerWidth)(nil)
+	_ LiteralValidator = IntegerWidth{}
+	_ LiteralValidator = (*IntegerWidth)(nil)
+)
+
+func NewInt32() *IntegerWidth {
+	return newIntegerWidth(jschema.SchemaTypeInt32, big.NewInt(-1<<31), big.NewInt(1<<31-1))
+}
+
+func NewInt64() *IntegerWidth {
+	return newIntegerWidth(jschema.SchemaTypeInt64, big.NewInt(-1<<63), big.NewInt(1<<63-1))
+}
+
+func NewUint32() *IntegerWidth {
+	return newIntegerWidth(jschema.SchemaTypeUint32, big.NewInt(0), big.NewInt(1<<32-1))
+}
+
+func NewUint64() *IntegerWidth {
+	return newIntegerWidth(jschema.SchemaTypeUint64, big.NewInt(0), new(big.Int).SetUint64(1<<64-1))
+}
+
+// NewSafeInteger creates the constraint for integers which can be exactly
+// represented by the JavaScript number, that is ±(2^53 - 1).
+func NewSafeInteger() *IntegerWidth {
+	return newIntegerWidth(jschema.SchemaTypeSafeInteger, big.NewInt(-(1<<53 - 1)), big.NewInt(1<<53-1))
+}
+
+func newIntegerWidth(t jschema.SchemaType, min, max *big.Int) *IntegerWidth {
+	return &IntegerWidth{
+		schemaType: t,
+		min:        min,
+		max:        max,
+	}
+}
+
+func (IntegerWidth) IsJsonTypeCompatible(t json.Type) bool {
+	return t == json.TypeInteger
+}
+
+func (IntegerWidth) Type() Type {
+	return IntegerWidthConstraintType
+}
+
+func (c IntegerWidth) String() string {
+	return IntegerWidthConstraintType.String() + ": " + string(c.schemaType)
+}
+
+// SchemaType returns the name of the integer type.
+func (c IntegerWidth) SchemaType() jschema.SchemaType {
+	return c.schemaType
+}
+
+// Min returns the smallest value of the integer type.
+func (c IntegerWidth) Min() *big.Int {
+	return c.min
+}
+
+// Max returns the largest value of the integer type.
+func (c IntegerWidth) Max() *big.Int {
+	return c.max
+}
+
+// InRange returns true if the number fits into the integer type.
+func (c IntegerWidth) InRange(r *big.Rat) bool {
+	return r.Cmp(new(big.Rat).SetInt(c.min)) >= 0 && r.Cmp(new(big.Rat).SetInt(c.max)) <= 0
+}
+
+func (c IntegerWidth) Validate(value bytes.Bytes) {
+	n, err := json.NewNumber(value)
+	if err != nil {
+		panic(err)
+	}
+
+	if !c.InRange(n.Rat()) {
+		panic(errors.Format(errors.ErrIntegerOutOfRange, string(c.schemaType), c.min.String(), c.max.String()))
+	}
+}
+
+func (c IntegerWidth) ASTNode() jschema.RuleASTNode {
+	return newRuleASTNode(jschema.TokenTypeString, string(c.schemaType), jschema.RuleASTNodeSourceGenerated)
+}
@@ -0,0 +1,142 @@
+package constraint
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	jschema "github.com/jsightapi/jsight-schema-go-library"
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+	"github.com/jsightapi/jsight-schema-go-library/internal/json"
+)
+
+func TestIntegerWidth_IsJsonTypeCompatible(t *testing.T) {
+	testIsJsonTypeCompatible(t, IntegerWidth{}, json.TypeInteger)
+}
+
+func TestIntegerWidth_Type(t *testing.T) {
+	assert.Equal(t, IntegerWidthConstraintType, NewInt32().Type())
+}
+
+func TestIntegerWidth_String(t *testing.T) {
+	cc := map[string]*IntegerWidth{
+		"integerWidth: int32":       NewInt32(),
+		"integerWidth: int64":       NewInt64(),
+		"integerWidth: uint32":      NewUint32(),
+		"integerWidth: uint64":      NewUint64(),
+		"integerWidth: safeInteger": NewSafeInteger(),
+	}
+
+	for expected, c := range cc {
+		t.Run(expected, func(t *testing.T) {
+			assert.Equal(t, expected, c.String())
+		})
+	}
+}
+
+func TestIntegerWidth_SchemaType(t *testing.T) {
+	cc := map[jschema.SchemaType]*IntegerWidth{
+		jschema.SchemaTypeInt32:       NewInt32(),
+		jschema.SchemaTypeInt64:       NewInt64(),
+		jschema.SchemaTypeUint32:      NewUint32(),
+		jschema.SchemaTypeUint64:      NewUint64(),
+		jschema.SchemaTypeSafeInteger: NewSafeInteger(),
+	}
+
+	for expected, c := range cc {
+		t.Run(string(expected), func(t *testing.T) {
+			assert.Equal(t, expected, c.SchemaType())
+		})
+	}
+}
+
+func TestIntegerWidth_InRange(t *testing.T) {
+	cc := map[string]bool{
+		"-2147483649":  false,
+		"-2147483648":  true,
+		"0":            true,
+		"2147483647":   true,
+		"2147483648":   false,
+		"2.5":          true,
+		"2147483647.5": false,
+	}
+
+	for given, expected := range cc {
+		t.Run(given, func(t *testing.T) {
+			r, ok := new(big.Rat).SetString(given)
+			assert.True(t, ok)
+			assert.Equal(t, expected, NewInt32().InRange(r))
+		})
+	}
+}
+
+func TestIntegerWidth_Validate(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		cc := map[string]*IntegerWidth{
+			"-2147483648":          NewInt32(),
+			"2147483647":           NewInt32(),
+			"-9223372036854775808": NewInt64(),
+			"9223372036854775807":  NewInt64(),
+			"0":                    NewUint32(),
+			"4294967295":           NewUint32(),
+			"18446744073709551615": NewUint64(),
+			"-9007199254740991":    NewSafeInteger(),
+			"9007199254740991":     NewSafeInteger(),
+			"1E3":                  NewUint32(),
+		}
+
+		for given, c := range cc {
+			t.Run(given, func(t *testing.T) {
+				assert.NotPanics(t, func() {
+					c.Validate(bytes.Bytes(given))
+				})
+			})
+		}
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		cc := map[string]struct {
+			constraint *IntegerWidth
+			expected   string
+		}{
+			"2147483648": {
+				NewInt32(),
+				`The value is out of range of the "int32" type (from -2147483648 to 2147483647)`,
+			},
+			"-9223372036854775809": {
+				NewInt64(),
+				`The value is out of range of the "int64" type (from -9223372036854775808 to 9223372036854775807)`,
+			},
+			"-1": {
+				NewUint32(),
+				`The value is out of range of the "uint32" type (from 0 to 4294967295)`,
+			},
+			"18446744073709551616": {
+				NewUint64(),
+				`The value is out of range of the "uint64" type (from 0 to 18446744073709551615)`,
+			},
+			"9007199254740992": {
+				NewSafeInteger(),
+				`The value is out of range of the "safeInteger" type (from -9007199254740991 to 9007199254740991)`,
+			},
+		}
+
+		for given, c := range cc {
+			t.Run(given, func(t *testing.T) {
+				assert.PanicsWithError(t, c.expected, func() {
+					c.constraint.Validate(bytes.Bytes(given))
+				})
+			})
+		}
+	})
+}
+
+func TestIntegerWidth_ASTNode(t *testing.T) {
+	assert.Equal(t, jschema.RuleASTNode{
+		TokenType:  jschema.TokenTypeString,
+		Value:      "uint64",
+		Properties: &jschema.RuleASTNodes{},
+		Source:     jschema.RuleASTNodeSourceGenerated,
+	}, NewUint64().ASTNode())
+}
@@ -0,0 +1,51 @@
+package constraint
+
+import (
+	stdErrors "errors"
+	"net/netip"
+
+	jschema "github.com/jsightapi/jsight-schema-go-library"
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+	"github.com/jsightapi/jsight-schema-go-library/errors"
+	"github.com/jsightapi/jsight-schema-go-library/internal/json"
+)
+
+// IPv4 an IPv4 address in the dotted decimal notation. Example: "192.168.0.1".
+type IPv4 struct{}
+
+var (
+	_ Constraint       = IPv4{}
+	_ Constraint       = (*IPv4)(nil)
+	_ LiteralValidator = IPv4{}
+	_ LiteralValidator = (*IPv4)(nil)
+)
+
+func NewIpv4() *IPv4 {
+	return &IPv4{}
+}
+
+func (IPv4) IsJsonTypeCompatible(t json.Type) bool {
+	return t == json.TypeString
+}
+
+func (IPv4) Type() Type {
+	return Ipv4ConstraintType
+}
+
+func (IPv4) String() string {
+	return Ipv4ConstraintType.String()
+}
+
+func (IPv4) Validate(value bytes.Bytes) {
+	addr, err := netip.ParseAddr(value.Unquote().String())
+	if err == nil && !addr.Is4() {
+		err = stdErrors.New("IPv4 address expected")
+	}
+	if err != nil {
+		panic(errors.Format(errors.ErrInvalidIPv4, err))
+	}
+}
+
+func (IPv4) ASTNode() jschema.RuleASTNode {
+	return newEmptyRuleASTNode()
+}
@@ -0,0 +1,64 @@
+package constraint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+	"github.com/jsightapi/jsight-schema-go-library/internal/json"
+)
+
+func TestIPv4_IsJsonTypeCompatible(t *testing.T) {
+	testIsJsonTypeCompatible(t, IPv4{}, json.TypeString)
+}
+
+func TestIPv4_Type(t *testing.T) {
+	assert.Equal(t, Ipv4ConstraintType, NewIpv4().Type())
+}
+
+func TestIPv4_String(t *testing.T) {
+	assert.Equal(t, "ipv4", NewIpv4().String())
+}
+
+func TestIPv4_Validate(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		tests := []string{
+			`192.168.0.1`,
+			`0.0.0.0`,
+			`255.255.255.255`,
+			`"10.0.0.1"`,
+		}
+
+		for _, value := range tests {
+			t.Run(value, func(t *testing.T) {
+				assert.NotPanics(t, func() {
+					NewIpv4().Validate(bytes.Bytes(value))
+				})
+			})
+		}
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		tests := map[string]string{
+			"":          "Invalid IPv4 address (ParseAddr(\"\"): unable to parse IP)",
+			"256.0.0.1": "Invalid IPv4 address (ParseAddr(\"256.0.0.1\"): IPv4 field has value >255)",
+			"1.2.3":     "Invalid IPv4 address (ParseAddr(\"1.2.3\"): IPv4 address too short)",
+			"01.2.3.4":  "Invalid IPv4 address (ParseAddr(\"01.2.3.4\"): IPv4 field has octet with leading zero)",
+			"::1":       "Invalid IPv4 address (IPv4 address expected)",
+			" 1.2.3.4":  "Invalid IPv4 address (ParseAddr(\" 1.2.3.4\"): unexpected character (at \" 1.2.3.4\"))",
+		}
+
+		for given, expected := range tests {
+			t.Run(given, func(t *testing.T) {
+				assert.PanicsWithError(t, expected, func() {
+					NewIpv4().Validate(bytes.Bytes(given))
+				})
+			})
+		}
+	})
+}
+
+func TestIPv4_ASTNode(t *testing.T) {
+	assert.Equal(t, newEmptyRuleASTNode(), IPv4{}.ASTNode())
+}
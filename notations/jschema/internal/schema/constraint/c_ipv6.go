@@ -0,0 +1,57 @@
+package constraint
+
+import (
+	stdErrors "errors"
+	"net/netip"
+
+	jschema "github.com/jsightapi/jsight-schema-go-library"
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+	"github.com/jsightapi/jsight-schema-go-library/errors"
+	"github.com/jsightapi/jsight-schema-go-library/internal/json"
+)
+
+// IPv6 an IPv6 address as described in RFC 4291. Zones aren't allowed.
+// Example: "2001:db8::1".
+type IPv6 struct{}
+
+var (
+	_ Constraint       = IPv6{}
+	_ Constraint       = (*IPv6)(nil)
+	_ LiteralValidator = IPv6{}
+	_ LiteralValidator = (*IPv6)(nil)
+)
+
+func NewIpv6() *IPv6 {
+	return &IPv6{}
+}
+
+func (IPv6) IsJsonTypeCompatible(t json.Type) bool {
+	return t == json.TypeString
+}
+
+func (IPv6) Type() Type {
+	return Ipv6ConstraintType
+}
+
+func (IPv6) String() string {
+	return Ipv6ConstraintType.String()
+}
+
+func (IPv6) Validate(value bytes.Bytes) {
+	addr, err := netip.ParseAddr(value.Unquote().String())
+	if err == nil {
+		switch {
+		case !addr.Is6():
+			err = stdErrors.New("IPv6 address expected")
+		case addr.Zone() != "":
+			err = stdErrors.New("zone isn't allowed")
+		}
+	}
+	if err != nil {
+		panic(errors.Format(errors.ErrInvalidIPv6, err))
+	}
+}
+
+func (IPv6) ASTNode() jschema.RuleASTNode {
+	return newEmptyRuleASTNode()
+}
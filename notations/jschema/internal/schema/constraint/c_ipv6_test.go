@@ -0,0 +1,64 @@
+package constraint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+	"github.com/jsightapi/jsight-schema-go-library/internal/json"
+)
+
+func TestIPv6_IsJsonTypeCompatible(t *testing.T) {
+	testIsJsonTypeCompatible(t, IPv6{}, json.TypeString)
+}
+
+func TestIPv6_Type(t *testing.T) {
+	assert.Equal(t, Ipv6ConstraintType, NewIpv6().Type())
+}
+
+func TestIPv6_String(t *testing.T) {
+	assert.Equal(t, "ipv6", NewIpv6().String())
+}
+
+func TestIPv6_Validate(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		tests := []string{
+			`::1`,
+			`2001:db8::1`,
+			`2001:0db8:0000:0000:0000:ff00:0042:8329`,
+			`::ffff:192.168.0.1`,
+			`"fe80::1"`,
+		}
+
+		for _, value := range tests {
+			t.Run(value, func(t *testing.T) {
+				assert.NotPanics(t, func() {
+					NewIpv6().Validate(bytes.Bytes(value))
+				})
+			})
+		}
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		tests := map[string]string{
+			"":             "Invalid IPv6 address (ParseAddr(\"\"): unable to parse IP)",
+			"192.168.0.1":  "Invalid IPv6 address (IPv6 address expected)",
+			"fe80::1%eth0": "Invalid IPv6 address (zone isn't allowed)",
+			"2001:db8:::1": "Invalid IPv6 address (ParseAddr(\"2001:db8:::1\"): each colon-separated field must have at least one digit (at \":1\"))",
+			"12345::":      "Invalid IPv6 address (ParseAddr(\"12345::\"): each group must have 4 or less digits (at \"12345::\"))",
+		}
+
+		for given, expected := range tests {
+			t.Run(given, func(t *testing.T) {
+				assert.PanicsWithError(t, expected, func() {
+					NewIpv6().Validate(bytes.Bytes(given))
+				})
+			})
+		}
+	})
+}
+
+func TestIPv6_ASTNode(t *testing.T) {
+	assert.Equal(t, newEmptyRuleASTNode(), IPv6{}.ASTNode())
+}
@@ -0,0 +1,60 @@
+package constraint
+
+import (
+	stdErrors "errors"
+	"strings"
+
+	"golang.org/x/text/language"
+
+	jschema "github.com/jsightapi/jsight-schema-go-library"
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+	"github.com/jsightapi/jsight-schema-go-library/errors"
+	"github.com/jsightapi/jsight-schema-go-library/internal/json"
+)
+
+// Language a language tag as described in BCP 47. Example: "en", "en-US",
+// "zh-Hant-TW".
+type Language struct{}
+
+var (
+	_ Constraint       = Language{}
+	_ Constraint       = (*Language)(nil)
+	_ LiteralValidator = Language{}
+	_ LiteralValidator = (*Language)(nil)
+)
+
+func NewLanguage() *Language {
+	return &Language{}
+}
+
+func (Language) IsJsonTypeCompatible(t json.Type) bool {
+	return t == json.TypeString
+}
+
+func (Language) Type() Type {
+	return LanguageConstraintType
+}
+
+func (Language) String() string {
+	return LanguageConstraintType.String()
+}
+
+func (Language) Validate(value bytes.Bytes) {
+	s := value.Unquote().String()
+
+	var err error
+	if strings.Contains(s, "_") {
+		// The language package accepts "_" as a separator, but BCP 47 doesn't.
+		err = stdErrors.New(`invalid separator "_"`)
+	} else {
+		_, err = language.Parse(s)
+	}
+
+	if err != nil {
+		panic(errors.Format(errors.ErrInvalidLanguageTag, err))
+	}
+}
+
+func (Language) ASTNode() jschema.RuleASTNode {
+	return newEmptyRuleASTNode()
+}
@@ -0,0 +1,66 @@
+package constraint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+	"github.com/jsightapi/jsight-schema-go-library/internal/json"
+)
+
+func TestLanguage_IsJsonTypeCompatible(t *testing.T) {
+	testIsJsonTypeCompatible(t, Language{}, json.TypeString)
+}
+
+func TestLanguage_Type(t *testing.T) {
+	assert.Equal(t, LanguageConstraintType, NewLanguage().Type())
+}
+
+func TestLanguage_String(t *testing.T) {
+	assert.Equal(t, "language", NewLanguage().String())
+}
+
+func TestLanguage_Validate(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		tests := []string{
+			`en`,
+			`en-US`,
+			`zh-Hant-TW`,
+			`sr-Latn-RS`,
+			`de-CH-1996`,
+			`x-private`,
+			`"fr"`,
+		}
+
+		for _, value := range tests {
+			t.Run(value, func(t *testing.T) {
+				assert.NotPanics(t, func() {
+					NewLanguage().Validate(bytes.Bytes(value))
+				})
+			})
+		}
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		tests := map[string]string{
+			"":        "Invalid BCP 47 language tag (language: tag is not well-formed)",
+			"en_US":   "Invalid BCP 47 language tag (invalid separator \"_\")",
+			"english": "Invalid BCP 47 language tag (language: tag is not well-formed)",
+			"en-":     "Invalid BCP 47 language tag (language: tag is not well-formed)",
+			"12":      "Invalid BCP 47 language tag (language: tag is not well-formed)",
+		}
+
+		for given, expected := range tests {
+			t.Run(given, func(t *testing.T) {
+				assert.PanicsWithError(t, expected, func() {
+					NewLanguage().Validate(bytes.Bytes(given))
+				})
+			})
+		}
+	})
+}
+
+func TestLanguage_ASTNode(t *testing.T) {
+	assert.Equal(t, newEmptyRuleASTNode(), Language{}.ASTNode())
+}
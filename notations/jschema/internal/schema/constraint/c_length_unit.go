@@ -0,0 +1,63 @@
+package constraint
+
+import (
+	"encoding/json"
+
+	jschema "github.com/jsightapi/jsight-schema-go-library"
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+	"github.com/jsightapi/jsight-schema-go-library/errors"
+	internalJSON "github.com/jsightapi/jsight-schema-go-library/internal/json"
+)
+
+// LengthUnit sets the unit in which the "minLength" and "maxLength" rules
+// measure the length of the string.
+//
+// Example:
+//
+//	"Hello" // {maxLength: 5, lengthUnit: "utf16"}
+type LengthUnit struct {
+	unit StringUnit
+}
+
+var (
+	_ Constraint = LengthUnit{}
+	_ Constraint = (*LengthUnit)(nil)
+)
+
+func NewLengthUnit(ruleValue bytes.Bytes) *LengthUnit {
+	if !ruleValue.InQuotes() {
+		panic(errors.Format(errors.ErrInvalidValueOfConstraint, LengthUnitConstraintType.String()))
+	}
+
+	var s string
+	if err := json.Unmarshal(ruleValue, &s); err != nil {
+		panic(err)
+	}
+
+	u, ok := ParseStringUnit(s)
+	if !ok {
+		panic(errors.Format(errors.ErrInvalidValueOfConstraint, LengthUnitConstraintType.String()).
+			WithSuggestion(errors.Suggest(s, stringUnitNames)))
+	}
+	return &LengthUnit{unit: u}
+}
+
+func (LengthUnit) IsJsonTypeCompatible(t internalJSON.Type) bool {
+	return t == internalJSON.TypeString
+}
+
+func (LengthUnit) Type() Type {
+	return LengthUnitConstraintType
+}
+
+func (c LengthUnit) String() string {
+	return LengthUnitConstraintType.String() + ": " + c.unit.String()
+}
+
+func (c LengthUnit) Unit() StringUnit {
+	return c.unit
+}
+
+func (c LengthUnit) ASTNode() jschema.RuleASTNode {
+	return newRuleASTNode(jschema.TokenTypeString, c.unit.String(), jschema.RuleASTNodeSourceManual)
+}
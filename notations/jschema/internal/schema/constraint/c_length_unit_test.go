@@ -0,0 +1,66 @@
+package constraint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	jschema "github.com/jsightapi/jsight-schema-go-library"
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+	"github.com/jsightapi/jsight-schema-go-library/internal/json"
+)
+
+func TestNewLengthUnit(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		cc := map[string]StringUnit{
+			`"codePoints"`: StringUnitCodePoints,
+			`"bytes"`:      StringUnitBytes,
+			`"utf16"`:      StringUnitUTF16,
+			`"graphemes"`:  StringUnitGraphemes,
+		}
+
+		for given, expected := range cc {
+			t.Run(given, func(t *testing.T) {
+				assert.Equal(t, expected, NewLengthUnit(bytes.Bytes(given)).Unit())
+			})
+		}
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		ss := []string{
+			`bytes`,
+			`""`,
+			`"utf8"`,
+			`42`,
+		}
+
+		for _, s := range ss {
+			t.Run(s, func(t *testing.T) {
+				assert.PanicsWithError(t, `Invalid value of "lengthUnit" constraint`, func() {
+					NewLengthUnit(bytes.Bytes(s))
+				})
+			})
+		}
+	})
+}
+
+func TestLengthUnit_IsJsonTypeCompatible(t *testing.T) {
+	testIsJsonTypeCompatible(t, LengthUnit{}, json.TypeString)
+}
+
+func TestLengthUnit_Type(t *testing.T) {
+	assert.Equal(t, LengthUnitConstraintType, NewLengthUnit(bytes.Bytes(`"bytes"`)).Type())
+}
+
+func TestLengthUnit_String(t *testing.T) {
+	assert.Equal(t, "lengthUnit: utf16", NewLengthUnit(bytes.Bytes(`"utf16"`)).String())
+}
+
+func TestLengthUnit_ASTNode(t *testing.T) {
+	assert.Equal(t, jschema.RuleASTNode{
+		TokenType:  jschema.TokenTypeString,
+		Value:      "graphemes",
+		Properties: &jschema.RuleASTNodes{},
+		Source:     jschema.RuleASTNodeSourceManual,
+	}, NewLengthUnit(bytes.Bytes(`"graphemes"`)).ASTNode())
+}
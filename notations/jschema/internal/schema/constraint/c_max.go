@@ -8,7 +8,11 @@ import (
 )
 
 type Max struct {
-	max       *json.Number
+	max *json.Number
+
+	// date the bound for the "date" and "datetime" types, nil for numbers.
+	date *DateBound
+
 	rawValue  bytes.Bytes
 	exclusive bool
 }
@@ -21,6 +25,13 @@ var (
 )
 
 func NewMax(ruleValue bytes.Bytes) *Max {
+	if isDateBoundValue(ruleValue) {
+		return &Max{
+			rawValue: ruleValue,
+			date:     newDateBound(MaxConstraintType, ruleValue),
+		}
+	}
+
 	number, err := json.NewNumber(ruleValue)
 	if err != nil {
 		panic(err)
@@ -32,7 +43,10 @@ func NewMax(ruleValue bytes.Bytes) *Max {
 	}
 }
 
-func (Max) IsJsonTypeCompatible(t json.Type) bool {
+func (c Max) IsJsonTypeCompatible(t json.Type) bool {
+	if c.date != nil {
+		return t == json.TypeString
+	}
 	return t == json.TypeInteger || t == json.TypeFloat
 }
 
@@ -41,7 +55,7 @@ func (Max) Type() Type {
 }
 
 func (c Max) String() string {
-	str := MaxConstraintType.String() + ": " + c.max.String()
+	str := MaxConstraintType.String() + ": " + c.valueString()
 	if c.exclusive {
 		return str + " (exclusive: true)"
 	}
@@ -57,6 +71,11 @@ func (c *Max) Exclusive() bool {
 }
 
 func (c Max) Validate(value bytes.Bytes) {
+	if c.date != nil {
+		c.validateDate(value)
+		return
+	}
+
 	jsonNumber, err := json.NewNumber(value)
 	if err != nil {
 		panic(err)
@@ -72,10 +91,45 @@ func (c Max) Validate(value bytes.Bytes) {
 	}
 }
 
+// validateDate compares the date or the datetime. Datetime values are compared
+// as moments in time, so "2022-01-01T03:00:00+03:00" is equal to
+// "2022-01-01T00:00:00Z".
+func (c Max) validateDate(value bytes.Bytes) {
+	v, ok := c.date.parse(value)
+	if !ok {
+		return
+	}
+
+	if c.exclusive {
+		if !v.Before(c.date.Time()) {
+			panic(errors.Format(errors.ErrConstraintValidation, MaxConstraintType.String(), c.valueString(), "(exclusive)"))
+		}
+	} else if v.After(c.date.Time()) {
+		panic(errors.Format(errors.ErrConstraintValidation, MaxConstraintType.String(), c.valueString(), ""))
+	}
+}
+
+func (c Max) valueString() string {
+	if c.date != nil {
+		return c.rawValue.String()
+	}
+	return c.max.String()
+}
+
 func (c Max) ASTNode() jschema.RuleASTNode {
+	if c.date != nil {
+		return newRuleASTNode(jschema.TokenTypeString, c.rawValue.Unquote().String(), jschema.RuleASTNodeSourceManual)
+	}
 	return newRuleASTNode(jschema.TokenTypeNumber, c.rawValue.String(), jschema.RuleASTNodeSourceManual)
 }
 
+// Value returns the numeric bound, or nil for the date bound.
 func (c *Max) Value() *json.Number {
 	return c.max
 }
+
+// DateBound returns the bound for the "date" and "datetime" types, or nil for
+// the numeric bound.
+func (c *Max) DateBound() *DateBound {
+	return c.date
+}
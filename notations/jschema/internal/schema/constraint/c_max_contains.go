@@ -0,0 +1,58 @@
+package constraint
+
+import (
+	"strconv"
+
+	jschema "github.com/jsightapi/jsight-schema-go-library"
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+	"github.com/jsightapi/jsight-schema-go-library/errors"
+	"github.com/jsightapi/jsight-schema-go-library/internal/json"
+)
+
+// MaxContains the maximum number of array items matching the "contains" rule.
+type MaxContains struct {
+	value uint
+}
+
+var (
+	_ Constraint = MaxContains{}
+	_ Constraint = (*MaxContains)(nil)
+)
+
+func NewMaxContains(ruleValue bytes.Bytes) *MaxContains {
+	return &MaxContains{
+		value: parseUint(ruleValue, MaxContainsConstraintType),
+	}
+}
+
+func (MaxContains) IsJsonTypeCompatible(t json.Type) bool {
+	return t == json.TypeArray
+}
+
+func (MaxContains) Type() Type {
+	return MaxContainsConstraintType
+}
+
+func (c MaxContains) String() string {
+	return MaxContainsConstraintType.String() + ": " + strconv.FormatUint(uint64(c.value), 10)
+}
+
+// ValidateTheNumber checks the number of array items matching the "contains"
+// rule.
+func (c MaxContains) ValidateTheNumber(numberOfMatches uint) {
+	if numberOfMatches > c.value {
+		panic(errors.Format(errors.ErrTooManyContainedItems, strconv.FormatUint(uint64(c.value), 10)))
+	}
+}
+
+func (c MaxContains) Value() uint {
+	return c.value
+}
+
+func (c MaxContains) ASTNode() jschema.RuleASTNode {
+	return newRuleASTNode(
+		jschema.TokenTypeNumber,
+		strconv.FormatUint(uint64(c.value), 10),
+		jschema.RuleASTNodeSourceManual,
+	)
+}
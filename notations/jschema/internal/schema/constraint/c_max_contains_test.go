@@ -0,0 +1,83 @@
+package constraint
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	jschema "github.com/jsightapi/jsight-schema-go-library"
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+	"github.com/jsightapi/jsight-schema-go-library/internal/json"
+)
+
+func TestNewMaxContains(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		cnstr := NewMaxContains([]byte("10"))
+
+		assert.EqualValues(t, 10, cnstr.value)
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		ss := []string{
+			"not a number",
+			"3.14",
+			"-12",
+		}
+
+		for _, s := range ss {
+			t.Run(s, func(t *testing.T) {
+				assert.PanicsWithError(t, `Invalid value of "maxContains" constraint`, func() {
+					NewMaxContains([]byte(s))
+				})
+			})
+		}
+	})
+}
+
+func TestMaxContains_IsJsonTypeCompatible(t *testing.T) {
+	testIsJsonTypeCompatible(t, MaxContains{}, json.TypeArray)
+}
+
+func TestMaxContains_Type(t *testing.T) {
+	assert.Equal(t, MaxContainsConstraintType, NewMaxContains(bytes.Bytes("1")).Type())
+}
+
+func TestMaxContains_String(t *testing.T) {
+	assert.Equal(t, "maxContains: 1", NewMaxContains([]byte("1")).String())
+}
+
+func TestMaxContains_ValidateTheNumber(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		cc := []uint{
+			1, 2,
+		}
+
+		for _, numberOfMatches := range cc {
+			t.Run(fmt.Sprintf("%d", numberOfMatches), func(t *testing.T) {
+				assert.NotPanics(t, func() {
+					NewMaxContains([]byte("2")).ValidateTheNumber(numberOfMatches)
+				})
+			})
+		}
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		assert.PanicsWithError(t, `The array allows at most 2 items matching the "contains" rule`, func() {
+			NewMaxContains([]byte("2")).ValidateTheNumber(3)
+		})
+	})
+}
+
+func TestMaxContains_Value(t *testing.T) {
+	assert.EqualValues(t, 2, NewMaxContains([]byte("2")).Value())
+}
+
+func TestMaxContains_ASTNode(t *testing.T) {
+	assert.Equal(t, jschema.RuleASTNode{
+		TokenType:  jschema.TokenTypeNumber,
+		Value:      "1",
+		Properties: &jschema.RuleASTNodes{},
+		Source:     jschema.RuleASTNodeSourceManual,
+	}, NewMaxContains(bytes.Bytes("1")).ASTNode())
+}
@@ -11,6 +11,7 @@ import (
 
 type MaxLength struct {
 	value uint
+	unit  StringUnit
 }
 
 var (
@@ -39,7 +40,7 @@ func (c MaxLength) String() string {
 }
 
 func (c MaxLength) Validate(value bytes.Bytes) {
-	length := uint(len(value.Unquote()))
+	length := c.unit.Len(value)
 	if length > c.value {
 		panic(errors.Format(
 			errors.ErrConstraintStringLengthValidation,
@@ -60,3 +61,8 @@ func (c MaxLength) ASTNode() jschema.RuleASTNode {
 func (c MaxLength) Value() uint {
 	return c.value
 }
+
+// SetUnit sets the unit in which the length of the string is measured.
+func (c *MaxLength) SetUnit(u StringUnit) {
+	c.unit = u
+}
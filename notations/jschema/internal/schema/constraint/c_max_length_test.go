@@ -82,3 +82,13 @@ func TestMaxLength_ASTNode(t *testing.T) {
 func TestMaxLength_Value(t *testing.T) {
 	assert.Equal(t, uint(1), NewMaxLength([]byte("1")).Value())
 }
+
+func TestMaxLength_SetUnit(t *testing.T) {
+	c := NewMaxLength([]byte("1"))
+	c.SetUnit(StringUnitBytes)
+
+	assert.Equal(t, StringUnitBytes, c.unit)
+	assert.PanicsWithError(t, `Invalid string length for "maxLength" = "1" constraint`, func() {
+		c.Validate([]byte(`"\u00E9"`))
+	})
+}
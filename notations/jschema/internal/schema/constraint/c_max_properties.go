@@ -0,0 +1,57 @@
+package constraint
+
+import (
+	"strconv"
+
+	jschema "github.com/jsightapi/jsight-schema-go-library"
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+	"github.com/jsightapi/jsight-schema-go-library/errors"
+	"github.com/jsightapi/jsight-schema-go-library/internal/json"
+)
+
+type MaxProperties struct {
+	value uint
+}
+
+var (
+	_ Constraint      = MaxProperties{}
+	_ Constraint      = (*MaxProperties)(nil)
+	_ ObjectValidator = MaxProperties{}
+	_ ObjectValidator = (*MaxProperties)(nil)
+)
+
+func NewMaxProperties(ruleValue bytes.Bytes) *MaxProperties {
+	return &MaxProperties{
+		value: parseUint(ruleValue, MaxPropertiesConstraintType),
+	}
+}
+
+func (MaxProperties) IsJsonTypeCompatible(t json.Type) bool {
+	return t == json.TypeObject
+}
+
+func (MaxProperties) Type() Type {
+	return MaxPropertiesConstraintType
+}
+
+func (c MaxProperties) String() string {
+	return MaxPropertiesConstraintType.String() + ": " + strconv.FormatUint(uint64(c.value), 10)
+}
+
+func (c MaxProperties) ValidateTheObject(numberOfProperties uint) {
+	if numberOfProperties > c.value {
+		panic(errors.ErrConstraintMaxPropertiesValidation)
+	}
+}
+
+func (c MaxProperties) Value() uint {
+	return c.value
+}
+
+func (c MaxProperties) ASTNode() jschema.RuleASTNode {
+	return newRuleASTNode(
+		jschema.TokenTypeNumber,
+		strconv.FormatUint(uint64(c.value), 10),
+		jschema.RuleASTNodeSourceManual,
+	)
+}
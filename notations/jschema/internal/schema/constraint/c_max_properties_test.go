@@ -0,0 +1,84 @@
+package constraint
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	jschema "github.com/jsightapi/jsight-schema-go-library"
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+	"github.com/jsightapi/jsight-schema-go-library/internal/json"
+)
+
+func TestNewMaxProperties(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		cnstr := NewMaxProperties([]byte("10"))
+
+		assert.EqualValues(t, 10, cnstr.value)
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		ss := []string{
+			"not a number",
+			"3.14",
+			"-12",
+		}
+
+		for _, s := range ss {
+			t.Run(s, func(t *testing.T) {
+				assert.PanicsWithError(t, `Invalid value of "maxProperties" constraint`, func() {
+					NewMaxProperties([]byte(s))
+				})
+			})
+		}
+	})
+}
+
+func TestMaxProperties_IsJsonTypeCompatible(t *testing.T) {
+	testIsJsonTypeCompatible(t, MaxProperties{}, json.TypeObject)
+}
+
+func TestMaxProperties_Type(t *testing.T) {
+	assert.Equal(t, MaxPropertiesConstraintType, NewMaxProperties(bytes.Bytes("1")).Type())
+}
+
+func TestMaxProperties_String(t *testing.T) {
+	assert.Equal(t, "maxProperties: 1", NewMaxProperties([]byte("1")).String())
+}
+
+func TestMaxProperties_ValidateTheObject(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		cc := []uint{
+			1,
+			2,
+		}
+
+		for _, numberOfChildren := range cc {
+			t.Run(fmt.Sprintf("%d", numberOfChildren), func(t *testing.T) {
+				assert.NotPanics(t, func() {
+					NewMaxProperties([]byte("2")).ValidateTheObject(numberOfChildren)
+				})
+			})
+		}
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		assert.PanicsWithError(t, `The number of object properties does not match the "maxProperties" rule`, func() {
+			NewMaxProperties([]byte("2")).ValidateTheObject(3)
+		})
+	})
+}
+
+func TestMaxProperties_Value(t *testing.T) {
+	assert.EqualValues(t, 2, NewMaxProperties([]byte("2")).Value())
+}
+
+func TestMaxProperties_ASTNode(t *testing.T) {
+	assert.Equal(t, jschema.RuleASTNode{
+		TokenType:  jschema.TokenTypeNumber,
+		Value:      "1",
+		Properties: &jschema.RuleASTNodes{},
+		Source:     jschema.RuleASTNodeSourceManual,
+	}, NewMaxProperties(bytes.Bytes("1")).ASTNode())
+}
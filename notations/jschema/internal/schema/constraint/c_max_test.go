@@ -24,15 +24,44 @@ func TestNewMax(t *testing.T) {
 		assert.False(t, cnstr.exclusive)
 	})
 
+	t.Run("positive date", func(t *testing.T) {
+		cc := map[string]bool{
+			`"1900-01-01"`:                false,
+			`"2022-01-01T03:00:00+03:00"`: true,
+		}
+
+		for given, isDateTime := range cc {
+			t.Run(given, func(t *testing.T) {
+				cnstr := NewMax(bytes.Bytes(given))
+
+				assert.Nil(t, cnstr.max)
+				require.NotNil(t, cnstr.DateBound())
+				assert.Equal(t, isDateTime, cnstr.DateBound().IsDateTime())
+			})
+		}
+	})
+
 	t.Run("negative", func(t *testing.T) {
 		assert.PanicsWithError(t, `Incorrect number value "not a number"`, func() {
 			NewMax([]byte("not a number"))
 		})
 	})
+
+	t.Run("negative date", func(t *testing.T) {
+		assert.PanicsWithError(t, `Invalid value of "max" constraint`, func() {
+			NewMax([]byte(`"2000-13-01"`))
+		})
+	})
 }
 
 func TestMax_IsJsonTypeCompatible(t *testing.T) {
-	testIsJsonTypeCompatible(t, Max{}, json.TypeInteger, json.TypeFloat)
+	t.Run("number", func(t *testing.T) {
+		testIsJsonTypeCompatible(t, Max{}, json.TypeInteger, json.TypeFloat)
+	})
+
+	t.Run("date", func(t *testing.T) {
+		testIsJsonTypeCompatible(t, NewMax(bytes.Bytes(`"2000-01-01"`)), json.TypeString)
+	})
 }
 
 func TestMax_Type(t *testing.T) {
@@ -89,6 +118,33 @@ func TestMax_Validate(t *testing.T) {
 			value string
 			error string
 		}{
+			`"2100-01-01" < "2100-01-02"`: {
+				cnstr: newMax(`"2100-01-01"`, false),
+				value: `"2100-01-02"`,
+				error: `Invalid value for "max" = "2100-01-01" constraint `,
+			},
+			`"2100-01-01" < "2100-01-01"`: {
+				cnstr: newMax(`"2100-01-01"`, false),
+				value: `"2100-01-01"`,
+			},
+			`"2100-01-01" <= "2100-01-01"`: {
+				cnstr: newMax(`"2100-01-01"`, true),
+				value: `"2100-01-01"`,
+				error: `Invalid value for "max" = "2100-01-01" constraint (exclusive)`,
+			},
+			`"2022-01-01T00:00:00Z" < "2022-01-01T03:00:01+03:00"`: {
+				cnstr: newMax(`"2022-01-01T00:00:00Z"`, false),
+				value: `"2022-01-01T03:00:01+03:00"`,
+				error: `Invalid value for "max" = "2022-01-01T00:00:00Z" constraint `,
+			},
+			`"2022-01-01T00:00:00Z" < "2022-01-01T03:00:00+03:00"`: {
+				cnstr: newMax(`"2022-01-01T00:00:00Z"`, false),
+				value: `"2022-01-01T03:00:00+03:00"`,
+			},
+			`"2100-01-01" < "not a date"`: {
+				cnstr: newMax(`"2100-01-01"`, false),
+				value: `"not a date"`,
+			},
 			"3.14 <= 3.14": {
 				cnstr: newMax("3.14", true),
 				value: "3.14",
@@ -141,12 +197,23 @@ func TestMax_Validate(t *testing.T) {
 }
 
 func TestMax_ASTNode(t *testing.T) {
-	assert.Equal(t, jschema.RuleASTNode{
-		TokenType:  jschema.TokenTypeNumber,
-		Value:      "1",
-		Properties: &jschema.RuleASTNodes{},
-		Source:     jschema.RuleASTNodeSourceManual,
-	}, NewMax(bytes.Bytes("1")).ASTNode())
+	t.Run("number", func(t *testing.T) {
+		assert.Equal(t, jschema.RuleASTNode{
+			TokenType:  jschema.TokenTypeNumber,
+			Value:      "1",
+			Properties: &jschema.RuleASTNodes{},
+			Source:     jschema.RuleASTNodeSourceManual,
+		}, NewMax(bytes.Bytes("1")).ASTNode())
+	})
+
+	t.Run("date", func(t *testing.T) {
+		assert.Equal(t, jschema.RuleASTNode{
+			TokenType:  jschema.TokenTypeString,
+			Value:      "2000-01-01",
+			Properties: &jschema.RuleASTNodes{},
+			Source:     jschema.RuleASTNodeSourceManual,
+		}, NewMax(bytes.Bytes(`"2000-01-01"`)).ASTNode())
+	})
 }
 
 func TestMax_Value(t *testing.T) {
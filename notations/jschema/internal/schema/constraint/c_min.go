@@ -8,7 +8,11 @@ import (
 )
 
 type Min struct {
-	min       *json.Number
+	min *json.Number
+
+	// date the bound for the "date" and "datetime" types, nil for numbers.
+	date *DateBound
+
 	rawValue  bytes.Bytes
 	exclusive bool
 }
@@ -21,6 +25,13 @@ var (
 )
 
 func NewMin(ruleValue bytes.Bytes) *Min {
+	if isDateBoundValue(ruleValue) {
+		return &Min{
+			rawValue: ruleValue,
+			date:     newDateBound(MinConstraintType, ruleValue),
+		}
+	}
+
 	number, err := json.NewNumber(ruleValue)
 	if err != nil {
 		panic(err)
@@ -32,7 +43,10 @@ func NewMin(ruleValue bytes.Bytes) *Min {
 	}
 }
 
-func (Min) IsJsonTypeCompatible(t json.Type) bool {
+func (c Min) IsJsonTypeCompatible(t json.Type) bool {
+	if c.date != nil {
+		return t == json.TypeString
+	}
 	return t == json.TypeInteger || t == json.TypeFloat
 }
 
@@ -41,7 +55,7 @@ func (Min) Type() Type {
 }
 
 func (c Min) String() string {
-	str := MinConstraintType.String() + ": " + c.min.String()
+	str := MinConstraintType.String() + ": " + c.valueString()
 	if c.exclusive {
 		return str + " (exclusive: true)"
 	}
@@ -57,6 +71,11 @@ func (c *Min) Exclusive() bool {
 }
 
 func (c Min) Validate(value bytes.Bytes) {
+	if c.date != nil {
+		c.validateDate(value)
+		return
+	}
+
 	jsonNumber, err := json.NewNumber(value)
 	if err != nil {
 		panic(err)
@@ -77,10 +96,45 @@ func (c Min) Validate(value bytes.Bytes) {
 	}
 }
 
+// validateDate compares the date or the datetime. Datetime values are compared
+// as moments in time, so "2022-01-01T03:00:00+03:00" is equal to
+// "2022-01-01T00:00:00Z".
+func (c Min) validateDate(value bytes.Bytes) {
+	v, ok := c.date.parse(value)
+	if !ok {
+		return
+	}
+
+	if c.exclusive {
+		if !v.After(c.date.Time()) {
+			panic(errors.Format(errors.ErrConstraintValidation, MinConstraintType.String(), c.valueString(), "(exclusive)"))
+		}
+	} else if v.Before(c.date.Time()) {
+		panic(errors.Format(errors.ErrConstraintValidation, MinConstraintType.String(), c.valueString(), ""))
+	}
+}
+
+func (c Min) valueString() string {
+	if c.date != nil {
+		return c.rawValue.String()
+	}
+	return c.min.String()
+}
+
 func (c Min) ASTNode() jschema.RuleASTNode {
+	if c.date != nil {
+		return newRuleASTNode(jschema.TokenTypeString, c.rawValue.Unquote().String(), jschema.RuleASTNodeSourceManual)
+	}
 	return newRuleASTNode(jschema.TokenTypeNumber, c.rawValue.String(), jschema.RuleASTNodeSourceManual)
 }
 
+// Value returns the numeric bound, or nil for the date bound.
 func (c *Min) Value() *json.Number {
 	return c.min
 }
+
+// DateBound returns the bound for the "date" and "datetime" types, or nil for
+// the numeric bound.
+func (c *Min) DateBound() *DateBound {
+	return c.date
+}
@@ -0,0 +1,58 @@
+package constraint
+
+import (
+	"strconv"
+
+	jschema "github.com/jsightapi/jsight-schema-go-library"
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+	"github.com/jsightapi/jsight-schema-go-library/errors"
+	"github.com/jsightapi/jsight-schema-go-library/internal/json"
+)
+
+// MinContains the minimum number of array items matching the "contains" rule.
+type MinContains struct {
+	value uint
+}
+
+var (
+	_ Constraint = MinContains{}
+	_ Constraint = (*MinContains)(nil)
+)
+
+func NewMinContains(ruleValue bytes.Bytes) *MinContains {
+	return &MinContains{
+		value: parseUint(ruleValue, MinContainsConstraintType),
+	}
+}
+
+func (MinContains) IsJsonTypeCompatible(t json.Type) bool {
+	return t == json.TypeArray
+}
+
+func (MinContains) Type() Type {
+	return MinContainsConstraintType
+}
+
+func (c MinContains) String() string {
+	return MinContainsConstraintType.String() + ": " + strconv.FormatUint(uint64(c.value), 10)
+}
+
+// ValidateTheNumber checks the number of array items matching the "contains"
+// rule.
+func (c MinContains) ValidateTheNumber(numberOfMatches uint) {
+	if numberOfMatches < c.value {
+		panic(errors.Format(errors.ErrNotEnoughContainedItems, strconv.FormatUint(uint64(c.value), 10)))
+	}
+}
+
+func (c MinContains) Value() uint {
+	return c.value
+}
+
+func (c MinContains) ASTNode() jschema.RuleASTNode {
+	return newRuleASTNode(
+		jschema.TokenTypeNumber,
+		strconv.FormatUint(uint64(c.value), 10),
+		jschema.RuleASTNodeSourceManual,
+	)
+}
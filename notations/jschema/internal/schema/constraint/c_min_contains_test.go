@@ -0,0 +1,83 @@
+package constraint
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	jschema "github.com/jsightapi/jsight-schema-go-library"
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+	"github.com/jsightapi/jsight-schema-go-library/internal/json"
+)
+
+func TestNewMinContains(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		cnstr := NewMinContains([]byte("10"))
+
+		assert.EqualValues(t, 10, cnstr.value)
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		ss := []string{
+			"not a number",
+			"3.14",
+			"-12",
+		}
+
+		for _, s := range ss {
+			t.Run(s, func(t *testing.T) {
+				assert.PanicsWithError(t, `Invalid value of "minContains" constraint`, func() {
+					NewMinContains([]byte(s))
+				})
+			})
+		}
+	})
+}
+
+func TestMinContains_IsJsonTypeCompatible(t *testing.T) {
+	testIsJsonTypeCompatible(t, MinContains{}, json.TypeArray)
+}
+
+func TestMinContains_Type(t *testing.T) {
+	assert.Equal(t, MinContainsConstraintType, NewMinContains(bytes.Bytes("1")).Type())
+}
+
+func TestMinContains_String(t *testing.T) {
+	assert.Equal(t, "minContains: 1", NewMinContains([]byte("1")).String())
+}
+
+func TestMinContains_ValidateTheNumber(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		cc := []uint{
+			2, 3,
+		}
+
+		for _, numberOfMatches := range cc {
+			t.Run(fmt.Sprintf("%d", numberOfMatches), func(t *testing.T) {
+				assert.NotPanics(t, func() {
+					NewMinContains([]byte("2")).ValidateTheNumber(numberOfMatches)
+				})
+			})
+		}
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		assert.PanicsWithError(t, `The array requires at least 2 items matching the "contains" rule`, func() {
+			NewMinContains([]byte("2")).ValidateTheNumber(1)
+		})
+	})
+}
+
+func TestMinContains_Value(t *testing.T) {
+	assert.EqualValues(t, 2, NewMinContains([]byte("2")).Value())
+}
+
+func TestMinContains_ASTNode(t *testing.T) {
+	assert.Equal(t, jschema.RuleASTNode{
+		TokenType:  jschema.TokenTypeNumber,
+		Value:      "1",
+		Properties: &jschema.RuleASTNodes{},
+		Source:     jschema.RuleASTNodeSourceManual,
+	}, NewMinContains(bytes.Bytes("1")).ASTNode())
+}
@@ -11,6 +11,7 @@ import (
 
 type MinLength struct {
 	value uint
+	unit  StringUnit
 }
 
 var (
@@ -39,7 +40,7 @@ func (c MinLength) String() string {
 }
 
 func (c MinLength) Validate(value bytes.Bytes) {
-	length := uint(len(value.Unquote()))
+	length := c.unit.Len(value)
 	if length < c.value {
 		panic(errors.Format(
 			errors.ErrConstraintStringLengthValidation,
@@ -60,3 +61,8 @@ func (c MinLength) ASTNode() jschema.RuleASTNode {
 func (c MinLength) Value() uint {
 	return c.value
 }
+
+// SetUnit sets the unit in which the length of the string is measured.
+func (c *MinLength) SetUnit(u StringUnit) {
+	c.unit = u
+}
@@ -82,3 +82,13 @@ func TestMinLength_ASTNode(t *testing.T) {
 func TestMinLength_Value(t *testing.T) {
 	assert.Equal(t, uint(1), NewMinLength([]byte("1")).Value())
 }
+
+func TestMinLength_SetUnit(t *testing.T) {
+	c := NewMinLength([]byte("2"))
+	c.SetUnit(StringUnitBytes)
+
+	assert.Equal(t, StringUnitBytes, c.unit)
+	assert.PanicsWithError(t, `Invalid string length for "minLength" = "2" constraint`, func() {
+		c.Validate([]byte(`"a"`))
+	})
+}
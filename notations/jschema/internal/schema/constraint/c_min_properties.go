@@ -0,0 +1,57 @@
+package constraint
+
+import (
+	"strconv"
+
+	jschema "github.com/jsightapi/jsight-schema-go-library"
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+	"github.com/jsightapi/jsight-schema-go-library/errors"
+	"github.com/jsightapi/jsight-schema-go-library/internal/json"
+)
+
+type MinProperties struct {
+	value uint
+}
+
+var (
+	_ Constraint      = MinProperties{}
+	_ Constraint      = (*MinProperties)(nil)
+	_ ObjectValidator = MinProperties{}
+	_ ObjectValidator = (*MinProperties)(nil)
+)
+
+func NewMinProperties(ruleValue bytes.Bytes) *MinProperties {
+	return &MinProperties{
+		value: parseUint(ruleValue, MinPropertiesConstraintType),
+	}
+}
+
+func (MinProperties) IsJsonTypeCompatible(t json.Type) bool {
+	return t == json.TypeObject
+}
+
+func (MinProperties) Type() Type {
+	return MinPropertiesConstraintType
+}
+
+func (c MinProperties) String() string {
+	return MinPropertiesConstraintType.String() + ": " + strconv.FormatUint(uint64(c.value), 10)
+}
+
+func (c MinProperties) ValidateTheObject(numberOfProperties uint) {
+	if numberOfProperties < c.value {
+		panic(errors.ErrConstraintMinPropertiesValidation)
+	}
+}
+
+func (c MinProperties) Value() uint {
+	return c.value
+}
+
+func (c MinProperties) ASTNode() jschema.RuleASTNode {
+	return newRuleASTNode(
+		jschema.TokenTypeNumber,
+		strconv.FormatUint(uint64(c.value), 10),
+		jschema.RuleASTNodeSourceManual,
+	)
+}
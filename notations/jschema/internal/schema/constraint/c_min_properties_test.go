@@ -0,0 +1,84 @@
+package constraint
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	jschema "github.com/jsightapi/jsight-schema-go-library"
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+	"github.com/jsightapi/jsight-schema-go-library/internal/json"
+)
+
+func TestNewMinProperties(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		cnstr := NewMinProperties([]byte("10"))
+
+		assert.EqualValues(t, 10, cnstr.value)
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		ss := []string{
+			"not a number",
+			"3.14",
+			"-12",
+		}
+
+		for _, s := range ss {
+			t.Run(s, func(t *testing.T) {
+				assert.PanicsWithError(t, `Invalid value of "minProperties" constraint`, func() {
+					NewMinProperties([]byte(s))
+				})
+			})
+		}
+	})
+}
+
+func TestMinProperties_IsJsonTypeCompatible(t *testing.T) {
+	testIsJsonTypeCompatible(t, MinProperties{}, json.TypeObject)
+}
+
+func TestMinProperties_Type(t *testing.T) {
+	assert.Equal(t, MinPropertiesConstraintType, NewMinProperties(bytes.Bytes("1")).Type())
+}
+
+func TestMinProperties_String(t *testing.T) {
+	assert.Equal(t, "minProperties: 1", NewMinProperties([]byte("1")).String())
+}
+
+func TestMinProperties_ValidateTheObject(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		cc := []uint{
+			2,
+			3,
+		}
+
+		for _, numberOfChildren := range cc {
+			t.Run(fmt.Sprintf("%d", numberOfChildren), func(t *testing.T) {
+				assert.NotPanics(t, func() {
+					NewMinProperties([]byte("2")).ValidateTheObject(numberOfChildren)
+				})
+			})
+		}
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		assert.PanicsWithError(t, `The number of object properties does not match the "minProperties" rule`, func() {
+			NewMinProperties([]byte("2")).ValidateTheObject(1)
+		})
+	})
+}
+
+func TestMinProperties_Value(t *testing.T) {
+	assert.EqualValues(t, 2, NewMinProperties([]byte("2")).Value())
+}
+
+func TestMinProperties_ASTNode(t *testing.T) {
+	assert.Equal(t, jschema.RuleASTNode{
+		TokenType:  jschema.TokenTypeNumber,
+		Value:      "1",
+		Properties: &jschema.RuleASTNodes{},
+		Source:     jschema.RuleASTNodeSourceManual,
+	}, NewMinProperties(bytes.Bytes("1")).ASTNode())
+}
@@ -24,15 +24,44 @@ func TestNewMin(t *testing.T) {
 		assert.False(t, cnstr.exclusive)
 	})
 
+	t.Run("positive date", func(t *testing.T) {
+		cc := map[string]bool{
+			`"1900-01-01"`:                false,
+			`"2022-01-01T03:00:00+03:00"`: true,
+		}
+
+		for given, isDateTime := range cc {
+			t.Run(given, func(t *testing.T) {
+				cnstr := NewMin(bytes.Bytes(given))
+
+				assert.Nil(t, cnstr.min)
+				require.NotNil(t, cnstr.DateBound())
+				assert.Equal(t, isDateTime, cnstr.DateBound().IsDateTime())
+			})
+		}
+	})
+
 	t.Run("negative", func(t *testing.T) {
 		assert.PanicsWithError(t, `Incorrect number value "not a number"`, func() {
 			NewMin([]byte("not a number"))
 		})
 	})
+
+	t.Run("negative date", func(t *testing.T) {
+		assert.PanicsWithError(t, `Invalid value of "min" constraint`, func() {
+			NewMin([]byte(`"2000-13-01"`))
+		})
+	})
 }
 
 func TestMin_IsJsonTypeCompatible(t *testing.T) {
-	testIsJsonTypeCompatible(t, Min{}, json.TypeInteger, json.TypeFloat)
+	t.Run("number", func(t *testing.T) {
+		testIsJsonTypeCompatible(t, Min{}, json.TypeInteger, json.TypeFloat)
+	})
+
+	t.Run("date", func(t *testing.T) {
+		testIsJsonTypeCompatible(t, NewMin(bytes.Bytes(`"2000-01-01"`)), json.TypeString)
+	})
 }
 
 func TestMin_Type(t *testing.T) {
@@ -89,6 +118,33 @@ func TestMin_Validate(t *testing.T) {
 			value string
 			error string
 		}{
+			`"1900-01-01" > "1899-12-31"`: {
+				cnstr: newMin(`"1900-01-01"`, false),
+				value: `"1899-12-31"`,
+				error: `Invalid value for "min" = "1900-01-01" constraint `,
+			},
+			`"1900-01-01" > "1900-01-01"`: {
+				cnstr: newMin(`"1900-01-01"`, false),
+				value: `"1900-01-01"`,
+			},
+			`"1900-01-01" >= "1900-01-01"`: {
+				cnstr: newMin(`"1900-01-01"`, true),
+				value: `"1900-01-01"`,
+				error: `Invalid value for "min" = "1900-01-01" constraint (exclusive)`,
+			},
+			`"2022-01-01T03:00:00+03:00" >= "2022-01-01T00:00:00Z"`: {
+				cnstr: newMin(`"2022-01-01T03:00:00+03:00"`, true),
+				value: `"2022-01-01T00:00:00Z"`,
+				error: `Invalid value for "min" = "2022-01-01T03:00:00+03:00" constraint (exclusive)`,
+			},
+			`"2022-01-01T03:00:00+03:00" > "2022-01-01T00:00:00Z"`: {
+				cnstr: newMin(`"2022-01-01T03:00:00+03:00"`, false),
+				value: `"2022-01-01T00:00:00Z"`,
+			},
+			`"1900-01-01" > "not a date"`: {
+				cnstr: newMin(`"1900-01-01"`, false),
+				value: `"not a date"`,
+			},
 			"3.14 >= 3.14": {
 				cnstr: newMin("3.14", true),
 				value: "3.14",
@@ -141,12 +197,23 @@ func TestMin_Validate(t *testing.T) {
 }
 
 func TestMin_ASTNode(t *testing.T) {
-	assert.Equal(t, jschema.RuleASTNode{
-		TokenType:  jschema.TokenTypeNumber,
-		Value:      "1",
-		Properties: &jschema.RuleASTNodes{},
-		Source:     jschema.RuleASTNodeSourceManual,
-	}, NewMin(bytes.Bytes("1")).ASTNode())
+	t.Run("number", func(t *testing.T) {
+		assert.Equal(t, jschema.RuleASTNode{
+			TokenType:  jschema.TokenTypeNumber,
+			Value:      "1",
+			Properties: &jschema.RuleASTNodes{},
+			Source:     jschema.RuleASTNodeSourceManual,
+		}, NewMin(bytes.Bytes("1")).ASTNode())
+	})
+
+	t.Run("date", func(t *testing.T) {
+		assert.Equal(t, jschema.RuleASTNode{
+			TokenType:  jschema.TokenTypeString,
+			Value:      "2000-01-01",
+			Properties: &jschema.RuleASTNodes{},
+			Source:     jschema.RuleASTNodeSourceManual,
+		}, NewMin(bytes.Bytes(`"2000-01-01"`)).ASTNode())
+	})
 }
 
 func TestMin_Value(t *testing.T) {
@@ -0,0 +1,66 @@
+package constraint
+
+import (
+	jschema "github.com/jsightapi/jsight-schema-go-library"
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+	"github.com/jsightapi/jsight-schema-go-library/errors"
+	"github.com/jsightapi/jsight-schema-go-library/internal/json"
+)
+
+// MultipleOf requires the number to be an integer multiple of the rule value.
+// Numbers are compared exactly, without converting to float64, so
+// `{multipleOf: 0.01}` works as expected for money amounts.
+type MultipleOf struct {
+	value    *json.Number
+	rawValue bytes.Bytes
+}
+
+var (
+	_ Constraint       = MultipleOf{}
+	_ Constraint       = (*MultipleOf)(nil)
+	_ LiteralValidator = MultipleOf{}
+	_ LiteralValidator = (*MultipleOf)(nil)
+)
+
+func NewMultipleOf(ruleValue bytes.Bytes) *MultipleOf {
+	number, err := json.NewNumber(ruleValue)
+	if err != nil || number.Rat().Sign() <= 0 {
+		panic(errors.Format(errors.ErrInvalidValueOfConstraint, MultipleOfConstraintType.String()))
+	}
+
+	return &MultipleOf{
+		value:    number,
+		rawValue: ruleValue,
+	}
+}
+
+func (MultipleOf) IsJsonTypeCompatible(t json.Type) bool {
+	return t == json.TypeInteger || t == json.TypeFloat
+}
+
+func (MultipleOf) Type() Type {
+	return MultipleOfConstraintType
+}
+
+func (c MultipleOf) String() string {
+	return MultipleOfConstraintType.String() + ": " + c.value.String()
+}
+
+func (c MultipleOf) Validate(value bytes.Bytes) {
+	n, err := json.NewNumber(value)
+	if err != nil {
+		panic(err)
+	}
+
+	if !n.IsMultipleOf(c.value) {
+		panic(errors.Format(errors.ErrConstraintValidation, MultipleOfConstraintType.String(), c.value.String(), ""))
+	}
+}
+
+func (c MultipleOf) ASTNode() jschema.RuleASTNode {
+	return newRuleASTNode(jschema.TokenTypeNumber, c.rawValue.String(), jschema.RuleASTNodeSourceManual)
+}
+
+func (c MultipleOf) Value() *json.Number {
+	return c.value
+}
@@ -0,0 +1,95 @@
+package constraint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	jschema "github.com/jsightapi/jsight-schema-go-library"
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+	"github.com/jsightapi/jsight-schema-go-library/internal/json"
+)
+
+func TestNewMultipleOf(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		cnstr := NewMultipleOf(bytes.Bytes("0.050"))
+		assert.Equal(t, "0.05", cnstr.value.String())
+		assert.Equal(t, "0.050", cnstr.rawValue.String())
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		ss := []string{
+			"0",
+			"-1",
+			"not a number",
+			`"1"`,
+		}
+
+		for _, s := range ss {
+			t.Run(s, func(t *testing.T) {
+				assert.PanicsWithError(t, `Invalid value of "multipleOf" constraint`, func() {
+					NewMultipleOf(bytes.Bytes(s))
+				})
+			})
+		}
+	})
+}
+
+func TestMultipleOf_IsJsonTypeCompatible(t *testing.T) {
+	testIsJsonTypeCompatible(t, MultipleOf{}, json.TypeInteger, json.TypeFloat)
+}
+
+func TestMultipleOf_Type(t *testing.T) {
+	assert.Equal(t, MultipleOfConstraintType, NewMultipleOf(bytes.Bytes("1")).Type())
+}
+
+func TestMultipleOf_String(t *testing.T) {
+	assert.Equal(t, "multipleOf: 0.01", NewMultipleOf(bytes.Bytes("1e-2")).String())
+}
+
+func TestMultipleOf_Validate(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		cc := map[string][]string{
+			"10":   {"0", "10", "-20", "1e3", "100.0"},
+			"0.01": {"19.99", "0.1", "-0.05", "12345678901234567890.12"},
+			"2.5":  {"7.5", "-2.5", "25e-1"},
+		}
+
+		for multipleOf, values := range cc {
+			for _, v := range values {
+				t.Run(multipleOf+" "+v, func(t *testing.T) {
+					assert.NotPanics(t, func() {
+						NewMultipleOf(bytes.Bytes(multipleOf)).Validate(bytes.Bytes(v))
+					})
+				})
+			}
+		}
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		cc := map[string][]string{
+			"10":   {"1", "15", "10.5"},
+			"0.01": {"19.999", "0.001"},
+			"0.1":  {"0.35"},
+		}
+
+		for multipleOf, values := range cc {
+			for _, v := range values {
+				t.Run(multipleOf+" "+v, func(t *testing.T) {
+					assert.PanicsWithError(t, `Invalid value for "multipleOf" = `+multipleOf+` constraint `, func() {
+						NewMultipleOf(bytes.Bytes(multipleOf)).Validate(bytes.Bytes(v))
+					})
+				})
+			}
+		}
+	})
+}
+
+func TestMultipleOf_ASTNode(t *testing.T) {
+	assert.Equal(t, jschema.RuleASTNode{
+		TokenType:  jschema.TokenTypeNumber,
+		Value:      "0.50",
+		Properties: &jschema.RuleASTNodes{},
+		Source:     jschema.RuleASTNodeSourceManual,
+	}, NewMultipleOf(bytes.Bytes("0.50")).ASTNode())
+}
@@ -0,0 +1,112 @@
+package constraint
+
+import (
+	jschema "github.com/jsightapi/jsight-schema-go-library"
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+	"github.com/jsightapi/jsight-schema-go-library/internal/json"
+)
+
+// Not excludes values from the node. Excluded values are described by the
+// value, or by the type (user type or type created from the rule-set).
+//
+// Example:
+//
+//	{not: "admin"} - any value except "admin".
+//	{not: "@legacy"} - any value which isn't valid for the "@legacy" type.
+//	{not: {enum: ["admin", "root"]}} - any value except "admin" and "root".
+type Not struct {
+	// value the excluded value, nil if excluded values are described by the
+	// type.
+	value bytes.Bytes
+
+	// types contains the single type which describes excluded values.
+	types *TypesList
+}
+
+var (
+	_ Constraint = Not{}
+	_ Constraint = (*Not)(nil)
+)
+
+func NewNot() *Not {
+	return &Not{
+		types: NewTypesList(jschema.RuleASTNodeSourceManual),
+	}
+}
+
+func (Not) IsJsonTypeCompatible(json.Type) bool {
+	return true
+}
+
+func (Not) Type() Type {
+	return NotConstraintType
+}
+
+func (c Not) String() string {
+	if c.value != nil {
+		return NotConstraintType.String() + ": " + c.value.String()
+	}
+	return NotConstraintType.String() + ": " + c.TypeName()
+}
+
+// SetValue sets the excluded value.
+func (c *Not) SetValue(v bytes.Bytes) {
+	c.value = v
+}
+
+// Value returns the excluded value, or nil if excluded values are described by
+// the type.
+func (c Not) Value() bytes.Bytes {
+	return c.value
+}
+
+// TypesList returns the list with the single type which describes excluded
+// values. Is used by loaders for adding the type.
+func (c Not) TypesList() *TypesList {
+	return c.types
+}
+
+// HasType returns true if excluded values are described by the type.
+func (c Not) HasType() bool {
+	return c.types.Len() != 0
+}
+
+// TypeName returns the name of the type which describes excluded values.
+func (c Not) TypeName() string {
+	if !c.HasType() {
+		return ""
+	}
+	return c.types.Names()[0]
+}
+
+// Match returns true if the JSON value is equal to the excluded value. Values
+// are compared by their JSON meaning, so 1 is equal to 1.0.
+func (c Not) Match(v bytes.Bytes) bool {
+	if c.value == nil {
+		return false
+	}
+
+	expected, err := json.Canonical(c.value)
+	if err != nil {
+		return false
+	}
+
+	actual, err := json.Canonical(v)
+	if err != nil {
+		return false
+	}
+
+	return expected == actual
+}
+
+func (c Not) ASTNode() jschema.RuleASTNode {
+	if c.HasType() {
+		return c.types.ASTNode().Items[0]
+	}
+
+	return newRuleASTNode(
+		json.Guess(c.value).LiteralJsonType().ToTokenType(),
+		c.value.Unquote().String(),
+		jschema.RuleASTNodeSourceManual,
+	)
+}
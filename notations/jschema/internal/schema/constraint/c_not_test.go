@@ -0,0 +1,126 @@
+package constraint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	jschema "github.com/jsightapi/jsight-schema-go-library"
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+)
+
+func newTestNotWithType(name string) *Not {
+	c := NewNot()
+	c.TypesList().AddNameWithASTNode(name, name, jschema.RuleASTNode{
+		TokenType:  jschema.TokenTypeShortcut,
+		Value:      name,
+		Properties: &jschema.RuleASTNodes{},
+		Source:     jschema.RuleASTNodeSourceManual,
+	})
+	return c
+}
+
+func newTestNotWithValue(v string) *Not {
+	c := NewNot()
+	c.SetValue(bytes.Bytes(v))
+	return c
+}
+
+func TestNot_IsJsonTypeCompatible(t *testing.T) {
+	testIsJsonTypeCompatible(t, Not{}, allJSONTypes...)
+}
+
+func TestNot_Type(t *testing.T) {
+	assert.Equal(t, NotConstraintType, NewNot().Type())
+}
+
+func TestNot_String(t *testing.T) {
+	cc := map[string]*Not{
+		`not: "admin"`: newTestNotWithValue(`"admin"`),
+		`not: 42`:      newTestNotWithValue(`42`),
+		`not: @legacy`: newTestNotWithType("@legacy"),
+	}
+
+	for expected, c := range cc {
+		t.Run(expected, func(t *testing.T) {
+			assert.Equal(t, expected, c.String())
+		})
+	}
+}
+
+func TestNot_TypeName(t *testing.T) {
+	t.Run("value", func(t *testing.T) {
+		c := newTestNotWithValue(`"admin"`)
+
+		assert.False(t, c.HasType())
+		assert.Equal(t, "", c.TypeName())
+		assert.Equal(t, bytes.Bytes(`"admin"`), c.Value())
+	})
+
+	t.Run("type", func(t *testing.T) {
+		c := newTestNotWithType("@legacy")
+
+		assert.True(t, c.HasType())
+		assert.Equal(t, "@legacy", c.TypeName())
+		assert.Nil(t, c.Value())
+	})
+}
+
+func TestNot_Match(t *testing.T) {
+	cc := map[string]struct {
+		given    string
+		value    string
+		expected bool
+	}{
+		"same string":      {`"admin"`, `"admin"`, true},
+		"escaped string":   {`"admin"`, `"\u0061dmin"`, true},
+		"other string":     {`"admin"`, `"root"`, false},
+		"same number":      {`1`, `1.0`, true},
+		"string vs number": {`"1"`, `1`, false},
+		"null":             {`null`, `null`, true},
+	}
+
+	for name, c := range cc {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, c.expected, newTestNotWithValue(c.given).Match(bytes.Bytes(c.value)))
+		})
+	}
+
+	t.Run("type", func(t *testing.T) {
+		assert.False(t, newTestNotWithType("@legacy").Match(bytes.Bytes(`"@legacy"`)))
+	})
+}
+
+func TestNot_ASTNode(t *testing.T) {
+	const source = jschema.RuleASTNodeSourceManual
+
+	cc := map[string]struct {
+		given    *Not
+		expected jschema.RuleASTNode
+	}{
+		"value": {
+			newTestNotWithValue(`"admin"`),
+			jschema.RuleASTNode{
+				TokenType:  jschema.TokenTypeString,
+				Value:      "admin",
+				Properties: &jschema.RuleASTNodes{},
+				Source:     source,
+			},
+		},
+		"type": {
+			newTestNotWithType("@legacy"),
+			jschema.RuleASTNode{
+				TokenType:  jschema.TokenTypeShortcut,
+				Value:      "@legacy",
+				Properties: &jschema.RuleASTNodes{},
+				Source:     source,
+			},
+		},
+	}
+
+	for name, c := range cc {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, c.expected, c.given.ASTNode())
+		})
+	}
+}
@@ -59,6 +59,10 @@ func (c Precision) Validate(value bytes.Bytes) {
 	}
 }
 
+func (c Precision) Value() uint {
+	return c.value
+}
+
 func (c Precision) ASTNode() jschema.RuleASTNode {
 	return newRuleASTNode(
 		jschema.TokenTypeNumber,
@@ -0,0 +1,76 @@
+package constraint
+
+import (
+	"encoding/json"
+	"regexp"
+
+	jschema "github.com/jsightapi/jsight-schema-go-library"
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+	"github.com/jsightapi/jsight-schema-go-library/errors"
+	internalJSON "github.com/jsightapi/jsight-schema-go-library/internal/json"
+)
+
+// PropertyNames restricts keys of additional properties of the object.
+//
+// Handle next cases:
+//
+//	{propertyNames: "^[a-z]{2}$"} - keys should match the regular expression.
+//	{propertyNames: "@LocaleCode"} - keys should be valid values of the string
+//	  user type.
+type PropertyNames struct {
+	re         *regexp.Regexp // only for the regular expression
+	typeName   bytes.Bytes    // only for the user type
+	expression string
+}
+
+var (
+	_ Constraint = PropertyNames{}
+	_ Constraint = (*PropertyNames)(nil)
+)
+
+func NewPropertyNames(ruleValue bytes.Bytes) *PropertyNames {
+	c := PropertyNames{}
+
+	if !ruleValue.InQuotes() {
+		panic(errors.Format(errors.ErrInvalidValueOfConstraint, PropertyNamesConstraintType.String()))
+	}
+
+	if err := json.Unmarshal(ruleValue, &c.expression); err != nil {
+		panic(err)
+	}
+
+	if bytes.Bytes(c.expression).IsUserTypeName() {
+		c.typeName = bytes.Bytes(c.expression)
+	} else {
+		c.re = regexp.MustCompile(c.expression) // can panic
+	}
+	return &c
+}
+
+func (PropertyNames) IsJsonTypeCompatible(t internalJSON.Type) bool {
+	return t == internalJSON.TypeObject
+}
+
+func (PropertyNames) Type() Type {
+	return PropertyNamesConstraintType
+}
+
+func (c PropertyNames) String() string {
+	return PropertyNamesConstraintType.String() + ": " + c.expression
+}
+
+// TypeName returns the name of the user type, or nil if keys are validated by
+// the regular expression.
+func (c PropertyNames) TypeName() bytes.Bytes {
+	return c.typeName
+}
+
+// Match checks the key against the regular expression. Always returns true if
+// keys are validated by the user type.
+func (c PropertyNames) Match(key string) bool {
+	return c.re == nil || c.re.MatchString(key)
+}
+
+func (c PropertyNames) ASTNode() jschema.RuleASTNode {
+	return newRuleASTNode(jschema.TokenTypeString, c.expression, jschema.RuleASTNodeSourceManual)
+}
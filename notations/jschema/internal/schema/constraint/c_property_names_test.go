@@ -0,0 +1,85 @@
+package constraint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	jschema "github.com/jsightapi/jsight-schema-go-library"
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+	"github.com/jsightapi/jsight-schema-go-library/internal/json"
+)
+
+func TestNewPropertyNames(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		t.Run("regex", func(t *testing.T) {
+			c := NewPropertyNames([]byte(`"^[a-z]+$"`))
+
+			assert.Equal(t, "^[a-z]+$", c.expression)
+			assert.NotNil(t, c.re)
+			assert.Nil(t, c.typeName)
+		})
+
+		t.Run("user type", func(t *testing.T) {
+			c := NewPropertyNames([]byte(`"@foo"`))
+
+			assert.Equal(t, "@foo", c.expression)
+			assert.Nil(t, c.re)
+			assert.Equal(t, bytes.Bytes("@foo"), c.typeName)
+		})
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		t.Run("not a string", func(t *testing.T) {
+			assert.PanicsWithError(t, `Invalid value of "propertyNames" constraint`, func() {
+				NewPropertyNames([]byte("42"))
+			})
+		})
+
+		t.Run("invalid expression", func(t *testing.T) {
+			assert.PanicsWithValue(t, "regexp: Compile(`\\l`): error parsing regexp: invalid escape sequence: `\\l`", func() {
+				NewPropertyNames([]byte(`"\\l"`))
+			})
+		})
+	})
+}
+
+func TestPropertyNames_IsJsonTypeCompatible(t *testing.T) {
+	testIsJsonTypeCompatible(t, PropertyNames{}, json.TypeObject)
+}
+
+func TestPropertyNames_Type(t *testing.T) {
+	assert.Equal(t, PropertyNamesConstraintType, NewPropertyNames(bytes.Bytes(`"."`)).Type())
+}
+
+func TestPropertyNames_String(t *testing.T) {
+	assert.Equal(t, "propertyNames: @foo", NewPropertyNames([]byte(`"@foo"`)).String())
+}
+
+func TestPropertyNames_Match(t *testing.T) {
+	cc := map[string]bool{
+		"en":  true,
+		"EN":  false,
+		"eng": false,
+	}
+
+	cnstr := NewPropertyNames([]byte(`"^[a-z]{2}$"`))
+	for key, expected := range cc {
+		t.Run(key, func(t *testing.T) {
+			assert.Equal(t, expected, cnstr.Match(key))
+		})
+	}
+
+	t.Run("user type", func(t *testing.T) {
+		assert.True(t, NewPropertyNames([]byte(`"@foo"`)).Match("anything"))
+	})
+}
+
+func TestPropertyNames_ASTNode(t *testing.T) {
+	assert.Equal(t, jschema.RuleASTNode{
+		TokenType:  jschema.TokenTypeString,
+		Value:      "@foo",
+		Properties: &jschema.RuleASTNodes{},
+		Source:     jschema.RuleASTNodeSourceManual,
+	}, NewPropertyNames(bytes.Bytes(`"@foo"`)).ASTNode())
+}
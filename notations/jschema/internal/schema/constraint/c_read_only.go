@@ -0,0 +1,60 @@
+package constraint
+
+import (
+	"strconv"
+
+	jschema "github.com/jsightapi/jsight-schema-go-library"
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+	"github.com/jsightapi/jsight-schema-go-library/errors"
+	"github.com/jsightapi/jsight-schema-go-library/internal/json"
+)
+
+// ReadOnly marks the value as managed by the server. Doesn't affect validation,
+// but object keys marked by this rule are rejected in the request mode.
+//
+// Example:
+//
+//	"id": 1 // {readOnly: true}
+type ReadOnly struct {
+	value bool
+}
+
+var (
+	_ Constraint = ReadOnly{}
+	_ Constraint = (*ReadOnly)(nil)
+	_ BoolKeeper = ReadOnly{}
+	_ BoolKeeper = (*ReadOnly)(nil)
+)
+
+func NewReadOnly(ruleValue bytes.Bytes) *ReadOnly {
+	c := ReadOnly{}
+
+	var err error
+	if c.value, err = ruleValue.ParseBool(); err != nil {
+		panic(errors.Format(errors.ErrInvalidValueOfConstraint, ReadOnlyConstraintType.String()))
+	}
+	return &c
+}
+
+func (ReadOnly) IsJsonTypeCompatible(json.Type) bool {
+	return true
+}
+
+func (ReadOnly) Type() Type {
+	return ReadOnlyConstraintType
+}
+
+func (c ReadOnly) String() string {
+	if c.value {
+		return ReadOnlyConstraintType.String() + ": true"
+	}
+	return ReadOnlyConstraintType.String() + ": false"
+}
+
+func (c ReadOnly) Bool() bool {
+	return c.value
+}
+
+func (c ReadOnly) ASTNode() jschema.RuleASTNode {
+	return newRuleASTNode(jschema.TokenTypeBoolean, strconv.FormatBool(c.value), jschema.RuleASTNodeSourceManual)
+}
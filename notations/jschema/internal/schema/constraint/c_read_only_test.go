@@ -0,0 +1,82 @@
+package constraint
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	jschema "github.com/jsightapi/jsight-schema-go-library"
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+)
+
+func TestNewReadOnly(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		cc := map[string]bool{
+			"true":  true,
+			"false": false,
+		}
+
+		for given, expected := range cc {
+			t.Run(given, func(t *testing.T) {
+				c := NewReadOnly([]byte(given))
+				assert.Equal(t, expected, c.value)
+			})
+		}
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		assert.PanicsWithError(t, `Invalid value of "readOnly" constraint`, func() {
+			NewReadOnly([]byte("foo"))
+		})
+	})
+}
+
+func TestReadOnly_IsJsonTypeCompatible(t *testing.T) {
+	testIsJsonTypeCompatible(t, ReadOnly{}, allJSONTypes...)
+}
+
+func TestReadOnly_Type(t *testing.T) {
+	assert.Equal(t, ReadOnlyConstraintType, NewReadOnly(bytes.Bytes("true")).Type())
+}
+
+func TestReadOnly_String(t *testing.T) {
+	cc := map[string]string{
+		"false": "readOnly: false",
+		"true":  "readOnly: true",
+	}
+
+	for given, expected := range cc {
+		t.Run(given, func(t *testing.T) {
+			assert.Equal(t, expected, NewReadOnly([]byte(given)).String())
+		})
+	}
+}
+
+func TestReadOnly_Bool(t *testing.T) {
+	cc := map[string]bool{
+		"false": false,
+		"true":  true,
+	}
+
+	for given, expected := range cc {
+		t.Run(given, func(t *testing.T) {
+			assert.Equal(t, expected, NewReadOnly([]byte(given)).Bool())
+		})
+	}
+}
+
+func TestReadOnly_ASTNode(t *testing.T) {
+	cc := []bool{true, false}
+
+	for _, c := range cc {
+		t.Run(strconv.FormatBool(c), func(t *testing.T) {
+			assert.Equal(t, jschema.RuleASTNode{
+				TokenType:  jschema.TokenTypeBoolean,
+				Value:      strconv.FormatBool(c),
+				Properties: &jschema.RuleASTNodes{},
+				Source:     jschema.RuleASTNodeSourceManual,
+			}, ReadOnly{value: c}.ASTNode())
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package constraint
+
+import (
+	"regexp"
+
+	jschema "github.com/jsightapi/jsight-schema-go-library"
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+	"github.com/jsightapi/jsight-schema-go-library/errors"
+	"github.com/jsightapi/jsight-schema-go-library/internal/json"
+)
+
+// Semver a version as described in the Semantic Versioning 2.0.0 specification.
+// Example: "1.0.0", "2.1.3-beta.1+build.42".
+type Semver struct{}
+
+var (
+	_ Constraint       = Semver{}
+	_ Constraint       = (*Semver)(nil)
+	_ LiteralValidator = Semver{}
+	_ LiteralValidator = (*Semver)(nil)
+)
+
+func NewSemver() *Semver {
+	return &Semver{}
+}
+
+func (Semver) IsJsonTypeCompatible(t json.Type) bool {
+	return t == json.TypeString
+}
+
+func (Semver) Type() Type {
+	return SemverConstraintType
+}
+
+func (Semver) String() string {
+	return SemverConstraintType.String()
+}
+
+// semverRegex the regular expression recommended by the Semantic Versioning
+// 2.0.0 specification.
+var semverRegex = regexp.MustCompile(`^(0|[1-9]\d*)\.(0|[1-9]\d*)\.(0|[1-9]\d*)` +
+	`(?:-((?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*)(?:\.(?:0|[1-9]\d*|\d*[a-zA-Z-][0-9a-zA-Z-]*))*))?` +
+	`(?:\+([0-9a-zA-Z-]+(?:\.[0-9a-zA-Z-]+)*))?$`)
+
+func (Semver) Validate(value bytes.Bytes) {
+	if !semverRegex.Match(value.Unquote()) {
+		panic(errors.Format(errors.ErrInvalidSemver, `"MAJOR.MINOR.PATCH[-PRERELEASE][+BUILD]" format expected`))
+	}
+}
+
+func (Semver) ASTNode() jschema.RuleASTNode {
+	return newEmptyRuleASTNode()
+}
@@ -0,0 +1,69 @@
+package constraint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+	"github.com/jsightapi/jsight-schema-go-library/internal/json"
+)
+
+func TestSemver_IsJsonTypeCompatible(t *testing.T) {
+	testIsJsonTypeCompatible(t, Semver{}, json.TypeString)
+}
+
+func TestSemver_Type(t *testing.T) {
+	assert.Equal(t, SemverConstraintType, NewSemver().Type())
+}
+
+func TestSemver_String(t *testing.T) {
+	assert.Equal(t, "semver", NewSemver().String())
+}
+
+func TestSemver_Validate(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		tests := []string{
+			`0.0.0`,
+			`1.0.0`,
+			`2.1.3-beta.1+build.42`,
+			`1.0.0-alpha`,
+			`1.0.0+20130313144700`,
+			`1.0.0-0.3.7`,
+			`"1.2.3"`,
+		}
+
+		for _, value := range tests {
+			t.Run(value, func(t *testing.T) {
+				assert.NotPanics(t, func() {
+					NewSemver().Validate(bytes.Bytes(value))
+				})
+			})
+		}
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		tests := map[string]string{
+			"":         "Invalid semantic version (\"MAJOR.MINOR.PATCH[-PRERELEASE][+BUILD]\" format expected)",
+			"1":        "Invalid semantic version (\"MAJOR.MINOR.PATCH[-PRERELEASE][+BUILD]\" format expected)",
+			"1.0":      "Invalid semantic version (\"MAJOR.MINOR.PATCH[-PRERELEASE][+BUILD]\" format expected)",
+			"v1.0.0":   "Invalid semantic version (\"MAJOR.MINOR.PATCH[-PRERELEASE][+BUILD]\" format expected)",
+			"01.0.0":   "Invalid semantic version (\"MAJOR.MINOR.PATCH[-PRERELEASE][+BUILD]\" format expected)",
+			"1.0.0-01": "Invalid semantic version (\"MAJOR.MINOR.PATCH[-PRERELEASE][+BUILD]\" format expected)",
+			"1.0.0+":   "Invalid semantic version (\"MAJOR.MINOR.PATCH[-PRERELEASE][+BUILD]\" format expected)",
+			"1.0.0-":   "Invalid semantic version (\"MAJOR.MINOR.PATCH[-PRERELEASE][+BUILD]\" format expected)",
+		}
+
+		for given, expected := range tests {
+			t.Run(given, func(t *testing.T) {
+				assert.PanicsWithError(t, expected, func() {
+					NewSemver().Validate(bytes.Bytes(given))
+				})
+			})
+		}
+	})
+}
+
+func TestSemver_ASTNode(t *testing.T) {
+	assert.Equal(t, newEmptyRuleASTNode(), Semver{}.ASTNode())
+}
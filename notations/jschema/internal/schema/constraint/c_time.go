@@ -0,0 +1,106 @@
+package constraint
+
+import (
+	stdErrors "errors"
+	"fmt"
+
+	jschema "github.com/jsightapi/jsight-schema-go-library"
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+	"github.com/jsightapi/jsight-schema-go-library/errors"
+	"github.com/jsightapi/jsight-schema-go-library/internal/json"
+)
+
+// Time a time of the day without a time zone, the "partial-time" of RFC 3339.
+// Example: "23:59:59", "08:30:00.250".
+type Time struct{}
+
+var (
+	_ Constraint       = Time{}
+	_ Constraint       = (*Time)(nil)
+	_ LiteralValidator = Time{}
+	_ LiteralValidator = (*Time)(nil)
+)
+
+func NewTime() *Time {
+	return &Time{}
+}
+
+func (Time) IsJsonTypeCompatible(t json.Type) bool {
+	return t == json.TypeString
+}
+
+func (Time) Type() Type {
+	return TimeConstraintType
+}
+
+func (Time) String() string {
+	return TimeConstraintType.String()
+}
+
+func (Time) Validate(value bytes.Bytes) {
+	if err := parsePartialTime(value.Unquote()); err != nil {
+		panic(errors.Format(errors.ErrInvalidTime, err))
+	}
+}
+
+func (Time) ASTNode() jschema.RuleASTNode {
+	return newEmptyRuleASTNode()
+}
+
+// parsePartialTime checks the "HH:MM:SS[.frac]" string. The second might be 60
+// in case of the leap second.
+func parsePartialTime(b bytes.Bytes) error {
+	if len(b) < 8 || b[2] != ':' || b[5] != ':' {
+		return stdErrors.New(`"HH:MM:SS" format expected`)
+	}
+
+	parts := []struct {
+		name string
+		max  int
+		pos  int
+	}{
+		{"hour", 23, 0},
+		{"minute", 59, 3},
+		{"second", 60, 6},
+	}
+
+	for _, p := range parts {
+		v, ok := parseTwoDigits(b[p.pos : p.pos+2])
+		if !ok {
+			return stdErrors.New(`"HH:MM:SS" format expected`)
+		}
+		if v > p.max {
+			return fmt.Errorf("%s out of range", p.name)
+		}
+	}
+
+	frac := b[8:]
+	if len(frac) == 0 {
+		return nil
+	}
+
+	if frac[0] != '.' {
+		return fmt.Errorf("unexpected %q after the second", frac.String())
+	}
+
+	if len(frac) == 1 {
+		return stdErrors.New("invalid fraction of the second")
+	}
+	for _, c := range frac[1:] {
+		if !isDigit(c) {
+			return stdErrors.New("invalid fraction of the second")
+		}
+	}
+	return nil
+}
+
+func parseTwoDigits(b bytes.Bytes) (int, bool) {
+	if !isDigit(b[0]) || !isDigit(b[1]) {
+		return 0, false
+	}
+	return int(b[0]-'0')*10 + int(b[1]-'0'), true
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
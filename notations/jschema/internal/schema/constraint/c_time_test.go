@@ -0,0 +1,69 @@
+package constraint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+	"github.com/jsightapi/jsight-schema-go-library/internal/json"
+)
+
+func TestTime_IsJsonTypeCompatible(t *testing.T) {
+	testIsJsonTypeCompatible(t, Time{}, json.TypeString)
+}
+
+func TestTime_Type(t *testing.T) {
+	assert.Equal(t, TimeConstraintType, NewTime().Type())
+}
+
+func TestTime_String(t *testing.T) {
+	assert.Equal(t, "time", NewTime().String())
+}
+
+func TestTime_Validate(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		tests := []string{
+			`00:00:00`,
+			`23:59:59`,
+			`23:59:60`,
+			`08:30:00.250`,
+			`"12:00:00"`,
+		}
+
+		for _, value := range tests {
+			t.Run(value, func(t *testing.T) {
+				assert.NotPanics(t, func() {
+					NewTime().Validate(bytes.Bytes(value))
+				})
+			})
+		}
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		tests := map[string]string{
+			"":               "Time parsing error (\"HH:MM:SS\" format expected)",
+			"24:00:00":       "Time parsing error (hour out of range)",
+			"12:60:00":       "Time parsing error (minute out of range)",
+			"12:00:61":       "Time parsing error (second out of range)",
+			"1:02:03":        "Time parsing error (\"HH:MM:SS\" format expected)",
+			"12:00":          "Time parsing error (\"HH:MM:SS\" format expected)",
+			"12:00:00Z":      "Time parsing error (unexpected \"Z\" after the second)",
+			"12:00:00+03:00": "Time parsing error (unexpected \"+03:00\" after the second)",
+			"12:00:00.":      "Time parsing error (invalid fraction of the second)",
+			"12-00-00":       "Time parsing error (\"HH:MM:SS\" format expected)",
+		}
+
+		for given, expected := range tests {
+			t.Run(given, func(t *testing.T) {
+				assert.PanicsWithError(t, expected, func() {
+					NewTime().Validate(bytes.Bytes(given))
+				})
+			})
+		}
+	})
+}
+
+func TestTime_ASTNode(t *testing.T) {
+	assert.Equal(t, newEmptyRuleASTNode(), Time{}.ASTNode())
+}
@@ -0,0 +1,56 @@
+package constraint
+
+import (
+	"encoding/json"
+
+	jschema "github.com/jsightapi/jsight-schema-go-library"
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+	"github.com/jsightapi/jsight-schema-go-library/errors"
+	internalJSON "github.com/jsightapi/jsight-schema-go-library/internal/json"
+)
+
+// Title a short human-readable name of the value. Doesn't affect validation.
+//
+// Example:
+//
+//	"id": 1 // {title: "Identifier"}
+type Title struct {
+	value string
+}
+
+var (
+	_ Constraint = Title{}
+	_ Constraint = (*Title)(nil)
+)
+
+func NewTitle(ruleValue bytes.Bytes) *Title {
+	if !ruleValue.InQuotes() {
+		panic(errors.Format(errors.ErrInvalidValueOfConstraint, TitleConstraintType.String()))
+	}
+
+	c := Title{}
+	if err := json.Unmarshal(ruleValue, &c.value); err != nil {
+		panic(errors.Format(errors.ErrInvalidValueOfConstraint, TitleConstraintType.String()))
+	}
+	return &c
+}
+
+func (Title) IsJsonTypeCompatible(internalJSON.Type) bool {
+	return true
+}
+
+func (Title) Type() Type {
+	return TitleConstraintType
+}
+
+func (c Title) String() string {
+	return TitleConstraintType.String() + ": " + c.value
+}
+
+func (c Title) Value() string {
+	return c.value
+}
+
+func (c Title) ASTNode() jschema.RuleASTNode {
+	return newRuleASTNode(jschema.TokenTypeString, c.value, jschema.RuleASTNodeSourceManual)
+}
@@ -0,0 +1,63 @@
+package constraint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	jschema "github.com/jsightapi/jsight-schema-go-library"
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+)
+
+func TestNewTitle(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		cc := map[string]string{
+			`"foo"`:         "foo",
+			`""`:            "",
+			`"foo \"bar\""`: `foo "bar"`,
+		}
+
+		for given, expected := range cc {
+			t.Run(given, func(t *testing.T) {
+				assert.Equal(t, expected, NewTitle(bytes.Bytes(given)).Value())
+			})
+		}
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		ss := []string{
+			`foo`,
+			`42`,
+			`true`,
+		}
+
+		for _, s := range ss {
+			t.Run(s, func(t *testing.T) {
+				assert.PanicsWithError(t, `Invalid value of "title" constraint`, func() {
+					NewTitle(bytes.Bytes(s))
+				})
+			})
+		}
+	})
+}
+
+func TestTitle_IsJsonTypeCompatible(t *testing.T) {
+	testIsJsonTypeCompatible(t, Title{}, allJSONTypes...)
+}
+
+func TestTitle_Type(t *testing.T) {
+	assert.Equal(t, TitleConstraintType, NewTitle(bytes.Bytes(`"foo"`)).Type())
+}
+
+func TestTitle_String(t *testing.T) {
+	assert.Equal(t, "title: foo", NewTitle(bytes.Bytes(`"foo"`)).String())
+}
+
+func TestTitle_ASTNode(t *testing.T) {
+	assert.Equal(t, jschema.RuleASTNode{
+		TokenType:  jschema.TokenTypeString,
+		Value:      "foo",
+		Properties: &jschema.RuleASTNodes{},
+		Source:     jschema.RuleASTNodeSourceManual,
+	}, NewTitle(bytes.Bytes(`"foo"`)).ASTNode())
+}
@@ -0,0 +1,65 @@
+package constraint
+
+import (
+	"strconv"
+
+	jschema "github.com/jsightapi/jsight-schema-go-library"
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+	"github.com/jsightapi/jsight-schema-go-library/errors"
+	"github.com/jsightapi/jsight-schema-go-library/internal/json"
+)
+
+// Tuple switches the array to the tuple mode, in which each element of the
+// example is applied to the item at the same position. Trailing elements can
+// be marked by the "optional" rule. Items after the last element are handled
+// by the "additionalItems" rule, and are not allowed by default.
+//
+// Example:
+//
+//	[ // {tuple: true}
+//	  55.75, // latitude
+//	  37.62  // longitude
+//	]
+type Tuple struct {
+	value bool
+}
+
+var (
+	_ Constraint = Tuple{}
+	_ Constraint = (*Tuple)(nil)
+	_ BoolKeeper = Tuple{}
+	_ BoolKeeper = (*Tuple)(nil)
+)
+
+func NewTuple(ruleValue bytes.Bytes) *Tuple {
+	c := Tuple{}
+
+	var err error
+	if c.value, err = ruleValue.ParseBool(); err != nil {
+		panic(errors.Format(errors.ErrInvalidValueOfConstraint, TupleConstraintType.String()))
+	}
+	return &c
+}
+
+func (Tuple) IsJsonTypeCompatible(t json.Type) bool {
+	return t == json.TypeArray
+}
+
+func (Tuple) Type() Type {
+	return TupleConstraintType
+}
+
+func (c Tuple) String() string {
+	if c.value {
+		return TupleConstraintType.String() + ": true"
+	}
+	return TupleConstraintType.String() + ": false"
+}
+
+func (c Tuple) Bool() bool {
+	return c.value
+}
+
+func (c Tuple) ASTNode() jschema.RuleASTNode {
+	return newRuleASTNode(jschema.TokenTypeBoolean, strconv.FormatBool(c.value), jschema.RuleASTNodeSourceManual)
+}
@@ -0,0 +1,83 @@
+package constraint
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	jschema "github.com/jsightapi/jsight-schema-go-library"
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+	"github.com/jsightapi/jsight-schema-go-library/internal/json"
+)
+
+func TestNewTuple(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		cc := map[string]bool{
+			"true":  true,
+			"false": false,
+		}
+
+		for given, expected := range cc {
+			t.Run(given, func(t *testing.T) {
+				c := NewTuple([]byte(given))
+				assert.Equal(t, expected, c.value)
+			})
+		}
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		assert.PanicsWithError(t, `Invalid value of "tuple" constraint`, func() {
+			NewTuple([]byte("foo"))
+		})
+	})
+}
+
+func TestTuple_IsJsonTypeCompatible(t *testing.T) {
+	testIsJsonTypeCompatible(t, Tuple{}, json.TypeArray)
+}
+
+func TestTuple_Type(t *testing.T) {
+	assert.Equal(t, TupleConstraintType, NewTuple(bytes.Bytes("true")).Type())
+}
+
+func TestTuple_String(t *testing.T) {
+	cc := map[string]string{
+		"false": "tuple: false",
+		"true":  "tuple: true",
+	}
+
+	for given, expected := range cc {
+		t.Run(given, func(t *testing.T) {
+			assert.Equal(t, expected, NewTuple([]byte(given)).String())
+		})
+	}
+}
+
+func TestTuple_Bool(t *testing.T) {
+	cc := map[string]bool{
+		"false": false,
+		"true":  true,
+	}
+
+	for given, expected := range cc {
+		t.Run(given, func(t *testing.T) {
+			assert.Equal(t, expected, NewTuple([]byte(given)).Bool())
+		})
+	}
+}
+
+func TestTuple_ASTNode(t *testing.T) {
+	cc := []bool{true, false}
+
+	for _, c := range cc {
+		t.Run(strconv.FormatBool(c), func(t *testing.T) {
+			assert.Equal(t, jschema.RuleASTNode{
+				TokenType:  jschema.TokenTypeBoolean,
+				Value:      strconv.FormatBool(c),
+				Properties: &jschema.RuleASTNodes{},
+				Source:     jschema.RuleASTNodeSourceManual,
+			}, Tuple{value: c}.ASTNode())
+		})
+	}
+}
@@ -66,6 +66,11 @@ func (c TypesList) Names() []string {
 	return c.innerTypeNames
 }
 
+// TypeNames returns real type names, used in messages and AST nodes.
+func (c TypesList) TypeNames() []string {
+	return c.typeNames
+}
+
 func (c TypesList) Len() int {
 	return len(c.innerTypeNames)
 }
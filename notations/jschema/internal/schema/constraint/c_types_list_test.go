@@ -82,6 +82,14 @@ func TestTypesList_Names(t *testing.T) {
 	assert.Equal(t, []string{"foo", "bar"}, c.Names())
 }
 
+func TestTypesList_TypeNames(t *testing.T) {
+	c := NewTypesList(jschema.RuleASTNodeSourceManual)
+	c.AddName("#0x1", "string", jschema.RuleASTNodeSourceManual)
+	c.AddName("@foo", "@foo", jschema.RuleASTNodeSourceManual)
+
+	assert.Equal(t, []string{"string", "@foo"}, c.TypeNames())
+}
+
 func TestTypesList_Len(t *testing.T) {
 	c := NewTypesList(jschema.RuleASTNodeSourceManual)
 	c.innerTypeNames = []string{"foo", "bar"}
@@ -0,0 +1,73 @@
+package constraint
+
+import (
+	"encoding/json"
+
+	"golang.org/x/text/unicode/norm"
+
+	jschema "github.com/jsightapi/jsight-schema-go-library"
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+	"github.com/jsightapi/jsight-schema-go-library/errors"
+	internalJSON "github.com/jsightapi/jsight-schema-go-library/internal/json"
+)
+
+// UnicodeNormalization makes the "const" and "enum" rules to compare strings
+// after the normalization to one of the Unicode normalization forms: "NFC",
+// "NFD", "NFKC" or "NFKD".
+//
+// Example:
+//
+//	"café" // {const: true, unicodeNormalization: "NFC"}
+type UnicodeNormalization struct {
+	name string
+	form norm.Form
+}
+
+var (
+	_ Constraint = UnicodeNormalization{}
+	_ Constraint = (*UnicodeNormalization)(nil)
+)
+
+var normalizationForms = map[string]norm.Form{
+	"NFC":  norm.NFC,
+	"NFD":  norm.NFD,
+	"NFKC": norm.NFKC,
+	"NFKD": norm.NFKD,
+}
+
+func NewUnicodeNormalization(ruleValue bytes.Bytes) *UnicodeNormalization {
+	if !ruleValue.InQuotes() {
+		panic(errors.Format(errors.ErrInvalidValueOfConstraint, UnicodeNormalizationConstraintType.String()))
+	}
+
+	c := UnicodeNormalization{}
+	if err := json.Unmarshal(ruleValue, &c.name); err != nil {
+		panic(err)
+	}
+
+	var ok bool
+	if c.form, ok = normalizationForms[c.name]; !ok {
+		panic(errors.Format(errors.ErrInvalidValueOfConstraint, UnicodeNormalizationConstraintType.String()))
+	}
+	return &c
+}
+
+func (UnicodeNormalization) IsJsonTypeCompatible(t internalJSON.Type) bool {
+	return t.IsLiteralType()
+}
+
+func (UnicodeNormalization) Type() Type {
+	return UnicodeNormalizationConstraintType
+}
+
+func (c UnicodeNormalization) String() string {
+	return UnicodeNormalizationConstraintType.String() + ": " + c.name
+}
+
+func (c UnicodeNormalization) Form() norm.Form {
+	return c.form
+}
+
+func (c UnicodeNormalization) ASTNode() jschema.RuleASTNode {
+	return newRuleASTNode(jschema.TokenTypeString, c.name, jschema.RuleASTNodeSourceManual)
+}
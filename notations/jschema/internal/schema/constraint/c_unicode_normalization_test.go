@@ -0,0 +1,76 @@
+package constraint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/unicode/norm"
+
+	jschema "github.com/jsightapi/jsight-schema-go-library"
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+	"github.com/jsightapi/jsight-schema-go-library/internal/json"
+)
+
+func TestNewUnicodeNormalization(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		cc := map[string]norm.Form{
+			`"NFC"`:  norm.NFC,
+			`"NFD"`:  norm.NFD,
+			`"NFKC"`: norm.NFKC,
+			`"NFKD"`: norm.NFKD,
+		}
+
+		for given, expected := range cc {
+			t.Run(given, func(t *testing.T) {
+				assert.Equal(t, expected, NewUnicodeNormalization(bytes.Bytes(given)).Form())
+			})
+		}
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		ss := []string{
+			`NFC`,
+			`""`,
+			`"nfc"`,
+			`true`,
+		}
+
+		for _, s := range ss {
+			t.Run(s, func(t *testing.T) {
+				assert.PanicsWithError(t, `Invalid value of "unicodeNormalization" constraint`, func() {
+					NewUnicodeNormalization(bytes.Bytes(s))
+				})
+			})
+		}
+	})
+}
+
+func TestUnicodeNormalization_IsJsonTypeCompatible(t *testing.T) {
+	testIsJsonTypeCompatible(
+		t,
+		UnicodeNormalization{},
+		json.TypeString,
+		json.TypeInteger,
+		json.TypeFloat,
+		json.TypeBoolean,
+		json.TypeNull,
+		json.TypeMixed,
+	)
+}
+
+func TestUnicodeNormalization_Type(t *testing.T) {
+	assert.Equal(t, UnicodeNormalizationConstraintType, NewUnicodeNormalization(bytes.Bytes(`"NFC"`)).Type())
+}
+
+func TestUnicodeNormalization_String(t *testing.T) {
+	assert.Equal(t, "unicodeNormalization: NFKD", NewUnicodeNormalization(bytes.Bytes(`"NFKD"`)).String())
+}
+
+func TestUnicodeNormalization_ASTNode(t *testing.T) {
+	assert.Equal(t, jschema.RuleASTNode{
+		TokenType:  jschema.TokenTypeString,
+		Value:      "NFC",
+		Properties: &jschema.RuleASTNodes{},
+		Source:     jschema.RuleASTNodeSourceManual,
+	}, NewUnicodeNormalization(bytes.Bytes(`"NFC"`)).ASTNode())
+}
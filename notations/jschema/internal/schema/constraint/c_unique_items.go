@@ -0,0 +1,163 @@
+package constraint
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	jschema "github.com/jsightapi/jsight-schema-go-library"
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+	"github.com/jsightapi/jsight-schema-go-library/errors"
+	internalJSON "github.com/jsightapi/jsight-schema-go-library/internal/json"
+)
+
+// UniqueItems requires all array items to be unique in terms of JSON equality.
+// In the key-path form (e.g. `{uniqueItems: "id"}`, or `"user.id"` for nested
+// properties) only values of the specified property of object items should be
+// unique. Items which aren't objects or don't have the property are ignored.
+type UniqueItems struct {
+	// keyPath a path to the property which should be unique. Nil if items
+	// should be unique as a whole.
+	keyPath []string
+
+	// key the raw value of the key-path form.
+	key string
+
+	value bool
+}
+
+var (
+	_ Constraint  = UniqueItems{}
+	_ Constraint  = (*UniqueItems)(nil)
+	_ BoolKeeper  = UniqueItems{}
+	_ BoolKeeper  = (*UniqueItems)(nil)
+	_ BytesKeeper = UniqueItems{}
+	_ BytesKeeper = (*UniqueItems)(nil)
+)
+
+func NewUniqueItems(ruleValue bytes.Bytes) *UniqueItems {
+	c := UniqueItems{}
+
+	if ruleValue.InQuotes() {
+		if err := json.Unmarshal(ruleValue, &c.key); err != nil || c.key == "" {
+			panic(errors.Format(errors.ErrInvalidValueOfConstraint, UniqueItemsConstraintType.String()))
+		}
+		c.keyPath = strings.Split(c.key, ".")
+		c.value = true
+		return &c
+	}
+
+	var err error
+	if c.value, err = ruleValue.ParseBool(); err != nil {
+		panic(errors.Format(errors.ErrInvalidValueOfConstraint, UniqueItemsConstraintType.String()))
+	}
+	return &c
+}
+
+func (UniqueItems) IsJsonTypeCompatible(t internalJSON.Type) bool {
+	return t == internalJSON.TypeArray
+}
+
+func (UniqueItems) Type() Type {
+	return UniqueItemsConstraintType
+}
+
+func (c UniqueItems) String() string {
+	if c.keyPath != nil {
+		return UniqueItemsConstraintType.String() + ": " + c.key
+	}
+	return UniqueItemsConstraintType.String() + ": " + strconv.FormatBool(c.value)
+}
+
+func (c UniqueItems) Bool() bool {
+	return c.value
+}
+
+// Bytes returns the key path, or nil if items should be unique as a whole.
+func (c UniqueItems) Bytes() bytes.Bytes {
+	if c.keyPath == nil {
+		return nil
+	}
+	return bytes.Bytes(c.key)
+}
+
+// NewSet creates a set for checking items of a single array. Returns nil if
+// the constraint is disabled.
+func (c *UniqueItems) NewSet() *UniqueItemsSet {
+	if !c.value {
+		return nil
+	}
+	return &UniqueItemsSet{
+		constraint: c,
+		seen:       map[string]uint{},
+	}
+}
+
+func (c UniqueItems) ASTNode() jschema.RuleASTNode {
+	if c.keyPath != nil {
+		return newRuleASTNode(jschema.TokenTypeString, c.key, jschema.RuleASTNodeSourceManual)
+	}
+	return newRuleASTNode(jschema.TokenTypeBoolean, strconv.FormatBool(c.value), jschema.RuleASTNodeSourceManual)
+}
+
+// UniqueItemsSet collects items of a single array and checks them for
+// uniqueness.
+type UniqueItemsSet struct {
+	constraint *UniqueItems
+
+	// seen maps the canonical representation of the item (or its property) to
+	// the index of the item.
+	seen map[string]uint
+
+	// count the number of added items.
+	count uint
+}
+
+// Add adds the next array item. Panic if the same item was already added.
+func (s *UniqueItemsSet) Add(item bytes.Bytes) {
+	index := s.count
+	s.count++
+
+	v, err := internalJSON.Decode(item)
+	if err != nil {
+		panic(err)
+	}
+
+	v, ok := s.lookup(v)
+	if !ok {
+		return
+	}
+
+	key, err := internalJSON.CanonicalOf(v)
+	if err != nil {
+		panic(err)
+	}
+
+	if i, ok := s.seen[key]; ok {
+		if s.constraint.keyPath != nil {
+			panic(errors.Format(errors.ErrConstraintUniqueItemsKeyValidation, s.constraint.key, strconv.FormatUint(uint64(i), 10)))
+		}
+		panic(errors.Format(errors.ErrConstraintUniqueItemsValidation, strconv.FormatUint(uint64(i), 10)))
+	}
+	s.seen[key] = index
+}
+
+// Skip skips the next array item which can't be checked.
+func (s *UniqueItemsSet) Skip() {
+	s.count++
+}
+
+// lookup returns the value which should be unique.
+func (s *UniqueItemsSet) lookup(v interface{}) (interface{}, bool) {
+	for _, k := range s.constraint.keyPath {
+		obj, ok := v.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+
+		if v, ok = obj[k]; !ok {
+			return nil, false
+		}
+	}
+	return v, true
+}
@@ -0,0 +1,159 @@
+package constraint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	jschema "github.com/jsightapi/jsight-schema-go-library"
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+	"github.com/jsightapi/jsight-schema-go-library/internal/json"
+)
+
+func TestNewUniqueItems(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		t.Run("bool", func(t *testing.T) {
+			c := NewUniqueItems(bytes.Bytes("true"))
+			assert.True(t, c.value)
+			assert.Nil(t, c.keyPath)
+		})
+
+		t.Run("key path", func(t *testing.T) {
+			c := NewUniqueItems(bytes.Bytes(`"user.id"`))
+			assert.True(t, c.value)
+			assert.Equal(t, []string{"user", "id"}, c.keyPath)
+			assert.Equal(t, "user.id", c.key)
+		})
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		ss := []string{
+			"1",
+			`""`,
+			"null",
+		}
+
+		for _, s := range ss {
+			t.Run(s, func(t *testing.T) {
+				assert.PanicsWithError(t, `Invalid value of "uniqueItems" constraint`, func() {
+					NewUniqueItems(bytes.Bytes(s))
+				})
+			})
+		}
+	})
+}
+
+func TestUniqueItems_IsJsonTypeCompatible(t *testing.T) {
+	testIsJsonTypeCompatible(t, UniqueItems{}, json.TypeArray)
+}
+
+func TestUniqueItems_Type(t *testing.T) {
+	assert.Equal(t, UniqueItemsConstraintType, NewUniqueItems(bytes.Bytes("true")).Type())
+}
+
+func TestUniqueItems_String(t *testing.T) {
+	cc := map[string]string{
+		"true":  "uniqueItems: true",
+		"false": "uniqueItems: false",
+		`"a.b"`: "uniqueItems: a.b",
+	}
+
+	for given, expected := range cc {
+		t.Run(given, func(t *testing.T) {
+			assert.Equal(t, expected, NewUniqueItems(bytes.Bytes(given)).String())
+		})
+	}
+}
+
+func TestUniqueItems_NewSet(t *testing.T) {
+	t.Run("disabled", func(t *testing.T) {
+		assert.Nil(t, NewUniqueItems(bytes.Bytes("false")).NewSet())
+	})
+
+	t.Run("positive", func(t *testing.T) {
+		cc := map[string][]string{
+			"true":   {"1", "1.5", `"1"`, "true", "null", `{"a": 1}`, `{"a": 1, "b": 2}`, "[1, 2]", "[2, 1]"},
+			`"id"`:   {`{"id": 1}`, `{"id": 2}`, `{"name": 1}`, `{"name": 1}`, "1", "1"},
+			`"a.id"`: {`{"a": {"id": 1}}`, `{"a": {"id": "1"}}`, `{"a": 1}`, `{"id": 1}`},
+		}
+
+		for rule, items := range cc {
+			t.Run(rule, func(t *testing.T) {
+				set := NewUniqueItems(bytes.Bytes(rule)).NewSet()
+				assert.NotPanics(t, func() {
+					for _, item := range items {
+						set.Add(bytes.Bytes(item))
+					}
+				})
+			})
+		}
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		cc := []struct {
+			rule     string
+			items    []string
+			expected string
+		}{
+			{
+				"true",
+				[]string{"1", "2", "2.0"},
+				"Array items should be unique, but the item duplicates the item at index 1",
+			},
+			{
+				"true",
+				[]string{"100", "1e2"},
+				"Array items should be unique, but the item duplicates the item at index 0",
+			},
+			{
+				"true",
+				[]string{`"foo"`, `"foo"`},
+				"Array items should be unique, but the item duplicates the item at index 0",
+			},
+			{
+				"true",
+				[]string{`{"a": 1, "b": [1, {"c": 2}]}`, `{"b": [1, {"c": 2.0}], "a": 1}`},
+				"Array items should be unique, but the item duplicates the item at index 0",
+			},
+			{
+				`"id"`,
+				[]string{`{"id": 1}`, `{"id": 2}`, `{"id": 1, "name": "foo"}`},
+				`The "id" property of array items should be unique, but the item duplicates the item at index 0`,
+			},
+		}
+
+		for _, c := range cc {
+			t.Run(c.expected, func(t *testing.T) {
+				set := NewUniqueItems(bytes.Bytes(c.rule)).NewSet()
+				last := len(c.items) - 1
+				for _, item := range c.items[:last] {
+					set.Add(bytes.Bytes(item))
+				}
+
+				assert.PanicsWithError(t, c.expected, func() {
+					set.Add(bytes.Bytes(c.items[last]))
+				})
+			})
+		}
+	})
+}
+
+func TestUniqueItems_ASTNode(t *testing.T) {
+	t.Run("bool", func(t *testing.T) {
+		assert.Equal(t, jschema.RuleASTNode{
+			TokenType:  jschema.TokenTypeBoolean,
+			Value:      "true",
+			Properties: &jschema.RuleASTNodes{},
+			Source:     jschema.RuleASTNodeSourceManual,
+		}, NewUniqueItems(bytes.Bytes("true")).ASTNode())
+	})
+
+	t.Run("key path", func(t *testing.T) {
+		assert.Equal(t, jschema.RuleASTNode{
+			TokenType:  jschema.TokenTypeString,
+			Value:      "id",
+			Properties: &jschema.RuleASTNodes{},
+			Source:     jschema.RuleASTNodeSourceManual,
+		}, NewUniqueItems(bytes.Bytes(`"id"`)).ASTNode())
+	})
+}
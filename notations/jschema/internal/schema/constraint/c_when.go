@@ -0,0 +1,133 @@
+package constraint
+
+import (
+	"strings"
+
+	jschema "github.com/jsightapi/jsight-schema-go-library"
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+	"github.com/jsightapi/jsight-schema-go-library/internal/json"
+)
+
+// When applies the user type to the object when the key of this object has
+// the specified value.
+//
+// Handle next cases:
+//
+//	{when: {key: "type", const: "card", then: "@card"}} - the object should
+//	  match the "@card" type when the "type" key is equal to "card".
+//	{when: [{key: "type", const: "card", then: "@card"}, ...]} - a list of
+//	  conditions.
+type When struct {
+	conditions []WhenCondition
+
+	// isList true if conditions are declared by the list.
+	isList bool
+}
+
+// WhenCondition a single condition of the "when" rule.
+type WhenCondition struct {
+	// Key the name of the object key.
+	Key string
+
+	// Const the JSON value of the key which triggers the condition.
+	Const bytes.Bytes
+
+	// Then the name of the user type which the object should match.
+	Then string
+}
+
+var (
+	_ Constraint = When{}
+	_ Constraint = (*When)(nil)
+)
+
+func NewWhen() *When {
+	return &When{}
+}
+
+func (When) IsJsonTypeCompatible(t json.Type) bool {
+	return t == json.TypeObject
+}
+
+func (When) Type() Type {
+	return WhenConstraintType
+}
+
+func (c When) String() string {
+	var str strings.Builder
+	str.WriteString(WhenConstraintType.String())
+	str.WriteString(": ")
+	for i, cond := range c.conditions {
+		if i > 0 {
+			str.WriteString(", ")
+		}
+		str.WriteString(cond.String())
+	}
+	return str.String()
+}
+
+func (c *When) Append(cond WhenCondition) {
+	c.conditions = append(c.conditions, cond)
+}
+
+// SetIsList marks the rule value as a list of conditions.
+func (c *When) SetIsList() {
+	c.isList = true
+}
+
+// IsList returns true if conditions are declared by the list.
+func (c When) IsList() bool {
+	return c.isList
+}
+
+func (c When) Conditions() []WhenCondition {
+	return c.conditions
+}
+
+func (c When) ASTNode() jschema.RuleASTNode {
+	if !c.isList && len(c.conditions) == 1 {
+		return c.conditions[0].ASTNode()
+	}
+
+	n := newRuleASTNode(jschema.TokenTypeArray, "", jschema.RuleASTNodeSourceManual)
+	n.Items = make([]jschema.RuleASTNode, 0, len(c.conditions))
+	for _, cond := range c.conditions {
+		n.Items = append(n.Items, cond.ASTNode())
+	}
+	return n
+}
+
+// Match returns true if the JSON value of the key triggers the condition.
+// Values are compared by their JSON meaning, so 1 is equal to 1.0.
+func (c WhenCondition) Match(value bytes.Bytes) bool {
+	expected, err := json.Canonical(c.Const)
+	if err != nil {
+		return false
+	}
+
+	actual, err := json.Canonical(value)
+	if err != nil {
+		return false
+	}
+
+	return expected == actual
+}
+
+func (c WhenCondition) String() string {
+	return c.Key + " = " + c.Const.String() + " -> " + c.Then
+}
+
+func (c WhenCondition) ASTNode() jschema.RuleASTNode {
+	const source = jschema.RuleASTNodeSourceManual
+
+	n := newRuleASTNode(jschema.TokenTypeObject, "", source)
+	n.Properties = jschema.MakeRuleASTNodes(3)
+	n.Properties.Set("key", newRuleASTNode(jschema.TokenTypeString, c.Key, source))
+	n.Properties.Set("const", newRuleASTNode(
+		json.Guess(c.Const).LiteralJsonType().ToTokenType(),
+		c.Const.Unquote().String(),
+		source,
+	))
+	n.Properties.Set("then", newRuleASTNode(jschema.TokenTypeShortcut, c.Then, source))
+	return n
+}
@@ -0,0 +1,104 @@
+package constraint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	jschema "github.com/jsightapi/jsight-schema-go-library"
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+	"github.com/jsightapi/jsight-schema-go-library/internal/json"
+)
+
+func TestWhen_IsJsonTypeCompatible(t *testing.T) {
+	testIsJsonTypeCompatible(t, When{}, json.TypeObject)
+}
+
+func TestWhen_Type(t *testing.T) {
+	assert.Equal(t, WhenConstraintType, NewWhen().Type())
+}
+
+func TestWhen_String(t *testing.T) {
+	c := NewWhen()
+	c.Append(WhenCondition{Key: "type", Const: bytes.Bytes(`"card"`), Then: "@card"})
+	c.Append(WhenCondition{Key: "type", Const: bytes.Bytes(`"bank"`), Then: "@bank"})
+
+	assert.Equal(t, `when: type = "card" -> @card, type = "bank" -> @bank`, c.String())
+}
+
+func TestWhenCondition_Match(t *testing.T) {
+	cc := map[string]struct {
+		given    string
+		value    string
+		expected bool
+	}{
+		"same string":      {`"card"`, `"card"`, true},
+		"escaped string":   {`"card"`, `"\u0063ard"`, true},
+		"other string":     {`"card"`, `"bank"`, false},
+		"same number":      {`1`, `1.0`, true},
+		"other number":     {`1`, `2`, false},
+		"string vs number": {`"1"`, `1`, false},
+		"null":             {`null`, `null`, true},
+		"object value":     {`true`, `{"a": true}`, false},
+	}
+
+	for name, c := range cc {
+		t.Run(name, func(t *testing.T) {
+			cond := WhenCondition{Key: "k", Const: bytes.Bytes(c.given), Then: "@t"}
+			assert.Equal(t, c.expected, cond.Match(bytes.Bytes(c.value)))
+		})
+	}
+}
+
+func TestWhen_ASTNode(t *testing.T) {
+	const source = jschema.RuleASTNodeSourceManual
+
+	condition := WhenCondition{Key: "type", Const: bytes.Bytes(`"card"`), Then: "@card"}
+	conditionAST := jschema.RuleASTNode{
+		TokenType: jschema.TokenTypeObject,
+		Properties: jschema.NewRuleASTNodes(
+			map[string]jschema.RuleASTNode{
+				"key": {
+					TokenType:  jschema.TokenTypeString,
+					Value:      "type",
+					Properties: &jschema.RuleASTNodes{},
+					Source:     source,
+				},
+				"const": {
+					TokenType:  jschema.TokenTypeString,
+					Value:      "card",
+					Properties: &jschema.RuleASTNodes{},
+					Source:     source,
+				},
+				"then": {
+					TokenType:  jschema.TokenTypeShortcut,
+					Value:      "@card",
+					Properties: &jschema.RuleASTNodes{},
+					Source:     source,
+				},
+			},
+			[]string{"key", "const", "then"},
+		),
+		Source: source,
+	}
+
+	t.Run("single condition", func(t *testing.T) {
+		c := NewWhen()
+		c.Append(condition)
+
+		assert.Equal(t, conditionAST, c.ASTNode())
+	})
+
+	t.Run("list of conditions", func(t *testing.T) {
+		c := NewWhen()
+		c.SetIsList()
+		c.Append(condition)
+
+		assert.Equal(t, jschema.RuleASTNode{
+			TokenType:  jschema.TokenTypeArray,
+			Properties: &jschema.RuleASTNodes{},
+			Items:      []jschema.RuleASTNode{conditionAST},
+			Source:     source,
+		}, c.ASTNode())
+	})
+}
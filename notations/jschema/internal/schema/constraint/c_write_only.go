@@ -0,0 +1,61 @@
+package constraint
+
+import (
+	"strconv"
+
+	jschema "github.com/jsightapi/jsight-schema-go-library"
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+	"github.com/jsightapi/jsight-schema-go-library/errors"
+	"github.com/jsightapi/jsight-schema-go-library/internal/json"
+)
+
+// WriteOnly marks the value which is never returned by the server. Doesn't
+// affect validation, but object keys marked by this rule are rejected in the
+// response mode.
+//
+// Example:
+//
+//	"password": "secret" // {writeOnly: true}
+type WriteOnly struct {
+	value bool
+}
+
+var (
+	_ Constraint = WriteOnly{}
+	_ Constraint = (*WriteOnly)(nil)
+	_ BoolKeeper = WriteOnly{}
+	_ BoolKeeper = (*WriteOnly)(nil)
+)
+
+func NewWriteOnly(ruleValue bytes.Bytes) *WriteOnly {
+	c := WriteOnly{}
+
+	var err error
+	if c.value, err = ruleValue.ParseBool(); err != nil {
+		panic(errors.Format(errors.ErrInvalidValueOfConstraint, WriteOnlyConstraintType.String()))
+	}
+	return &c
+}
+
+func (WriteOnly) IsJsonTypeCompatible(json.Type) bool {
+	return true
+}
+
+func (WriteOnly) Type() Type {
+	return WriteOnlyConstraintType
+}
+
+func (c WriteOnly) String() string {
+	if c.value {
+		return WriteOnlyConstraintType.String() + ": true"
+	}
+	return WriteOnlyConstraintType.String() + ": false"
+}
+
+func (c WriteOnly) Bool() bool {
+	return c.value
+}
+
+func (c WriteOnly) ASTNode() jschema.RuleASTNode {
+	return newRuleASTNode(jschema.TokenTypeBoolean, strconv.FormatBool(c.value), jschema.RuleASTNodeSourceManual)
+}
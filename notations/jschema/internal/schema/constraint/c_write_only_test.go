@@ -0,0 +1,82 @@
+package constraint
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	jschema "github.com/jsightapi/jsight-schema-go-library"
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+)
+
+func TestNewWriteOnly(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		cc := map[string]bool{
+			"true":  true,
+			"false": false,
+		}
+
+		for given, expected := range cc {
+			t.Run(given, func(t *testing.T) {
+				c := NewWriteOnly([]byte(given))
+				assert.Equal(t, expected, c.value)
+			})
+		}
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		assert.PanicsWithError(t, `Invalid value of "writeOnly" constraint`, func() {
+			NewWriteOnly([]byte("foo"))
+		})
+	})
+}
+
+func TestWriteOnly_IsJsonTypeCompatible(t *testing.T) {
+	testIsJsonTypeCompatible(t, WriteOnly{}, allJSONTypes...)
+}
+
+func TestWriteOnly_Type(t *testing.T) {
+	assert.Equal(t, WriteOnlyConstraintType, NewWriteOnly(bytes.Bytes("true")).Type())
+}
+
+func TestWriteOnly_String(t *testing.T) {
+	cc := map[string]string{
+		"false": "writeOnly: false",
+		"true":  "writeOnly: true",
+	}
+
+	for given, expected := range cc {
+		t.Run(given, func(t *testing.T) {
+			assert.Equal(t, expected, NewWriteOnly([]byte(given)).String())
+		})
+	}
+}
+
+func TestWriteOnly_Bool(t *testing.T) {
+	cc := map[string]bool{
+		"false": false,
+		"true":  true,
+	}
+
+	for given, expected := range cc {
+		t.Run(given, func(t *testing.T) {
+			assert.Equal(t, expected, NewWriteOnly([]byte(given)).Bool())
+		})
+	}
+}
+
+func TestWriteOnly_ASTNode(t *testing.T) {
+	cc := []bool{true, false}
+
+	for _, c := range cc {
+		t.Run(strconv.FormatBool(c), func(t *testing.T) {
+			assert.Equal(t, jschema.RuleASTNode{
+				TokenType:  jschema.TokenTypeBoolean,
+				Value:      strconv.FormatBool(c),
+				Properties: &jschema.RuleASTNodes{},
+				Source:     jschema.RuleASTNodeSourceManual,
+			}, WriteOnly{value: c}.ASTNode())
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package constraint
+
+import (
+	"strconv"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/unicode/norm"
+
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+	"github.com/jsightapi/jsight-schema-go-library/internal/json"
+)
+
+// Comparison defines how the "const" and "enum" rules compare JSON values.
+// Numbers are compared by value (1, 1.0 and 1e0 are equal) and strings are
+// compared after decoding of escape sequences. Optionally, strings can be
+// normalized to one of the Unicode normalization forms and compared regardless
+// of case.
+type Comparison struct {
+	form            norm.Form
+	normalize       bool
+	caseInsensitive bool
+}
+
+// SetNormalization makes strings to be compared after the normalization to
+// specified Unicode normalization form.
+func (c *Comparison) SetNormalization(f norm.Form) {
+	c.form = f
+	c.normalize = true
+}
+
+// SetCaseInsensitive makes strings to be compared regardless of case.
+func (c *Comparison) SetCaseInsensitive(v bool) {
+	c.caseInsensitive = v
+}
+
+// Key returns the representation of the JSON literal, which is the same for all
+// values equal in terms of this comparison.
+func (c Comparison) Key(b bytes.Bytes) string {
+	b = b.TrimSpaces()
+
+	if !b.InQuotes() {
+		if k, err := json.Canonical(b); err == nil {
+			return k
+		}
+		return b.String()
+	}
+
+	s := b.Unquote().String()
+	if c.normalize {
+		s = c.form.String(s)
+	}
+	if c.caseInsensitive {
+		s = cases.Fold().String(s)
+	}
+	return strconv.Quote(s)
+}
+
+// Equal returns true if JSON literals are equal in terms of this comparison.
+func (c Comparison) Equal(a, b bytes.Bytes) bool {
+	return c.Key(a) == c.Key(b)
+}
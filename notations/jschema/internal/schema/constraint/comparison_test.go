@@ -0,0 +1,55 @@
+package constraint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/text/unicode/norm"
+
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+)
+
+func TestComparison_Equal(t *testing.T) {
+	newComparison := func(normalize, caseInsensitive bool) Comparison {
+		var c Comparison
+		if normalize {
+			c.SetNormalization(norm.NFC)
+		}
+		c.SetCaseInsensitive(caseInsensitive)
+		return c
+	}
+
+	cc := []struct {
+		a, b            string
+		normalize       bool
+		caseInsensitive bool
+		expected        bool
+	}{
+		{a: `1`, b: `1.0`, expected: true},
+		{a: `1`, b: `1e0`, expected: true},
+		{a: `-0`, b: `0`, expected: true},
+		{a: `0.1`, b: `1e-1`, expected: true},
+		{a: `1`, b: `1.01`, expected: false},
+		{a: `1`, b: `"1"`, expected: false},
+		{a: `true`, b: `true`, expected: true},
+		{a: `true`, b: `false`, expected: false},
+		{a: `null`, b: `null`, expected: true},
+		{a: `foo`, b: `foo`, expected: true},
+		{a: `foo`, b: `bar`, expected: false},
+		{a: `"abc"`, b: `"abc"`, expected: true},
+		{a: `"abc"`, b: `"\u0061bc"`, expected: true},
+		{a: `"\u00E9"`, b: `"e\u0301"`, expected: false},
+		{a: `"\u00E9"`, b: `"e\u0301"`, normalize: true, expected: true},
+		{a: `"ABC"`, b: `"abc"`, expected: false},
+		{a: `"ABC"`, b: `"abc"`, caseInsensitive: true, expected: true},
+		{a: `"\u00C9"`, b: `"e\u0301"`, normalize: true, caseInsensitive: true, expected: true},
+		{a: `"null"`, b: `null`, caseInsensitive: true, expected: false},
+	}
+
+	for _, c := range cc {
+		t.Run(c.a+" "+c.b, func(t *testing.T) {
+			cmp := newComparison(c.normalize, c.caseInsensitive)
+			assert.Equal(t, c.expected, cmp.Equal(bytes.Bytes(c.a), bytes.Bytes(c.b)))
+		})
+	}
+}
@@ -32,6 +32,11 @@ type ArrayValidator interface {
 	Value() uint
 }
 
+type ObjectValidator interface {
+	ValidateTheObject(numberOfProperties uint)
+	Value() uint
+}
+
 type BytesKeeper interface {
 	Bytes() bytes.Bytes
 }
@@ -53,6 +58,8 @@ func NewConstraintFromRule( //nolint:gocyclo // For now it's okay.
 		return NewMinLength(ruleValue)
 	case "maxLength":
 		return NewMaxLength(ruleValue)
+	case "lengthUnit":
+		return NewLengthUnit(ruleValue)
 	case "min":
 		return NewMin(ruleValue)
 	case "max":
@@ -61,6 +68,8 @@ func NewConstraintFromRule( //nolint:gocyclo // For now it's okay.
 		return NewExclusiveMinimum(ruleValue)
 	case "exclusiveMaximum":
 		return NewExclusiveMaximum(ruleValue)
+	case "multipleOf":
+		return NewMultipleOf(ruleValue)
 	case "type":
 		return NewType(ruleValue, jschema.RuleASTNodeSourceManual)
 	case "precision":
@@ -71,6 +80,22 @@ func NewConstraintFromRule( //nolint:gocyclo // For now it's okay.
 		return NewMinItems(ruleValue)
 	case "maxItems":
 		return NewMaxItems(ruleValue)
+	case "uniqueItems":
+		return NewUniqueItems(ruleValue)
+	case "tuple":
+		return NewTuple(ruleValue)
+	case "additionalItems":
+		return NewAdditionalItems(ruleValue)
+	case "minContains":
+		return NewMinContains(ruleValue)
+	case "maxContains":
+		return NewMaxContains(ruleValue)
+	case "minProperties":
+		return NewMinProperties(ruleValue)
+	case "maxProperties":
+		return NewMaxProperties(ruleValue)
+	case "propertyNames":
+		return NewPropertyNames(ruleValue)
 	case "additionalProperties":
 		return NewAdditionalProperties(ruleValue)
 	case "nullable":
@@ -79,6 +104,29 @@ func NewConstraintFromRule( //nolint:gocyclo // For now it's okay.
 		return NewRegex(ruleValue)
 	case "const":
 		return NewConst(ruleValue, nodeValue)
+	case "caseInsensitive":
+		return NewCaseInsensitive(ruleValue)
+	case "unicodeNormalization":
+		return NewUnicodeNormalization(ruleValue)
+	case "discriminator":
+		return NewDiscriminator(ruleValue)
+	case "exclusive":
+		return NewExclusive(ruleValue)
+	case "deprecated":
+		return NewDeprecated(ruleValue)
+	case "readOnly":
+		return NewReadOnly(ruleValue)
+	case "writeOnly":
+		return NewWriteOnly(ruleValue)
+	case "title":
+		return NewTitle(ruleValue)
+	case "description":
+		return NewDescription(ruleValue)
+	case "default":
+		return NewDefault(ruleValue)
 	}
-	panic(lexeme.NewLexEventError(ruleNameLex, errors.Format(errors.ErrUnknownRule, str)))
+	panic(lexeme.NewLexEventError(
+		ruleNameLex,
+		errors.Format(errors.ErrUnknownRule, str).WithSuggestion(errors.Suggest(str, jschema.BuiltinRuleNames())),
+	))
 }
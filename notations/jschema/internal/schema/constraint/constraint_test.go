@@ -18,6 +18,7 @@ func TestNewConstraintFromRule(t *testing.T) {
 		}{
 			"minLength":            {"1", &MinLength{}},
 			"maxLength":            {"1", &MaxLength{}},
+			"lengthUnit":           {`"utf16"`, &LengthUnit{}},
 			"min":                  {"1", &Min{}},
 			"max":                  {"1", &Max{}},
 			"exclusiveMinimum":     {"true", &ExclusiveMinimum{}},
@@ -31,6 +32,20 @@ func TestNewConstraintFromRule(t *testing.T) {
 			"nullable":             {"true", &Nullable{}},
 			"regex":                {`"."`, &Regex{}},
 			"const":                {"true", &Const{}},
+			"caseInsensitive":      {"true", &CaseInsensitive{}},
+			"unicodeNormalization": {`"NFC"`, &UnicodeNormalization{}},
+			"discriminator":        {`"kind"`, &Discriminator{}},
+			"exclusive":            {"true", &Exclusive{}},
+			"deprecated":           {"true", &Deprecated{}},
+			"readOnly":             {"true", &ReadOnly{}},
+			"writeOnly":            {"true", &WriteOnly{}},
+			"title":                {`"Foo"`, &Title{}},
+			"description":          {`"Foo"`, &Description{}},
+			"default":              {"42", &Default{}},
+			"tuple":                {"true", &Tuple{}},
+			"additionalItems":      {`"string"`, &AdditionalItems{}},
+			"minContains":          {"1", &MinContains{}},
+			"maxContains":          {"1", &MaxContains{}},
 		}
 
 		for given, c := range cc {
@@ -52,22 +67,32 @@ func TestNewConstraintFromRule(t *testing.T) {
 	})
 
 	t.Run("negative", func(t *testing.T) {
-		assert.PanicsWithError(t, `ERROR (code 601): Unknown rule "invalid"
+		cc := map[string]string{
+			"invalid": `ERROR (code 601): Unknown rule "invalid"
 	in line 1 on file 
 	> invalid
-	--^`, func() {
-			const given = "invalid"
+	--^`,
+			"minLenght": `ERROR (code 601): Unknown rule "minLenght". Did you mean "minLength"?
+	in line 1 on file 
+	> minLenght
+	--^`,
+		}
 
-			NewConstraintFromRule(
-				lexeme.NewLexEvent(
-					lexeme.LiteralBegin,
-					0,
-					bytes.Index(len(given))-1,
-					fs.NewFile("", given),
-				),
-				nil,
-				nil,
-			)
-		})
+		for given, expected := range cc {
+			t.Run(given, func(t *testing.T) {
+				assert.PanicsWithError(t, expected, func() {
+					NewConstraintFromRule(
+						lexeme.NewLexEvent(
+							lexeme.LiteralBegin,
+							0,
+							bytes.Index(len(given))-1,
+							fs.NewFile("", given),
+						),
+						nil,
+						nil,
+					)
+				})
+			})
+		}
 	})
 }
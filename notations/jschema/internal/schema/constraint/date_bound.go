@@ -0,0 +1,72 @@
+package constraint
+
+import (
+	"time"
+
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+	"github.com/jsightapi/jsight-schema-go-library/errors"
+)
+
+const dateLayout = "2006-01-02"
+
+// DateBound the value of the "min" or "max" rule for the "date" and "datetime"
+// types. Example: {min: "1900-01-01"}, {max: "2100-01-01T00:00:00Z"}.
+type DateBound struct {
+	time time.Time
+
+	// isDateTime true for the datetime (RFC 3339) value, false for the date
+	// value.
+	isDateTime bool
+}
+
+// isDateBoundValue returns true if the rule value is a string, so it should be
+// parsed as the date bound instead of the number.
+func isDateBoundValue(ruleValue bytes.Bytes) bool {
+	return ruleValue.InQuotes()
+}
+
+func newDateBound(t Type, ruleValue bytes.Bytes) *DateBound {
+	str := ruleValue.Unquote().String()
+
+	if v, err := time.Parse(dateLayout, str); err == nil {
+		return &DateBound{time: v}
+	}
+
+	if v, err := time.Parse(time.RFC3339, str); err == nil {
+		return &DateBound{time: v, isDateTime: true}
+	}
+
+	panic(errors.Format(errors.ErrInvalidValueOfConstraint, t.String()))
+}
+
+// IsDateTime returns true for the datetime value, and false for the date value.
+func (b DateBound) IsDateTime() bool {
+	return b.isDateTime
+}
+
+// Time returns the bound. Datetime values keep their time zone offset, so they
+// should be compared with time.Time methods.
+func (b DateBound) Time() time.Time {
+	return b.time
+}
+
+// TypeName returns the name of the type which can be limited by this bound.
+func (b DateBound) TypeName() string {
+	if b.isDateTime {
+		return DateTimeConstraintType.String()
+	}
+	return DateConstraintType.String()
+}
+
+// parse parses the JSON value of the same format as the bound. Returns false
+// if the value has another format, such value will be rejected by the "type"
+// rule.
+func (b DateBound) parse(value bytes.Bytes) (time.Time, bool) {
+	layout := dateLayout
+	if b.isDateTime {
+		layout = time.RFC3339
+	}
+
+	v, err := time.Parse(layout, value.Unquote().String())
+	return v, err == nil
+}
@@ -0,0 +1,84 @@
+package constraint
+
+import (
+	"strconv"
+	"unicode/utf8"
+
+	"github.com/rivo/uniseg"
+
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+)
+
+// StringUnit a unit in which the length of the string is measured by the
+// "minLength" and "maxLength" rules.
+type StringUnit int
+
+const (
+	// StringUnitCodePoints counts Unicode code points. Used by default.
+	StringUnitCodePoints StringUnit = iota
+
+	// StringUnitBytes counts bytes of the UTF-8 encoded string.
+	StringUnitBytes
+
+	// StringUnitUTF16 counts UTF-16 code units, as the JavaScript
+	// String.length does.
+	StringUnitUTF16
+
+	// StringUnitGraphemes counts user-perceived characters (extended grapheme
+	// clusters).
+	StringUnitGraphemes
+)
+
+var stringUnitNames = []string{
+	"codePoints",
+	"bytes",
+	"utf16",
+	"graphemes",
+}
+
+// ParseStringUnit returns the unit with specified name.
+func ParseStringUnit(s string) (StringUnit, bool) {
+	for i, n := range stringUnitNames {
+		if n == s {
+			return StringUnit(i), true
+		}
+	}
+	return StringUnitCodePoints, false
+}
+
+func (u StringUnit) String() string {
+	if u < 0 || int(u) >= len(stringUnitNames) {
+		return "StringUnit(" + strconv.Itoa(int(u)) + ")"
+	}
+	return stringUnitNames[u]
+}
+
+// Len returns the length of the JSON string literal. JSON escapes are decoded
+// before counting.
+func (u StringUnit) Len(value bytes.Bytes) uint {
+	s := value.Unquote()
+
+	switch u {
+	case StringUnitBytes:
+		return uint(len(s))
+
+	case StringUnitUTF16:
+		var n uint
+		for _, r := range string(s) {
+			if r >= surrogatePairFirst {
+				n += 2
+			} else {
+				n++
+			}
+		}
+		return n
+
+	case StringUnitGraphemes:
+		return uint(uniseg.GraphemeClusterCount(string(s)))
+	}
+	return uint(utf8.RuneCount(s))
+}
+
+// surrogatePairFirst the first rune which is encoded in UTF-16 as a surrogate
+// pair.
+const surrogatePairFirst = '\U00010000'
@@ -0,0 +1,73 @@
+package constraint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+)
+
+func TestParseStringUnit(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		for _, u := range []StringUnit{
+			StringUnitCodePoints,
+			StringUnitBytes,
+			StringUnitUTF16,
+			StringUnitGraphemes,
+		} {
+			t.Run(u.String(), func(t *testing.T) {
+				actual, ok := ParseStringUnit(u.String())
+				assert.True(t, ok)
+				assert.Equal(t, u, actual)
+			})
+		}
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		_, ok := ParseStringUnit("runes")
+		assert.False(t, ok)
+	})
+}
+
+func TestStringUnit_String(t *testing.T) {
+	assert.Equal(t, "codePoints", StringUnitCodePoints.String())
+	assert.Equal(t, "StringUnit(42)", StringUnit(42).String())
+}
+
+func TestStringUnit_Len(t *testing.T) {
+	cc := map[string]struct {
+		codePoints uint
+		bytes      uint
+		utf16      uint
+		graphemes  uint
+	}{
+		`""`:                                     {0, 0, 0, 0},
+		`"abc"`:                                  {3, 3, 3, 3},
+		`abc`:                                    {3, 3, 3, 3},
+		`"\u00E9"`:                               {1, 2, 1, 1},
+		`"e\u0301"`:                              {2, 3, 2, 1},
+		`"\n\r"`:                                 {2, 2, 2, 2},
+		`"\r\n"`:                                 {2, 2, 2, 1},
+		`"\uD83E\uDD10"`:                         {1, 4, 2, 1},
+		`"\uD83D\uDC4D\uD83C\uDFFD"`:             {2, 8, 4, 1},
+		`"\uD83C\uDDE9\uD83C\uDDEA"`:             {2, 8, 4, 1},
+		`"\uD83C\uDDE9\uD83C\uDDEA\uD83C\uDDEB"`: {3, 12, 6, 2},
+		`"\uD83D\uDC69\u200D\uD83D\uDCBB"`:       {3, 11, 5, 1},
+		`"\u2764\uFE0F"`:                         {2, 6, 2, 1},
+		`"\u1100\u1161\u11A8"`:                   {3, 9, 3, 1},
+		`"\uD55C\uAD6D"`:                         {2, 6, 2, 2},
+		`"\u0600\u0661"`:                         {2, 4, 2, 1},
+		`"a\u200D\u2764"`:                        {3, 7, 3, 2},
+	}
+
+	for given, expected := range cc {
+		t.Run(given, func(t *testing.T) {
+			v := bytes.Bytes(given)
+			assert.Equal(t, expected.codePoints, StringUnitCodePoints.Len(v), "code points")
+			assert.Equal(t, expected.bytes, StringUnitBytes.Len(v), "bytes")
+			assert.Equal(t, expected.utf16, StringUnitUTF16.Len(v), "UTF-16")
+			assert.Equal(t, expected.graphemes, StringUnitGraphemes.Len(v), "graphemes")
+		})
+	}
+}
@@ -31,4 +31,43 @@ const (
 	DateTimeConstraintType                         // datetime
 	UuidConstraintType                             // uuid
 	ConstConstraintType                            // const
+	UniqueItemsConstraintType                      // uniqueItems
+	MultipleOfConstraintType                       // multipleOf
+	MinPropertiesConstraintType                    // minProperties
+	MaxPropertiesConstraintType                    // maxProperties
+	PropertyNamesConstraintType                    // propertyNames
+	DependentRequiredConstraintType                // dependentRequired
+	WhenConstraintType                             // when
+	DiscriminatorConstraintType                    // discriminator
+	ExclusiveConstraintType                        // exclusive
+	NotConstraintType                              // not
+	Ipv4ConstraintType                             // ipv4
+	Ipv6ConstraintType                             // ipv6
+	CidrConstraintType                             // cidr
+	HostnameConstraintType                         // hostname
+	TimeConstraintType                             // time
+	DurationConstraintType                         // duration
+	Base64ConstraintType                           // base64
+	Base64UrlConstraintType                        // base64url
+	HexConstraintType                              // hex
+	SemverConstraintType                           // semver
+	LanguageConstraintType                         // language
+	CustomConstraintType                           // custom
+	CustomTypeConstraintType                       // customType
+	LengthUnitConstraintType                       // lengthUnit
+	CaseInsensitiveConstraintType                  // caseInsensitive
+	UnicodeNormalizationConstraintType             // unicodeNormalization
+	DeprecatedConstraintType                       // deprecated
+	ReadOnlyConstraintType                         // readOnly
+	WriteOnlyConstraintType                        // writeOnly
+	TitleConstraintType                            // title
+	DescriptionConstraintType                      // description
+	ExamplesConstraintType                         // examples
+	DefaultConstraintType                          // default
+	IntegerWidthConstraintType                     // integerWidth
+	TupleConstraintType                            // tuple
+	AdditionalItemsConstraintType                  // additionalItems
+	ContainsConstraintType                         // contains
+	MinContainsConstraintType                      // minContains
+	MaxContainsConstraintType                      // maxContains
 )
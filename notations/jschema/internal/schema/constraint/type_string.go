@@ -32,11 +32,47 @@ func _() {
 	_ = x[DateTimeConstraintType-23]
 	_ = x[UuidConstraintType-24]
 	_ = x[ConstConstraintType-25]
+	_ = x[UniqueItemsConstraintType-26]
+	_ = x[MultipleOfConstraintType-27]
+	_ = x[MinPropertiesConstraintType-28]
+	_ = x[MaxPropertiesConstraintType-29]
+	_ = x[PropertyNamesConstraintType-30]
+	_ = x[DependentRequiredConstraintType-31]
+	_ = x[WhenConstraintType-32]
+	_ = x[DiscriminatorConstraintType-33]
+	_ = x[ExclusiveConstraintType-34]
+	_ = x[NotConstraintType-35]
+	_ = x[Ipv4ConstraintType-36]
+	_ = x[Ipv6ConstraintType-37]
+	_ = x[CidrConstraintType-38]
+	_ = x[HostnameConstraintType-39]
+	_ = x[TimeConstraintType-40]
+	_ = x[DurationConstraintType-41]
+	_ = x[Base64ConstraintType-42]
+	_ = x[Base64UrlConstraintType-43]
+	_ = x[HexConstraintType-44]
+	_ = x[SemverConstraintType-45]
+	_ = x[LanguageConstraintType-46]
+	_ = x[CustomConstraintType-47]
+	_ = x[CustomTypeConstraintType-48]
+	_ = x[LengthUnitConstraintType-49]
+	_ = x[CaseInsensitiveConstraintType-50]
+	_ = x[UnicodeNormalizationConstraintType-51]
+	_ = x[DeprecatedConstraintType-52]
+	_ = x[ReadOnlyConstraintType-53]
+	_ = x[WriteOnlyConstraintType-54]
+	_ = x[TitleConstraintType-55]
+	_ = x[DescriptionConstraintType-56]
+	_ = x[ExamplesConstraintType-57]
+	_ = x[DefaultConstraintType-58]
+	_ = x[IntegerWidthConstraintType-59]
+	_ = x[TupleConstraintType-60]
+	_ = x[AdditionalItemsConstraintType-61]
 }
 
-const _Type_name = "minLengthmaxLengthminmaxexclusiveMinimumexclusiveMaximumprecisiontypetypesoptionalorrequired-keysemailminItemsmaxItemsenumadditionalPropertiesallOfanynullableregexuridatedatetimeuuidconst"
+const _Type_name = "minLengthmaxLengthminmaxexclusiveMinimumexclusiveMaximumprecisiontypetypesoptionalorrequired-keysemailminItemsmaxItemsenumadditionalPropertiesallOfanynullableregexuridatedatetimeuuidconstuniqueItemsmultipleOfminPropertiesmaxPropertiespropertyNamesdependentRequiredwhendiscriminatorexclusivenotipv4ipv6cidrhostnametimedurationbase64base64urlhexsemverlanguagecustomcustomTypelengthUnitcaseInsensitiveunicodeNormalizationdeprecatedreadOnlywriteOnlytitledescriptionexamplesdefaultintegerWidthtupleadditionalItemscontainsminContainsmaxContains"
 
-var _Type_index = [...]uint8{0, 9, 18, 21, 24, 40, 56, 65, 69, 74, 82, 84, 97, 102, 110, 118, 122, 142, 147, 150, 158, 163, 166, 170, 178, 182, 187}
+var _Type_index = [...]uint16{0, 9, 18, 21, 24, 40, 56, 65, 69, 74, 82, 84, 97, 102, 110, 118, 122, 142, 147, 150, 158, 163, 166, 170, 178, 182, 187, 198, 208, 221, 234, 247, 264, 268, 281, 290, 293, 297, 301, 305, 313, 317, 325, 331, 340, 343, 349, 357, 363, 373, 383, 398, 418, 428, 436, 445, 450, 461, 469, 476, 488, 493, 508, 516, 527, 538}
 
 func (t Type) String() string {
 	if t < 0 || t >= Type(len(_Type_index)-1) {
@@ -3,6 +3,7 @@ package schema
 import (
 	"fmt"
 
+	jschema "github.com/jsightapi/jsight-schema-go-library"
 	"github.com/jsightapi/jsight-schema-go-library/bytes"
 	"github.com/jsightapi/jsight-schema-go-library/errors"
 	"github.com/jsightapi/jsight-schema-go-library/fs"
@@ -12,6 +13,13 @@ type Schema struct {
 	// types the map where key is the name of the type (or included Schema).
 	types    map[string]Type
 	rootNode Node
+
+	// registry the custom rules and types available in this schema.
+	registry *jschema.Registry
+
+	// validationOptions the optional checks performed during validation of
+	// documents.
+	validationOptions ValidationOptions
 }
 
 func New() Schema {
@@ -31,7 +39,7 @@ func (s Schema) MustType(name string) *Schema {
 	if ok {
 		return t.schema
 	}
-	panic(errors.Format(errors.ErrTypeNotFound, name))
+	panic(s.typeNotFoundError(name))
 }
 
 // Type returns specified type's schema.
@@ -40,7 +48,23 @@ func (s Schema) Type(name string) (*Schema, errors.Err) {
 	if ok {
 		return t.schema, nil
 	}
-	return nil, errors.Format(errors.ErrTypeNotFound, name)
+	return nil, s.typeNotFoundError(name)
+}
+
+func (s Schema) typeNotFoundError(name string) errors.Errorf {
+	return errors.Format(errors.ErrTypeNotFound, name).WithSuggestion(SuggestTypeName(name, s.types))
+}
+
+// SuggestTypeName returns the name of the type closest to the given one, or an
+// empty string if there is no such type. Unnamed types are ignored.
+func SuggestTypeName(name string, types map[string]Type) string {
+	names := make([]string, 0, len(types))
+	for n := range types {
+		if n != "" && n[0] != '#' {
+			names = append(names, n)
+		}
+	}
+	return errors.Suggest(name, names)
 }
 
 func (s Schema) RootNode() Node {
@@ -75,3 +99,23 @@ func (s *Schema) AddType(n string, t Type) {
 func (s *Schema) SetRootNode(node Node) {
 	s.rootNode = node
 }
+
+// Registry returns the custom rules and types available in this schema. Might
+// return nil.
+func (s Schema) Registry() *jschema.Registry {
+	return s.registry
+}
+
+func (s *Schema) SetRegistry(r *jschema.Registry) {
+	s.registry = r
+}
+
+// ValidationOptions returns the optional checks performed during validation of
+// documents.
+func (s Schema) ValidationOptions() ValidationOptions {
+	return s.validationOptions
+}
+
+func (s *Schema) SetValidationOptions(o ValidationOptions) {
+	s.validationOptions = o
+}
@@ -0,0 +1,39 @@
+package schema
+
+import (
+	"github.com/jsightapi/jsight-schema-go-library/errors"
+	"github.com/jsightapi/jsight-schema-go-library/internal/limits"
+)
+
+// ValidationMode the direction of the validated document. Affects keys marked
+// by the "readOnly" and "writeOnly" rules.
+type ValidationMode uint8
+
+const (
+	// ValidationModeDefault the "readOnly" and "writeOnly" rules are ignored.
+	ValidationModeDefault ValidationMode = iota
+
+	// ValidationModeRequest keys marked by the "readOnly" rule are rejected.
+	ValidationModeRequest
+
+	// ValidationModeResponse keys marked by the "writeOnly" rule are rejected.
+	ValidationModeResponse
+)
+
+// ValidationOptions the optional checks of the metadata rules and the size of
+// the document performed during validation. The zero value disables all of
+// them.
+type ValidationOptions struct {
+	Mode ValidationMode
+
+	// ReportDeprecated is called for each found key marked by the "deprecated"
+	// rule. Might be nil.
+	ReportDeprecated func(errors.DocumentError)
+
+	// Limits the safeguards against too large documents.
+	Limits limits.Limits
+
+	// CoerceStrings strings of the document are converted to the values which
+	// the schema expects before validation.
+	CoerceStrings bool
+}
@@ -33,10 +33,36 @@ func NodeValidatorList(node schema.Node, rootSchema schema.Schema, parent valida
 	return c.list
 }
 
+// nodeValidatorListIgnoringNot works like NodeValidatorList, but ignores the
+// "not" constraint of the node. Is used by the "not" validator itself.
+func nodeValidatorListIgnoringNot(node schema.Node, rootSchema schema.Schema, parent validator) []validator {
+	c := validatorListConstructor{
+		rootSchema: rootSchema,
+		parent:     parent,
+	}
+	c.buildListIgnoringNot(node)
+	return c.list
+}
+
 func (c *validatorListConstructor) buildList(node schema.Node) {
+	if node.Constraint(constraint.NotConstraintType) != nil {
+		c.list = append(c.list, newNotValidator(node, c.parent, c.rootSchema))
+		return
+	}
+	c.buildListIgnoringNot(node)
+}
+
+func (c *validatorListConstructor) buildListIgnoringNot(node schema.Node) {
 	if constr := node.Constraint(constraint.TypesListConstraintType); constr != nil {
-		names := constr.(*constraint.TypesList).Names()
-		c.appendTypeValidators(names)
+		switch {
+		case node.Constraint(constraint.DiscriminatorConstraintType) != nil:
+			c.list = append(c.list, newDiscriminatorValidator(node, c.parent, c.rootSchema))
+		case node.Constraint(constraint.ExclusiveConstraintType) != nil:
+			c.list = append(c.list, newExclusiveOrValidator(node, c.parent, c.rootSchema))
+		default:
+			names := constr.(*constraint.TypesList).Names()
+			c.appendTypeValidators(names)
+		}
 
 		if constr := node.Constraint(constraint.NullableConstraintType); constr != nil {
 			c.list = append(c.list, newLiteralValidator(node, c.parent))
@@ -11,8 +11,8 @@ import (
 // validator to process additional properties.
 
 type additionalPropertiesValidator struct {
-	node_           schema.Node                               // always schema.ObjectNode
-	parentValidator validator                                 // always objectValidator
+	node_           schema.Node                               // schema.ObjectNode, or schema.ArrayNode for tuple items
+	parentValidator validator                                 // objectValidator, or arrayValidator for tuple items
 	feedFunc        func(lexeme.LexEvent) ([]validator, bool) // can panic
 	schemaType      jschema.SchemaType
 	depth           uint
@@ -24,11 +24,12 @@ type additionalPropertiesValidator struct {
 func newAdditionalPropertiesValidator(
 	node schema.Node,
 	parentValidator validator,
+	rootSchema schema.Schema,
 	c *constraint.AdditionalProperties,
 ) []validator {
 	v := additionalPropertiesValidator{
-		node_:           node,            // always schema.ObjectNode
-		parentValidator: parentValidator, // always objectValidator
+		node_:           node,
+		parentValidator: parentValidator,
 	}
 
 	switch c.Mode() {
@@ -49,10 +50,9 @@ func newAdditionalPropertiesValidator(
 		}
 
 	case constraint.AdditionalPropertiesMustBeUserType:
-		schem := parentValidator.(*objectValidator).rootSchema
 		return NodeValidatorList(
-			schem.MustType(c.TypeName().String()).RootNode(), // can panic
-			schem,
+			rootSchema.MustType(c.TypeName().String()).RootNode(), // can panic
+			rootSchema,
 			parentValidator,
 		)
 
@@ -134,9 +134,13 @@ func (v *additionalPropertiesValidator) feedLiteral(jsonLexeme lexeme.LexEvent)
 	panic(errors.ErrUnexpectedLexInLiteralValidator)
 }
 
-func (*additionalPropertiesValidator) feedNotAllowed(lex lexeme.LexEvent) ([]validator, bool) {
+func (v *additionalPropertiesValidator) feedNotAllowed(lex lexeme.LexEvent) ([]validator, bool) {
+	if p, ok := v.parentValidator.(*objectValidator); ok {
+		lex = p.lastFoundKeyLex
+	}
+	key := lex.Value().Unquote().String()
 	panic(lexeme.NewLexEventError(
 		lex,
-		errors.Format(errors.ErrSchemaDoesNotSupportKey, lex.Value().Unquote().String())),
-	)
+		errors.Format(errors.ErrSchemaDoesNotSupportKey, key).WithSuggestion(suggestKey(v.node_, key)),
+	))
 }
@@ -1,6 +1,8 @@
 package validator
 
 import (
+	"strconv"
+
 	"github.com/jsightapi/jsight-schema-go-library/errors"
 	"github.com/jsightapi/jsight-schema-go-library/internal/lexeme"
 	"github.com/jsightapi/jsight-schema-go-library/notations/jschema/internal/schema"
@@ -18,6 +20,16 @@ type arrayValidator struct {
 	// name.
 	rootSchema   schema.Schema
 	itemsCounter uint
+
+	// uniqueItems collects items for the "uniqueItems" constraint. Nil if the
+	// constraint isn't specified.
+	uniqueItems *constraint.UniqueItemsSet
+
+	// contains the "contains" constraint, nil if it isn't specified.
+	contains *constraint.Contains
+
+	// containsCounter the number of items matching the "contains" constraint.
+	containsCounter uint
 }
 
 func newArrayValidator(node schema.Node, parent validator, rootSchema schema.Schema) *arrayValidator {
@@ -28,6 +40,12 @@ func newArrayValidator(node schema.Node, parent validator, rootSchema schema.Sch
 			parent_:    parent,
 			rootSchema: rootSchema,
 		}
+		if c, ok := node.Constraint(constraint.UniqueItemsConstraintType).(*constraint.UniqueItems); ok {
+			v.uniqueItems = c.NewSet()
+		}
+		if c, ok := node.Constraint(constraint.ContainsConstraintType).(*constraint.Contains); ok {
+			v.contains = c
+		}
 		return &v
 	default:
 		panic(errors.ErrValidator)
@@ -51,11 +69,24 @@ func (v *arrayValidator) feed(jsonLexeme lexeme.LexEvent) ([]validator, bool) {
 	defer lexeme.CatchLexEventError(jsonLexeme)
 
 	switch jsonLexeme.Type() { //nolint:exhaustive // We will throw a panic in over cases.
-	case lexeme.ArrayBegin, lexeme.ArrayItemEnd:
+	case lexeme.ArrayBegin:
+		return nil, false
+
+	case lexeme.ArrayItemEnd:
+		if v.uniqueItems != nil {
+			v.uniqueItems.Add(jsonLexeme.Value()) // can panic
+		}
+		if v.contains != nil && IsContainedBy(v.contains, v.rootSchema, jsonLexeme.Value()) {
+			v.containsCounter++
+		}
 		return nil, false
 
 	case lexeme.ArrayItemBegin:
 		if arrayNode, ok := v.node_.(*schema.ArrayNode); ok {
+			if arrayNode.IsTuple() && v.itemsCounter >= uint(arrayNode.Len()) {
+				v.itemsCounter++
+				return v.additionalItemValidators(arrayNode), false // can panic
+			}
 			childNode := arrayNode.Child(v.itemsCounter) // can panic
 			v.itemsCounter++
 			return NodeValidatorList(childNode, v.rootSchema, v), false
@@ -65,14 +96,56 @@ func (v *arrayValidator) feed(jsonLexeme lexeme.LexEvent) ([]validator, bool) {
 
 	case lexeme.ArrayEnd:
 		if arrayNode, ok := v.node_.(*schema.ArrayNode); ok {
+			if arrayNode.IsTuple() && v.itemsCounter < uint(arrayNode.RequiredLen()) {
+				panic(errors.Format(errors.ErrNotEnoughTupleItems, strconv.Itoa(arrayNode.RequiredLen())))
+			}
 			arrayNode.ConstraintMap().EachSafe(func(_ constraint.Type, av constraint.Constraint) {
 				if arrayValidator, ok := av.(constraint.ArrayValidator); ok {
 					arrayValidator.ValidateTheArray(v.itemsCounter)
 				}
 			})
+			v.validateContains()
 		}
 		return nil, true
 	}
 
 	panic(errors.ErrUnexpectedLexInArrayValidator)
 }
+
+// additionalItemValidators returns validators for the tuple item after the last
+// element of the example. Panics if such items aren't allowed.
+func (v *arrayValidator) additionalItemValidators(node *schema.ArrayNode) []validator {
+	c, ok := node.Constraint(constraint.AdditionalItemsConstraintType).(*constraint.AdditionalItems)
+	if !ok || c.Mode() == constraint.AdditionalPropertiesNotAllowed {
+		panic(errors.Format(errors.ErrTooManyTupleItems, strconv.Itoa(node.Len())))
+	}
+	return newAdditionalPropertiesValidator(node, v, v.rootSchema, &c.AdditionalProperties)
+}
+
+// validateContains checks the number of items matching the "contains"
+// constraint. At least one item is required, if the "minContains" rule isn't
+// specified.
+func (v *arrayValidator) validateContains() {
+	if v.contains == nil {
+		return
+	}
+
+	if c, ok := v.node_.Constraint(constraint.MinContainsConstraintType).(*constraint.MinContains); ok {
+		c.ValidateTheNumber(v.containsCounter)
+	} else if v.containsCounter == 0 {
+		panic(errors.Format(errors.ErrNotEnoughContainedItems, "1"))
+	}
+
+	if c, ok := v.node_.Constraint(constraint.MaxContainsConstraintType).(*constraint.MaxContains); ok {
+		c.ValidateTheNumber(v.containsCounter)
+	}
+}
+
+// IsContainedBy returns true if the JSON value matches the "contains"
+// constraint.
+func IsContainedBy(c *constraint.Contains, rootSchema schema.Schema, value []byte) bool {
+	if c.HasType() {
+		return IsValidValue(rootSchema.MustType(c.TypeName()).RootNode(), rootSchema, value)
+	}
+	return c.Match(value)
+}
@@ -0,0 +1,111 @@
+package validator
+
+import (
+	"strings"
+
+	"github.com/jsightapi/jsight-schema-go-library/errors"
+	"github.com/jsightapi/jsight-schema-go-library/internal/lexeme"
+	"github.com/jsightapi/jsight-schema-go-library/notations/jschema/internal/schema"
+	"github.com/jsightapi/jsight-schema-go-library/notations/jschema/internal/schema/constraint"
+)
+
+// Validates json according to the "or" rule with the "discriminator". Instead of
+// validating the object against all types of the "or" rule, the type is
+// selected by the value of the discriminator key when the object is over.
+
+type discriminatorValidator struct {
+	node_   schema.Node
+	parent_ validator
+
+	// rootSchema the scheme from which it is possible to receive type by their
+	// name.
+	rootSchema schema.Schema
+
+	discriminator *constraint.Discriminator
+
+	// lastFoundKey the last found key of the object.
+	lastFoundKey string
+
+	// valueLex the value of the discriminator key, if found.
+	valueLex lexeme.LexEvent
+
+	depth     uint
+	valueSeen bool
+}
+
+func newDiscriminatorValidator(
+	node schema.Node,
+	parent validator,
+	rootSchema schema.Schema,
+) *discriminatorValidator {
+	return &discriminatorValidator{
+		node_:         node,
+		parent_:       parent,
+		rootSchema:    rootSchema,
+		discriminator: node.Constraint(constraint.DiscriminatorConstraintType).(*constraint.Discriminator),
+	}
+}
+
+func (v discriminatorValidator) node() schema.Node {
+	return v.node_
+}
+
+func (v discriminatorValidator) parent() validator {
+	return v.parent_
+}
+
+func (v *discriminatorValidator) setParent(parent validator) {
+	v.parent_ = parent
+}
+
+// feed returns nil (empty list pointers to validators) and bool (true if
+// validator is done).
+func (v *discriminatorValidator) feed(jsonLexeme lexeme.LexEvent) ([]validator, bool) {
+	defer lexeme.CatchLexEventError(jsonLexeme)
+
+	if v.depth == 0 && jsonLexeme.Type() != lexeme.ObjectBegin {
+		panic(errors.ErrUnexpectedLexInObjectValidator)
+	}
+
+	if jsonLexeme.Type().IsOpening() {
+		v.depth++
+		return nil, false
+	}
+	v.depth--
+
+	switch {
+	case v.depth == 1 && jsonLexeme.Type() == lexeme.ObjectKeyEnd:
+		v.lastFoundKey = jsonLexeme.Value().Unquote().String()
+
+	case v.depth == 1 && jsonLexeme.Type() == lexeme.ObjectValueEnd:
+		if v.lastFoundKey == v.discriminator.Key() {
+			v.valueLex = jsonLexeme
+			v.valueSeen = true
+		}
+
+	case v.depth == 0:
+		v.validateSelectedType(jsonLexeme)
+		return nil, true
+	}
+	return nil, false
+}
+
+// validateSelectedType validates the whole object against the type selected by
+// the value of the discriminator key.
+func (v discriminatorValidator) validateSelectedType(objectLex lexeme.LexEvent) {
+	if !v.valueSeen {
+		panic(errors.Format(errors.ErrDiscriminatorKeyNotFound, v.discriminator.Key()))
+	}
+
+	name, ok := v.discriminator.Branch(v.valueLex.Value())
+	if !ok {
+		panic(lexeme.NewLexEventError(v.valueLex, errors.Format(
+			errors.ErrInvalidDiscriminatorValue,
+			v.valueLex.Value().String(),
+			v.discriminator.Key(),
+			strings.Join(v.discriminator.Values(), ", "),
+		)))
+	}
+
+	validateScannedValue(objectLex, v.rootSchema.MustType(name).RootNode(), v.rootSchema)
+}
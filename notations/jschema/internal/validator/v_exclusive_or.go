@@ -0,0 +1,118 @@
+package validator
+
+import (
+	"strings"
+
+	"github.com/jsightapi/jsight-schema-go-library/errors"
+	"github.com/jsightapi/jsight-schema-go-library/internal/lexeme"
+	"github.com/jsightapi/jsight-schema-go-library/notations/jschema/internal/schema"
+	"github.com/jsightapi/jsight-schema-go-library/notations/jschema/internal/schema/constraint"
+)
+
+// Validates json according to the "or" rule with the "exclusive" mode. The value
+// is validated against all types of the "or" rule when the value is over, and
+// should be valid for exactly one of them.
+
+type exclusiveOrValidator struct {
+	node_   schema.Node
+	parent_ validator
+
+	// rootSchema the scheme from which it is possible to receive type by their
+	// name.
+	rootSchema schema.Schema
+
+	typesList *constraint.TypesList
+
+	depth uint
+}
+
+func newExclusiveOrValidator(node schema.Node, parent validator, rootSchema schema.Schema) *exclusiveOrValidator {
+	return &exclusiveOrValidator{
+		node_:      node,
+		parent_:    parent,
+		rootSchema: rootSchema,
+		typesList:  node.Constraint(constraint.TypesListConstraintType).(*constraint.TypesList),
+	}
+}
+
+func (v exclusiveOrValidator) node() schema.Node {
+	return v.node_
+}
+
+func (v exclusiveOrValidator) parent() validator {
+	return v.parent_
+}
+
+func (v *exclusiveOrValidator) setParent(parent validator) {
+	v.parent_ = parent
+}
+
+// feed returns nil (empty list pointers to validators) and bool (true if
+// validator is done).
+func (v *exclusiveOrValidator) feed(jsonLexeme lexeme.LexEvent) ([]validator, bool) {
+	defer lexeme.CatchLexEventError(jsonLexeme)
+
+	if jsonLexeme.Type().IsOpening() {
+		v.depth++
+		return nil, false
+	}
+
+	v.depth--
+	if v.depth != 0 {
+		return nil, false
+	}
+
+	v.validateTypes(jsonLexeme)
+	return nil, true
+}
+
+// validateTypes validates the whole value against every type of the "or" rule.
+func (v exclusiveOrValidator) validateTypes(valueLex lexeme.LexEvent) {
+	var (
+		matched   []string
+		lastErr   error
+		typeNames = v.typesList.TypeNames()
+	)
+
+	for i, name := range v.typesList.Names() {
+		if err := v.validateType(valueLex, name); err != nil {
+			lastErr = err
+			continue
+		}
+		matched = append(matched, typeNames[i])
+	}
+
+	switch len(matched) {
+	case 0:
+		if v.typesList.Len() == 1 {
+			panic(lastErr)
+		}
+		panic(lexeme.NewLexEventError(valueLex, errors.ErrOrRuleSetValidation))
+	case 1:
+		return
+	default:
+		panic(lexeme.NewLexEventError(valueLex, errors.Format(
+			errors.ErrOrRuleSeveralTypesMatched,
+			strings.Join(matched, ", "),
+		)))
+	}
+}
+
+// validateType returns an error if the value isn't valid for the type.
+func (v exclusiveOrValidator) validateType(valueLex lexeme.LexEvent, name string) (err error) {
+	defer func() {
+		r := recover()
+		if r == nil {
+			return
+		}
+
+		e, ok := r.(errors.DocumentError)
+		if !ok {
+			panic(r)
+		}
+		err = e
+	}()
+
+	validateScannedValue(valueLex, v.rootSchema.MustType(name).RootNode(), v.rootSchema)
+	return nil
+}
@@ -0,0 +1,77 @@
+package validator
+
+import (
+	"github.com/jsightapi/jsight-schema-go-library/errors"
+	"github.com/jsightapi/jsight-schema-go-library/internal/lexeme"
+	"github.com/jsightapi/jsight-schema-go-library/notations/jschema/internal/schema"
+	"github.com/jsightapi/jsight-schema-go-library/notations/jschema/internal/schema/constraint"
+)
+
+// Validates json according to the node with the "not" rule. When the value is
+// over, it's validated against the node without the "not" rule, and after that
+// against the excluded values.
+
+type notValidator struct {
+	node_   schema.Node
+	parent_ validator
+
+	// rootSchema the scheme from which it is possible to receive type by their
+	// name.
+	rootSchema schema.Schema
+
+	not *constraint.Not
+
+	depth uint
+}
+
+func newNotValidator(node schema.Node, parent validator, rootSchema schema.Schema) *notValidator {
+	return &notValidator{
+		node_:      node,
+		parent_:    parent,
+		rootSchema: rootSchema,
+		not:        node.Constraint(constraint.NotConstraintType).(*constraint.Not),
+	}
+}
+
+func (v notValidator) node() schema.Node {
+	return v.node_
+}
+
+func (v notValidator) parent() validator {
+	return v.parent_
+}
+
+func (v *notValidator) setParent(parent validator) {
+	v.parent_ = parent
+}
+
+// feed returns nil (empty list pointers to validators) and bool (true if
+// validator is done).
+func (v *notValidator) feed(jsonLexeme lexeme.LexEvent) ([]validator, bool) {
+	defer lexeme.CatchLexEventError(jsonLexeme)
+
+	if jsonLexeme.Type().IsOpening() {
+		v.depth++
+		return nil, false
+	}
+
+	v.depth--
+	if v.depth != 0 {
+		return nil, false
+	}
+
+	validateScannedValueWith(jsonLexeme, nodeValidatorListIgnoringNot(v.node_, v.rootSchema, nil))
+	if IsExcludedByNot(v.not, v.rootSchema, jsonLexeme.Value()) {
+		panic(errors.ErrConstraintNotValidation)
+	}
+	return nil, true
+}
+
+// IsExcludedByNot returns true if the JSON value is excluded by the "not"
+// constraint.
+func IsExcludedByNot(c *constraint.Not, rootSchema schema.Schema, value []byte) bool {
+	if c.HasType() {
+		return IsValidValue(rootSchema.MustType(c.TypeName()).RootNode(), rootSchema, value)
+	}
+	return c.Match(value)
+}
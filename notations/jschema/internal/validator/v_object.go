@@ -25,6 +25,18 @@ type objectValidator struct {
 	// name.
 	rootSchema      schema.Schema
 	lastFoundKeyLex lexeme.LexEvent
+
+	// propertiesCounter the number of found keys. Used by the "minProperties"
+	// and "maxProperties" constraints.
+	propertiesCounter uint
+
+	// foundKeys the found keys. Used by the "dependentRequired" and "when"
+	// constraints, nil otherwise.
+	foundKeys map[string]lexeme.LexEvent
+
+	// foundValues the values of found keys. Used by the "when" constraint, nil
+	// otherwise.
+	foundValues map[string]lexeme.LexEvent
 }
 
 func newObjectValidator(node schema.Node, parent validator, rootSchema schema.Schema) *objectValidator {
@@ -37,6 +49,7 @@ func newObjectValidator(node schema.Node, parent validator, rootSchema schema.Sc
 			requiredKeys: make(map[string]int, 5),
 		}
 		v.initRequiredKeys()
+		v.initFoundKeys()
 		return &v
 	default:
 		panic(errors.ErrValidator)
@@ -52,6 +65,18 @@ func (v *objectValidator) initRequiredKeys() {
 	}
 }
 
+func (v *objectValidator) initFoundKeys() {
+	hasDependentRequired := v.node_.Constraint(constraint.DependentRequiredConstraintType) != nil
+	hasWhen := v.node_.Constraint(constraint.WhenConstraintType) != nil
+
+	if hasDependentRequired || hasWhen {
+		v.foundKeys = make(map[string]lexeme.LexEvent, 5)
+	}
+	if hasWhen {
+		v.foundValues = make(map[string]lexeme.LexEvent, 5)
+	}
+}
+
 func (v objectValidator) node() schema.Node {
 	return v.node_
 }
@@ -70,7 +95,13 @@ func (v *objectValidator) feed(jsonLexeme lexeme.LexEvent) ([]validator, bool) {
 	defer lexeme.CatchLexEventError(jsonLexeme)
 
 	switch jsonLexeme.Type() { //nolint:exhaustive // We will throw a panic in over cases.
-	case lexeme.ObjectBegin, lexeme.ObjectKeyBegin, lexeme.ObjectValueEnd:
+	case lexeme.ObjectBegin, lexeme.ObjectKeyBegin:
+		return nil, false
+
+	case lexeme.ObjectValueEnd:
+		if v.foundValues != nil {
+			v.foundValues[v.lastFoundKeyLex.Value().Unquote().String()] = jsonLexeme
+		}
 		return nil, false
 
 	case lexeme.ObjectKeyEnd:
@@ -84,6 +115,13 @@ func (v *objectValidator) feed(jsonLexeme lexeme.LexEvent) ([]validator, bool) {
 		if len(v.requiredKeys) != 0 {
 			panic(errors.Format(errors.ErrRequiredKeyNotFound, v.requiredKeysString()))
 		}
+		v.node_.ConstraintMap().EachSafe(func(_ constraint.Type, c constraint.Constraint) {
+			if ov, ok := c.(constraint.ObjectValidator); ok {
+				ov.ValidateTheObject(v.propertiesCounter)
+			}
+		})
+		v.validateDependentRequired()
+		v.validateWhen(jsonLexeme)
 		return nil, true
 	}
 
@@ -92,6 +130,7 @@ func (v *objectValidator) feed(jsonLexeme lexeme.LexEvent) ([]validator, bool) {
 
 func (v *objectValidator) feedObjectKeyEnd(jsonLexeme lexeme.LexEvent) {
 	v.lastFoundKeyLex = jsonLexeme
+	v.propertiesCounter++
 	if _, ok := v.node_.(*schema.ObjectNode); !ok { // mixed node
 		panic(lexeme.NewLexEventError(
 			v.lastFoundKeyLex,
@@ -99,6 +138,9 @@ func (v *objectValidator) feedObjectKeyEnd(jsonLexeme lexeme.LexEvent) {
 		)
 	}
 	delete(v.requiredKeys, v.lastFoundKeyLex.Value().Unquote().String())
+	if v.foundKeys != nil {
+		v.foundKeys[v.lastFoundKeyLex.Value().Unquote().String()] = v.lastFoundKeyLex
+	}
 }
 
 func (v *objectValidator) feedObjectValueBegin() ([]validator, bool) {
@@ -109,6 +151,7 @@ func (v *objectValidator) feedObjectValueBegin() ([]validator, bool) {
 
 	childNode, ok := objectNode.ChildByRawKey(v.lastFoundKeyLex.Value())
 	if ok {
+		v.checkMetadata(childNode)
 		return NodeValidatorList(childNode, v.rootSchema, v), false
 	}
 
@@ -119,18 +162,165 @@ func (v *objectValidator) feedObjectValueBegin() ([]validator, bool) {
 			child, ok := objectNode.ChildByRawKey([]byte(key))
 			if ok {
 				delete(v.requiredKeys, key)
+				v.checkMetadata(child)
 				return NodeValidatorList(child, v.rootSchema, v), false
 			}
 		}
 	}
 	if c := v.node_.Constraint(constraint.AdditionalPropertiesConstraintType); c != nil {
-		return newAdditionalPropertiesValidator(v.node_, v, c.(*constraint.AdditionalProperties)), false
+		v.validatePropertyName()
+		return newAdditionalPropertiesValidator(v.node_, v, v.rootSchema, c.(*constraint.AdditionalProperties)), false
 	}
 
+	key := v.lastFoundKeyLex.Value().Unquote().String()
 	panic(lexeme.NewLexEventError(
 		v.lastFoundKeyLex,
-		errors.Format(errors.ErrSchemaDoesNotSupportKey, v.lastFoundKeyLex.Value().Unquote().String())),
-	)
+		errors.Format(errors.ErrSchemaDoesNotSupportKey, key).WithSuggestion(suggestKey(v.node_, key)),
+	))
+}
+
+// checkMetadata performs the optional checks of the metadata rules of the
+// found key.
+func (v objectValidator) checkMetadata(child schema.Node) {
+	opts := v.rootSchema.ValidationOptions()
+
+	switch {
+	case opts.Mode == schema.ValidationModeRequest && child.Constraint(constraint.ReadOnlyConstraintType) != nil:
+		panic(lexeme.NewLexEventError(
+			v.lastFoundKeyLex,
+			errors.Format(errors.ErrReadOnlyKey, v.lastFoundKeyLex.Value().Unquote().String()),
+		))
+
+	case opts.Mode == schema.ValidationModeResponse && child.Constraint(constraint.WriteOnlyConstraintType) != nil:
+		panic(lexeme.NewLexEventError(
+			v.lastFoundKeyLex,
+			errors.Format(errors.ErrWriteOnlyKey, v.lastFoundKeyLex.Value().Unquote().String()),
+		))
+	}
+
+	if opts.ReportDeprecated != nil && child.Constraint(constraint.DeprecatedConstraintType) != nil {
+		opts.ReportDeprecated(lexeme.NewLexEventError(
+			v.lastFoundKeyLex,
+			errors.Format(errors.ErrDeprecatedKey, v.lastFoundKeyLex.Value().Unquote().String()),
+		))
+	}
+}
+
+// validatePropertyName checks the key of the additional property against the
+// "propertyNames" constraint.
+func (v objectValidator) validatePropertyName() {
+	c, ok := v.node_.Constraint(constraint.PropertyNamesConstraintType).(*constraint.PropertyNames)
+	if !ok {
+		return
+	}
+
+	key := v.lastFoundKeyLex.Value()
+	valid := c.Match(key.Unquote().String())
+	if name := c.TypeName(); name != nil {
+		valid = isValidLiteralValue(v.rootSchema.MustType(name.String()).RootNode(), key) // can panic
+	}
+
+	if !valid {
+		panic(lexeme.NewLexEventError(
+			v.lastFoundKeyLex,
+			errors.Format(errors.ErrConstraintPropertyNamesValidation, key.Unquote().String()),
+		))
+	}
+}
+
+// validateDependentRequired checks that keys required by found keys are found
+// too. The error points to the key which requires the missing one.
+func (v objectValidator) validateDependentRequired() {
+	c, ok := v.node_.Constraint(constraint.DependentRequiredConstraintType).(*constraint.DependentRequired)
+	if !ok {
+		return
+	}
+
+	key, dependency, missing := c.FindMissing(func(k string) bool {
+		_, ok := v.foundKeys[k]
+		return ok
+	})
+	if missing {
+		panic(lexeme.NewLexEventError(
+			v.foundKeys[key],
+			errors.Format(errors.ErrConstraintDependentRequiredValidation, dependency, key),
+		))
+	}
+}
+
+// validateWhen validates the whole object against user types of triggered
+// conditions of the "when" constraint.
+func (v objectValidator) validateWhen(objectLex lexeme.LexEvent) {
+	c, ok := v.node_.Constraint(constraint.WhenConstraintType).(*constraint.When)
+	if !ok {
+		return
+	}
+
+	for _, cond := range c.Conditions() {
+		value, ok := v.foundValues[cond.Key]
+		if ok && cond.Match(value.Value()) {
+			v.validateWhenCondition(objectLex, cond)
+		}
+	}
+}
+
+func (v objectValidator) validateWhenCondition(objectLex lexeme.LexEvent, cond constraint.WhenCondition) {
+	defer func() {
+		r := recover()
+		if r == nil {
+			return
+		}
+
+		e, ok := r.(errors.DocumentError)
+		if !ok {
+			panic(r)
+		}
+
+		err := errors.NewDocumentError(e.File(), errors.Format(
+			errors.ErrConstraintWhenValidation,
+			cond.Then,
+			cond.Key,
+			cond.Const.String(),
+			e.Message(),
+		))
+		err.SetIndex(e.Index())
+		panic(err)
+	}()
+
+	validateScannedValue(objectLex, v.rootSchema.MustType(cond.Then).RootNode(), v.rootSchema)
+}
+
+// isValidLiteralValue returns true if the value is valid for the literal node.
+func isValidLiteralValue(node schema.Node, value jbytes.Bytes) (ok bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			ok = false
+		}
+	}()
+
+	if _, isLiteral := node.(*schema.LiteralNode); !isLiteral {
+		return false
+	}
+	ValidateLiteralValue(node, value)
+	return true
+}
+
+// suggestKey returns the key of the object node closest to the given one.
+// Shortcut keys are ignored because they are types, not key names.
+func suggestKey(node schema.Node, key string) string {
+	objectNode, ok := node.(*schema.ObjectNode)
+	if !ok {
+		return ""
+	}
+
+	kk := objectNode.Keys().Data
+	names := make([]string, 0, len(kk))
+	for _, k := range kk {
+		if !k.IsShortcut {
+			names = append(names, k.Key)
+		}
+	}
+	return errors.Suggest(key, names)
 }
 
 func (v objectValidator) requiredKeysString() string {
@@ -49,8 +49,13 @@ func checkNotAnEnum(node schema.Node, value bytes.Bytes) {
 		return
 	}
 
-	jsonType := json.Guess(value).LiteralJsonType() // can panic
+	g := json.Guess(value)
 	schemaType := node.Type()
+	if g.IsNonFinite() {
+		panic(errors.Format(errors.ErrInvalidValueType, value.String(), schemaType.String()))
+	}
+
+	jsonType := g.LiteralJsonType() // can panic
 	if !(jsonType == schemaType ||
 		(jsonType == json.TypeInteger && schemaType == json.TypeFloat) ||
 		(jsonType == json.TypeNull && node.Constraint(constraint.NullableConstraintType) != nil)) {
@@ -0,0 +1,62 @@
+package validator
+
+import (
+	stdErrors "errors"
+	"io"
+
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
+	"github.com/jsightapi/jsight-schema-go-library/formats/json"
+	"github.com/jsightapi/jsight-schema-go-library/fs"
+	"github.com/jsightapi/jsight-schema-go-library/internal/lexeme"
+	"github.com/jsightapi/jsight-schema-go-library/notations/jschema/internal/schema"
+)
+
+// validateScannedValue validates the already scanned JSON value against the
+// node. The value is scanned again, and its lexemes are moved to the position
+// of the value in the original file, so errors point to the right place.
+func validateScannedValue(valueLex lexeme.LexEvent, node schema.Node, rootSchema schema.Schema) {
+	validateScannedValueWith(valueLex, NodeValidatorList(node, rootSchema, nil))
+}
+
+// validateScannedValueWith validates the already scanned JSON value with the
+// list of validators.
+func validateScannedValueWith(valueLex lexeme.LexEvent, list []validator) {
+	doc := json.New(valueLex.File().Name(), valueLex.Value())
+	tree := NewTree(list)
+
+	for {
+		lex, err := doc.NextLexeme()
+		if err != nil {
+			if stdErrors.Is(err, io.EOF) {
+				return
+			}
+			panic(err)
+		}
+
+		lex = lexeme.NewLexEvent(
+			lex.Type(),
+			lex.Begin()+valueLex.Begin(),
+			lex.End()+valueLex.Begin(),
+			valueLex.File(),
+		)
+		if tree.FeedLeaves(lex) { // can panic
+			return
+		}
+	}
+}
+
+// IsValidValue returns true if the JSON value is valid for the node. The
+// optional checks of the metadata rules aren't performed.
+func IsValidValue(node schema.Node, rootSchema schema.Schema, value bytes.Bytes) (ok bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			ok = false
+		}
+	}()
+
+	rootSchema.SetValidationOptions(schema.ValidationOptions{})
+
+	valueLex := lexeme.NewLexEvent(lexeme.LiteralEnd, 0, bytes.Index(len(value)-1), fs.NewFile("", value))
+	validateScannedValue(valueLex, node, rootSchema)
+	return true
+}
@@ -1,15 +1,22 @@
 package jschema
 
 import (
+	"context"
 	stdErrors "errors"
 	"fmt"
 	"io"
 	"strings"
 
 	jschema "github.com/jsightapi/jsight-schema-go-library"
+	"github.com/jsightapi/jsight-schema-go-library/bytes"
 	"github.com/jsightapi/jsight-schema-go-library/errors"
+	"github.com/jsightapi/jsight-schema-go-library/formats/cbor"
+	"github.com/jsightapi/jsight-schema-go-library/formats/form"
 	"github.com/jsightapi/jsight-schema-go-library/formats/json"
+	"github.com/jsightapi/jsight-schema-go-library/formats/msgpack"
+	"github.com/jsightapi/jsight-schema-go-library/formats/yaml"
 	"github.com/jsightapi/jsight-schema-go-library/fs"
+	"github.com/jsightapi/jsight-schema-go-library/internal/limits"
 	"github.com/jsightapi/jsight-schema-go-library/internal/panics"
 	"github.com/jsightapi/jsight-schema-go-library/internal/sync"
 	"github.com/jsightapi/jsight-schema-go-library/notations/internal"
@@ -28,6 +35,9 @@ type Schema struct {
 
 	rules map[string]jschema.Rule
 
+	// registry the custom rules and types available in this schema.
+	registry *jschema.Registry
+
 	usedUserTypes []string
 
 	lenOnce     sync.ErrOnceWithValue[uint]
@@ -67,6 +77,14 @@ func KeysAreOptionalByDefault() Option {
 	}
 }
 
+// WithRegistry makes custom rules and types from the registry available in the
+// schema.
+func WithRegistry(r *jschema.Registry) Option {
+	return func(s *Schema) {
+		s.registry = r
+	}
+}
+
 func (s *Schema) Len() (uint, error) {
 	return s.lenOnce.Do(func() (uint, error) {
 		return s.computeLen()
@@ -163,29 +181,159 @@ func (s *Schema) Check() (err error) {
 	return s.compile()
 }
 
-func (s *Schema) Validate(document jschema.Document) (err error) {
+func (s *Schema) Validate(document jschema.Document) error {
+	return s.ValidateWithOptions(document)
+}
+
+// ValidateWithOptions works like Validate, but also performs the optional checks
+// of the metadata rules enabled by the options.
+func (s *Schema) ValidateWithOptions(document jschema.Document, oo ...ValidateOption) error {
+	return s.ValidateContext(context.Background(), document, oo...)
+}
+
+// ValidateContext works like ValidateWithOptions, but stops validation as soon
+// as the context is canceled or its deadline is exceeded. The context is
+// checked before each lexeme of the document. In that case the
+// errors.CanceledError which wraps the error of the context is returned.
+func (s *Schema) ValidateContext(
+	ctx context.Context,
+	document jschema.Document,
+	oo ...ValidateOption,
+) (err error) {
 	defer func() {
 		err = panics.Handle(recover(), err)
+		if m, ok := document.(errorMapper); ok {
+			err = m.MapError(err)
+		}
 	}()
 	if err := s.compile(); err != nil {
 		return err
 	}
 
-	if _, ok := document.(*json.Document); !ok {
-		return fmt.Errorf("support only JSON documents, but got %T", document)
+	if err := checkDocumentType(document); err != nil {
+		return err
+	}
+
+	var opts internalSchema.ValidationOptions
+	for _, o := range oo {
+		o(&opts)
 	}
 
-	return s.validate(document)
+	if opts.CoerceStrings {
+		_, err := s.coerce(ctx, document, opts)
+		return err
+	}
+	return s.validate(ctx, document, opts)
 }
 
-func (s *Schema) validate(document jschema.Document) error {
+// checkDocumentType returns an error if the document can't be validated by the
+// schema.
+func checkDocumentType(document jschema.Document) error {
+	switch document.(type) {
+	case *json.Document, *yaml.Document, *cbor.Document, *msgpack.Document, *form.Document:
+		return nil
+	default:
+		return fmt.Errorf("support only JSON, YAML, CBOR, MessagePack and form documents, but got %T", document)
+	}
+}
+
+// checkContext returns the errors.CanceledError if the context is canceled or
+// its deadline is exceeded. Doesn't block.
+func checkContext(ctx context.Context) error {
+	select {
+	case <-ctx.Done():
+		return errors.NewCanceledError(ctx.Err())
+	default:
+		return nil
+	}
+}
+
+// deprecatedKeysReporter wraps the function which reports deprecated keys. Each
+// key is reported once, even if it is checked by several branches of the "or"
+// rule. Reported errors get the path of the key and are mapped back to the
+// original content of the document.
+func deprecatedKeysReporter(
+	document jschema.Document,
+	path *documentPath,
+	report func(errors.DocumentError),
+) func(errors.DocumentError) {
+	reported := map[bytes.Index]struct{}{}
+	return func(e errors.DocumentError) {
+		if _, ok := reported[e.Index()]; ok {
+			return
+		}
+		reported[e.Index()] = struct{}{}
+
+		e.SetPath(path.String())
+		if m, ok := document.(errorMapper); ok {
+			stdErrors.As(m.MapError(e), &e)
+		}
+		report(e)
+	}
+}
+
+// limitedDocument is implemented by documents which are converted to JSON
+// before producing lexemes. Such documents check limits during conversion.
+type limitedDocument interface {
+	SetLimits(limits.Limits)
+}
+
+// setLimits passes the limits to the document which checks them during
+// conversion, so too large documents fail before they are fully converted.
+func setLimits(document jschema.Document, l limits.Limits) {
+	if d, ok := document.(limitedDocument); ok {
+		d.SetLimits(l)
+	}
+}
+
+// errorMapper is implemented by documents which produce lexemes from the
+// content which differs from the original one. Such documents should map
+// positions of errors back to the original content.
+type errorMapper interface {
+	MapError(error) error
+}
+
+func (s *Schema) validate(
+	ctx context.Context,
+	document jschema.Document,
+	opts internalSchema.ValidationOptions,
+) error {
+	var path documentPath
+
+	if report := opts.ReportDeprecated; report != nil {
+		opts.ReportDeprecated = deprecatedKeysReporter(document, &path, report)
+	}
+
+	rootSchema := *s.inner
+	rootSchema.SetValidationOptions(opts)
+
 	tree := validator.NewTree(
-		validator.NodeValidatorList(s.inner.RootNode(), *s.inner, nil),
+		validator.NodeValidatorList(rootSchema.RootNode(), rootSchema, nil),
 	)
 
+	var guard *limits.Guard
+	if !opts.Limits.IsZero() {
+		setLimits(document, opts.Limits)
+		guard = limits.NewGuard(opts.Limits)
+	}
+
 	empty := true
 
+	defer func() {
+		if r := recover(); r != nil {
+			if e, ok := r.(errors.DocumentError); ok {
+				e.SetPath(path.String())
+				panic(e)
+			}
+			panic(r)
+		}
+	}()
+
 	for {
+		if err := checkContext(ctx); err != nil {
+			return err
+		}
+
 		jsonLex, err := document.NextLexeme()
 		if err != nil {
 			if stdErrors.Is(err, io.EOF) {
@@ -195,6 +343,10 @@ func (s *Schema) validate(document jschema.Document) error {
 		}
 
 		empty = false
+		path.feed(jsonLex)
+		if guard != nil {
+			guard.Check(jsonLex) // can panic
+		}
 		if tree.FeedLeaves(jsonLex) { // can panic: error of validation
 			break
 		}
@@ -206,6 +358,10 @@ func (s *Schema) validate(document jschema.Document) error {
 
 	// check for error: Invalid non-space byte after top-level value
 	for {
+		if err := checkContext(ctx); err != nil {
+			return err
+		}
+
 		_, err := document.NextLexeme()
 		if err != nil {
 			if stdErrors.Is(err, io.EOF) {
@@ -241,6 +397,7 @@ func (s *Schema) load() error {
 			scanner.New(s.file),
 			nil,
 			s.rules,
+			s.registry,
 		)
 		s.inner = &sc
 		s.astNode = s.buildASTNode()
@@ -281,13 +438,18 @@ func (c *userTypesCollector) collect(node internalSchema.Node) {
 	c.collectUserTypesFromTypesListConstraint(node)
 	c.collectUserTypesFromTypeConstraint(node)
 	c.collectUserTypesFromAllOfConstraint(node)
+	c.collectUserTypesFromNotConstraint(node)
 
 	switch n := node.(type) {
 	case *internalSchema.ObjectNode:
 		c.collectUserTypesFromAdditionalPropertiesOfConstraint(node)
+		c.collectUserTypesFromPropertyNamesConstraint(node)
+		c.collectUserTypesFromWhenConstraint(node)
 		c.collectUserTypesObjectNode(n)
 
 	case *internalSchema.ArrayNode:
+		c.collectUserTypesFromAdditionalItemsConstraint(node)
+		c.collectUserTypesFromContainsConstraint(node)
 		for _, child := range n.Children() {
 			c.collect(child)
 		}
@@ -355,6 +517,17 @@ func (c *userTypesCollector) collectUserTypesFromAllOfConstraint(node internalSc
 	}
 }
 
+func (c *userTypesCollector) collectUserTypesFromNotConstraint(node internalSchema.Node) {
+	not, ok := node.Constraint(constraint.NotConstraintType).(*constraint.Not)
+	if !ok || !not.HasType() {
+		return
+	}
+
+	if name := not.TypeName(); name[0] == '@' {
+		c.addType(name)
+	}
+}
+
 func (c *userTypesCollector) collectUserTypesFromAdditionalPropertiesOfConstraint(node internalSchema.Node) {
 	cnstr := node.Constraint(constraint.AdditionalPropertiesConstraintType)
 	if c == nil {
@@ -371,6 +544,44 @@ func (c *userTypesCollector) collectUserTypesFromAdditionalPropertiesOfConstrain
 	}
 }
 
+func (c *userTypesCollector) collectUserTypesFromAdditionalItemsConstraint(node internalSchema.Node) {
+	ai, ok := node.Constraint(constraint.AdditionalItemsConstraintType).(*constraint.AdditionalItems)
+	if ok && ai.Mode() == constraint.AdditionalPropertiesMustBeUserType {
+		c.addType(ai.TypeName().String())
+	}
+}
+
+func (c *userTypesCollector) collectUserTypesFromContainsConstraint(node internalSchema.Node) {
+	cc, ok := node.Constraint(constraint.ContainsConstraintType).(*constraint.Contains)
+	if !ok || !cc.HasType() {
+		return
+	}
+
+	if name := cc.TypeName(); name[0] == '@' {
+		c.addType(name)
+	}
+}
+
+func (c *userTypesCollector) collectUserTypesFromPropertyNamesConstraint(node internalSchema.Node) {
+	pn, ok := node.Constraint(constraint.PropertyNamesConstraintType).(*constraint.PropertyNames)
+	if !ok || pn.TypeName() == nil {
+		return
+	}
+
+	c.addType(pn.TypeName().String())
+}
+
+func (c *userTypesCollector) collectUserTypesFromWhenConstraint(node internalSchema.Node) {
+	w, ok := node.Constraint(constraint.WhenConstraintType).(*constraint.When)
+	if !ok {
+		return
+	}
+
+	for _, cond := range w.Conditions() {
+		c.addType(cond.Then)
+	}
+}
+
 func (c *userTypesCollector) collectUserTypesObjectNode(node *internalSchema.ObjectNode) {
 	for _, v := range node.Keys().Data {
 		k := v.Key
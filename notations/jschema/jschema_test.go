@@ -1,16 +1,23 @@
 package jschema
 
 import (
+	"context"
 	stdErrors "errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/davecgh/go-spew/spew"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	jschema "github.com/jsightapi/jsight-schema-go-library"
+	"github.com/jsightapi/jsight-schema-go-library/errors"
+	"github.com/jsightapi/jsight-schema-go-library/formats/cbor"
+	"github.com/jsightapi/jsight-schema-go-library/formats/form"
 	"github.com/jsightapi/jsight-schema-go-library/formats/json"
+	"github.com/jsightapi/jsight-schema-go-library/formats/msgpack"
+	"github.com/jsightapi/jsight-schema-go-library/formats/yaml"
 	"github.com/jsightapi/jsight-schema-go-library/internal/mocks"
 	schemaMocks "github.com/jsightapi/jsight-schema-go-library/notations/jschema/internal/mocks"
 	internalSchema "github.com/jsightapi/jsight-schema-go-library/notations/jschema/internal/schema"
@@ -356,6 +363,96 @@ func TestSchema_AddRule(t *testing.T) {
 	})
 }
 
+func TestWithRegistry(t *testing.T) {
+	r := jschema.NewRegistry()
+	require.NoError(t, r.AddRule("country", jschema.CustomRule{
+		IsJsonTypeCompatible: func(t jschema.SchemaType) bool {
+			return t == jschema.SchemaTypeString
+		},
+		Validate: func(ruleValue interface{}, value []byte) error {
+			if string(value) != `"DE"` && string(value) != `"FR"` {
+				return stdErrors.New("unknown country")
+			}
+			return nil
+		},
+	}))
+	require.NoError(t, r.AddType("iban", jschema.CustomType{
+		Validate: func(value []byte) error {
+			if len(value) < 7 {
+				return stdErrors.New("too short")
+			}
+			return nil
+		},
+	}))
+
+	t.Run("positive", func(t *testing.T) {
+		cc := map[string]string{
+			`"DE" // {country: true}`:                        `"FR"`,
+			`"DE89370400" // {type: "iban"}`:                 `"GB29NWBK"`,
+			`"DE89370400" // {type: "iban", maxLength: 12}`:  `"GB29NWBK"`,
+			`"DE89370400" // {or: ["iban", "integer"]}`:      `42`,
+			`"DE89370400" // {or: [{type: "iban"}, "@foo"]}`: `"GB29NWBK"`,
+		}
+
+		for given, document := range cc {
+			t.Run(given, func(t *testing.T) {
+				s := New("schema", given, WithRegistry(r))
+				require.NoError(t, s.AddType("@foo", New("@foo", `42`)))
+
+				require.NoError(t, s.Check())
+				assert.NoError(t, s.Validate(json.New("json", document)))
+			})
+		}
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		t.Run("check", func(t *testing.T) {
+			cc := map[string]string{
+				`"PL" // {country: true}`: `ERROR (code 642): The value doesn't match the "country" rule (unknown country)
+	in line 1 on file schema
+	> "PL" // {country: true}
+	--^`,
+				`42 // {country: true}`: `ERROR (code 1117): The "country" constraint can't be used for the "integer" type
+	in line 1 on file schema
+	> 42 // {country: true}
+	--^`,
+				`"DE" // {type: "iban"}`: `ERROR (code 643): Invalid value of the "iban" type (too short)
+	in line 1 on file schema
+	> "DE" // {type: "iban"}
+	--^`,
+			}
+
+			for given, expected := range cc {
+				t.Run(given, func(t *testing.T) {
+					err := New("schema", given, WithRegistry(r)).Check()
+					assert.EqualError(t, err, expected)
+				})
+			}
+		})
+
+		t.Run("validate", func(t *testing.T) {
+			cc := map[string]string{
+				`"DE" // {country: true}`: `ERROR (code 642): The value doesn't match the "country" rule (unknown country)
+	in line 1 on file json
+	> "PL"
+	--^`,
+				`"DE89370400" // {type: "iban"}`: `ERROR (code 643): Invalid value of the "iban" type (too short)
+	in line 1 on file json
+	> "PL"
+	--^`,
+			}
+
+			for given, expected := range cc {
+				t.Run(given, func(t *testing.T) {
+					s := New("schema", given, WithRegistry(r))
+					err := s.Validate(json.New("json", `"PL"`))
+					assert.EqualError(t, err, expected)
+				})
+			}
+		})
+	})
+}
+
 //goland:noinspection HttpUrlsUsage
 func TestSchema_Check(t *testing.T) {
 	t.Run("positive", func(t *testing.T) {
@@ -366,13 +463,70 @@ func TestSchema_Check(t *testing.T) {
 			`{"foo": "bar"}`:         {},
 			`{} // {type: "object"}`: {},
 			`[] // {type: "array"}`:  {},
+			`{
+  "id": 1 // {readOnly: true, title: "Id", description: "The identifier", examples: [2, 3]}
+}`: {},
+			`{
+  "fax": "" // {deprecated: true, optional: true, nullable: true}
+}`: {},
+			`"GET" // {enum: ["GET", "POST"], deprecated: true, title: "Method"}`: {},
+			`{
+  "method": "GET", // {enum: ["GET", "POST"], optional: true, default: "POST"}
+  "id": @id, // {optional: true, default: 1}
+  "size": 1 // {or: ["integer", "string"], optional: true, default: "M"}
+}`: {
+				types: map[string]string{
+					"@id": `1 // {min: 1}`,
+				},
+			},
+			`"foo" // {or: ["string", "integer"], writeOnly: true}`: {},
+			`{ // {examples: ["foo", 42, true, null]}
+  "foo": @bar // {title: "Bar", readOnly: true}
+}`: {
+				types: map[string]string{
+					"@bar": `"bar"`,
+				},
+			},
 			"@foo": {
 				types: map[string]string{
 					"@foo": `{"foo": "bar"}`,
 				},
 			},
-			`{} // {or: [{type: "object"}, {type: "array"}]}`:     {},
-			`[] // {or: [{type: "object"}, {type: "array"}]}`:     {},
+			`{} // {or: [{type: "object"}, {type: "array"}]}`:                              {},
+			"20 // {multipleOf: 10, min: 11, max: 20}":                                     {},
+			"-20 // {multipleOf: 10, min: -25, max: -15}":                                  {},
+			"0.6 // {multipleOf: 0.3, min: 0, max: 1, exclusiveMinimum: true}":             {},
+			`0.3 // {type: "decimal", precision: 1, multipleOf: 0.15, min: 0.2, max: 0.3}`: {},
+			`[] // {or: [{type: "object"}, {type: "array"}]}`:                              {},
+			"{ // {minProperties: 1, maxProperties: 1}\n  \"foo\": 1\n}":                   {},
+			"{ // {minProperties: 2, additionalProperties: true}\n  \"foo\": 1\n}":         {},
+			`{} // {additionalProperties: true, propertyNames: "^[a-z]+$"}`:                {},
+			`{} // {additionalProperties: true, propertyNames: "@key"}`: {
+				types: map[string]string{
+					"@key": `"foo" // {regex: "^[a-z]+$"}`,
+				},
+			},
+			`{} // {additionalProperties: true, dependentRequired: {"foo": ["bar", "baz"]}}`: {},
+			`{} // {additionalProperties: true, when: {key: "type", const: 1, then: "@foo"}}`: {
+				types: map[string]string{
+					"@foo": `{} // {additionalProperties: true}`,
+				},
+			},
+			`@cat | @dog // {discriminator: "kind"}`: {
+				types: map[string]string{
+					"@cat": "{\n  \"kind\": \"cat\" // {const: true}\n}",
+					"@dog": "{\n  \"kind\": \"dog\", // {const: true}\n  \"breed\": \"beagle\"\n}",
+				},
+			},
+			`42 // {or: [{type: "integer", min: 0}, {type: "string"}], exclusive: true}`:  {},
+			`42 // {or: [{type: "integer", min: 0}, {type: "string"}], exclusive: false}`: {},
+			`"user" // {not: "admin"}`:                   {},
+			`"user" // {not: {enum: ["admin", "root"]}}`: {},
+			`"user" // {not: "@admin"}`: {
+				types: map[string]string{
+					"@admin": `"admin" // {const: true}`,
+				},
+			},
 			`{} // {or: [{type: "object"}, {type: "string"}]}`:    {},
 			`"foo" // {or: [{type: "object"}, {type: "string"}]}`: {},
 			`[] // {or: [{type: "array"}, {type: "string"}]}`:     {},
@@ -535,6 +689,30 @@ func TestSchema_Check(t *testing.T) {
 	"foo": "2021-01-08T12:50:45+06:00" // {type: "datetime"}
 }`: {},
 
+			`"192.168.0.1" // {type: "ipv4"}`:                                                {},
+			`"2001:db8::1" // {type: "ipv6"}`:                                                {},
+			`"10.0.0.0/8" // {type: "cidr"}`:                                                 {},
+			`"bücher.example" // {type: "hostname"}`:                                         {},
+			`"08:30:00" // {type: "time"}`:                                                   {},
+			`"P1Y2M10DT2H30M" // {type: "duration"}`:                                         {},
+			`"aGVsbG8=" // {type: "base64"}`:                                                 {},
+			`"aGVsbG8" // {type: "base64url"}`:                                               {},
+			`"48656c6c6f" // {type: "hex"}`:                                                  {},
+			`"1.0.0-rc.1" // {type: "semver"}`:                                               {},
+			`"en-US" // {type: "language"}`:                                                  {},
+			`2147483647 // {type: "int32"}`:                                                  {},
+			`-9223372036854775808 // {type: "int64", max: 0}`:                                {},
+			`18446744073709551615 // {type: "uint64", min: 1}`:                               {},
+			`9007199254740991 // {or: ["safeInteger", "string"]}`:                            {},
+			`"1980-05-17" // {type: "date", min: "1900-01-01", max: "2100-01-01"}`:           {},
+			`"2022-01-01T00:00:00Z" // {type: "datetime", min: "2022-01-01T03:00:00+03:00"}`: {},
+			`"2022-01-01T00:00:01Z" // {type: "datetime", min: "2022-01-01T03:00:00+03:00", exclusiveMinimum: true}`: {},
+			`"\u00E9t\u00E9" // {minLength: 3, maxLength: 3}`:                                                        {},
+			`"\uD83E\uDD10" // {minLength: 2, lengthUnit: "utf16"}`:                                                  {},
+			`"\uD83C\uDDE9\uD83C\uDDEA" // {maxLength: 1, lengthUnit: "graphemes"}`:                                  {},
+			`"10.0.0.1" // {or: [{type: "ipv4"}, {type: "ipv6"}]}`:                                                   {},
+			`"2001:db8::1" // {or: ["ipv4", "ipv6"], nullable: true}`:                                                {},
+
 			`{
   "id1": 1, // {type: "@id", nullable: true}
   "id2": @id, // {nullable: true}
@@ -750,6 +928,60 @@ func TestSchema_Check(t *testing.T) {
 			rules map[string]string
 			given string
 		}{
+			`ERROR (code 1124): The "default" rule can only be used for optional object properties
+	in line 1 on file 
+	> 42 // {default: 42}
+	--^`: {
+				given: `42 // {default: 42}`,
+			},
+			`ERROR (code 1124): The "default" rule can only be used for optional object properties
+	in line 2 on file 
+	> "limit": 10 // {default: 20}
+	-----------^`: {
+				given: `{
+  "limit": 10 // {default: 20}
+}`,
+			},
+			`ERROR (code 1217): The default value "foo" isn't valid for the node
+	in line 2 on file 
+	> "limit": 10 // {optional: true, default: "foo"}
+	-----------^`: {
+				given: `{
+  "limit": 10 // {optional: true, default: "foo"}
+}`,
+			},
+			`ERROR (code 1217): The default value 200 isn't valid for the node
+	in line 2 on file 
+	> "limit": 10 // {optional: true, max: 100, default: 200}
+	-----------^`: {
+				given: `{
+  "limit": 10 // {optional: true, max: 100, default: 200}
+}`,
+			},
+			`ERROR (code 1123): The "readOnly" and "writeOnly" rules cannot be used together
+	in line 1 on file 
+	> 42 // {readOnly: true, writeOnly: true}
+	--^`: {
+				given: `42 // {readOnly: true, writeOnly: true}`,
+			},
+			`ERROR (code 604): Invalid value of "title" constraint
+	in line 1 on file 
+	> 42 // {title: 42}
+	----------------^`: {
+				given: `42 // {title: 42}`,
+			},
+			`ERROR (code 816): An array of literals was expected as a value for the "examples" rule
+	in line 1 on file 
+	> 42 // {examples: 42}
+	-------------------^`: {
+				given: `42 // {examples: 42}`,
+			},
+			`ERROR (code 816): An array of literals was expected as a value for the "examples" rule
+	in line 1 on file 
+	> 42 // {examples: [{}]}
+	--------------------^`: {
+				given: `42 // {examples: [{}]}`,
+			},
 			`ERROR (code 301): Invalid character "i" looking for beginning of value
 	in line 1 on file 
 	> invalid
@@ -918,6 +1150,118 @@ func TestSchema_Check(t *testing.T) {
 }`,
 			},
 
+			`ERROR (code 631): Invalid IPv4 address (ParseAddr("1.2.3"): IPv4 address too short)
+	in line 1 on file 
+	> "1.2.3" // {type: "ipv4"}
+	--^`: {
+				given: `"1.2.3" // {type: "ipv4"}`,
+			},
+
+			`ERROR (code 635): Time parsing error (hour out of range)
+	in line 1 on file 
+	> "24:00:00" // {type: "time"}
+	--^`: {
+				given: `"24:00:00" // {type: "time"}`,
+			},
+
+			`ERROR (code 1117): The "maxLength" constraint can't be used for the "hostname" type
+	in line 1 on file 
+	> "example.com" // {type: "hostname", maxLength: 253}
+	--^`: {
+				given: `"example.com" // {type: "hostname", maxLength: 253}`,
+			},
+
+			`ERROR (code 644): The value is out of range of the "int32" type (from -2147483648 to 2147483647)
+	in line 1 on file 
+	> 2147483648 // {type: "int32"}
+	--^`: {
+				given: `2147483648 // {type: "int32"}`,
+			},
+
+			`ERROR (code 1125): The value of the "max" rule is out of range of the "uint32" type
+	in line 1 on file 
+	> 1 // {type: "uint32", max: 4294967296}
+	--^`: {
+				given: `1 // {type: "uint32", max: 4294967296}`,
+			},
+
+			`ERROR (code 1125): The value of the "min" rule is out of range of the "safeInteger" type
+	in line 1 on file 
+	> 1 // {type: "safeInteger", min: -9007199254740992}
+	--^`: {
+				given: `1 // {type: "safeInteger", min: -9007199254740992}`,
+			},
+
+			`ERROR (code 602): Invalid value for "min" = "1900-01-01" constraint 
+	in line 1 on file 
+	> "1800-01-01" // {type: "date", min: "1900-01-01"}
+	--^`: {
+				given: `"1800-01-01" // {type: "date", min: "1900-01-01"}`,
+			},
+
+			`ERROR (code 602): Invalid value for "max" = "2022-01-01T03:00:00+03:00" constraint (exclusive)
+	in line 1 on file 
+	> "2022-01-01T00:00:00Z" // {type: "datetime", max: "2022-01-01T03:00:00+03:00", exclusiveMaximum: true}
+	--^`: {
+				given: `"2022-01-01T00:00:00Z" // {type: "datetime", max: "2022-01-01T03:00:00+03:00", exclusiveMaximum: true}`,
+			},
+
+			`ERROR (code 604): Invalid value of "min" constraint
+	in line 1 on file 
+	> "2000-01-01" // {type: "date", min: "yesterday"}
+	--------------------------------------^`: {
+				given: `"2000-01-01" // {type: "date", min: "yesterday"}`,
+			},
+
+			`ERROR (code 617): Value of constraint "min" should be less or equal to value of "max" constraint
+	in line 1 on file 
+	> "2000-01-01" // {type: "date", min: "2001-01-01", max: "2000-01-01"}
+	--^`: {
+				given: `"2000-01-01" // {type: "date", min: "2001-01-01", max: "2000-01-01"}`,
+			},
+
+			`ERROR (code 1119): The "min" rule with the date value can only be used with the "date" and "datetime" types
+	in line 1 on file 
+	> "abc" // {min: "2000-01-01"}
+	--^`: {
+				given: `"abc" // {min: "2000-01-01"}`,
+			},
+
+			`ERROR (code 1120): The value of the "max" rule should be a datetime value
+	in line 1 on file 
+	> "2000-01-01T00:00:00Z" // {type: "datetime", max: "2100-01-01"}
+	--^`: {
+				given: `"2000-01-01T00:00:00Z" // {type: "datetime", max: "2100-01-01"}`,
+			},
+
+			`ERROR (code 603): Invalid string length for "maxLength" = "2" constraint
+	in line 1 on file 
+	> "e\u0301t" // {maxLength: 2}
+	--^`: {
+				given: `"e\u0301t" // {maxLength: 2}`,
+			},
+
+			`ERROR (code 604): Invalid value of "lengthUnit" constraint
+	in line 1 on file 
+	> "abc" // {maxLength: 3, lengthUnit: "utf8"}
+	--------------------------------------^`: {
+				given: `"abc" // {maxLength: 3, lengthUnit: "utf8"}`,
+			},
+
+			`ERROR (code 1121): The "lengthUnit" rule can only be used together with the "minLength" or "maxLength" rules
+	in line 1 on file 
+	> "abc" // {lengthUnit: "bytes"}
+	--^`: {
+				given: `"abc" // {lengthUnit: "bytes"}`,
+			},
+
+			`ERROR (code 1115): Incompatible value of example and "type" rule (semver)
+	in line 1 on file 
+	> 1 // {type: "semver"}
+	--^`: {
+				given: `1 // {type: "semver"}`,
+			},
+
 			`ERROR (code 1302): Type "@petName" not found
 	in line 3 on file 
 	> @petName: @cat
@@ -1478,112 +1822,386 @@ func TestSchema_Check(t *testing.T) {
   ]`,
 			},
 
-			`ERROR (code 603): Invalid string length for "minLength" = "4" constraint
+			`ERROR (code 619): Array items should be unique, but the item duplicates the item at index 0
+	in line 3 on file 
+	> 1.0
+	--^`: {
+				given: `[ // {uniqueItems: true}
+  1,
+  1.0
+]`,
+			},
+
+			`ERROR (code 620): The "id" property of array items should be unique, but the item duplicates the item at index 0
+	in line 3 on file 
+	> {"id": 1}
+	--^`: {
+				given: `[ // {uniqueItems: "id"}
+  {"id": 1, "name": "foo"},
+  {"id": 1}
+]`,
+			},
+
+			`ERROR (code 621): There is no value which is a multiple of 10 between values of "min" and "max" constraints
 	in line 1 on file 
-	> "foo" // {minLength: 4, maxLength: 5}
+	> 20 // {multipleOf: 10, min: 11, max: 19}
 	--^`: {
-				given: `"foo" // {minLength: 4, maxLength: 5}`,
+				given: "20 // {multipleOf: 10, min: 11, max: 19}",
 			},
 
-			`ERROR (code 603): Invalid string length for "maxLength" = "2" constraint
+			`ERROR (code 621): There is no value which is a multiple of 10 between values of "min" and "max" constraints
 	in line 1 on file 
-	> "foo" // {minLength: 1, maxLength: 2}
+	> 20 // {multipleOf: 10, min: 10, max: 20, exclusiveMinimum: true, exclusiveMaximum: true}
 	--^`: {
-				given: `"foo" // {minLength: 1, maxLength: 2}`,
+				given: "20 // {multipleOf: 10, min: 10, max: 20, exclusiveMinimum: true, exclusiveMaximum: true}",
 			},
 
-			`ERROR (code 1304): Key shortcut "@foo" should be string but "integer" given
-	in line 2 on file 
-	> @foo: 42
+			`ERROR (code 621): There is no value which is a multiple of 0.5 between values of "min" and "max" constraints
+	in line 1 on file 
+	> 2 // {multipleOf: 0.5, min: 1.1, max: 1.9}
 	--^`: {
-				given: `{
-	@foo: 42
-}`,
-				types: map[string]string{
-					"@foo": "42",
-				},
+				given: "2 // {multipleOf: 0.5, min: 1.1, max: 1.9}",
 			},
 
-			`ERROR (code 1304): Key shortcut "@foo" should be string but "float" given
-	in line 2 on file 
-	> @foo: 42
+			`ERROR (code 621): There is no value which is a multiple of 0.3 between values of "min" and "max" constraints
+	in line 1 on file 
+	> 0.6 // {type: "decimal", precision: 1, multipleOf: 0.3, min: -0.5, max: -0.4}
 	--^`: {
-				given: `{
-	@foo: 42
-}`,
-				types: map[string]string{
-					"@foo": "3.14",
-				},
+				given: `0.6 // {type: "decimal", precision: 1, multipleOf: 0.3, min: -0.5, max: -0.4}`,
 			},
 
-			`ERROR (code 1304): Key shortcut "@foo" should be string but "boolean" given
-	in line 2 on file 
-	> @foo: 42
+			`ERROR (code 602): Invalid value for "multipleOf" = 0.05 constraint 
+	in line 1 on file 
+	> 0.12 // {multipleOf: 0.05}
 	--^`: {
-				given: `{
-	@foo: 42
-}`,
-				types: map[string]string{
-					"@foo": "true",
-				},
+				given: "0.12 // {multipleOf: 0.05}",
 			},
 
-			`ERROR (code 1304): Key shortcut "@foo" should be string but "null" given
-	in line 2 on file 
-	> @foo: 42
+			`ERROR (code 604): Invalid value of "multipleOf" constraint
+	in line 1 on file 
+	> 0 // {multipleOf: 0}
+	--------------------^`: {
+				given: "0 // {multipleOf: 0}",
+			},
+
+			`ERROR (code 604): Invalid value of "multipleOf" constraint
+	in line 1 on file 
+	> 1 // {multipleOf: "1"}
+	--------------------^`: {
+				given: `1 // {multipleOf: "1"}`,
+			},
+
+			`ERROR (code 604): Invalid value of "uniqueItems" constraint
+	in line 1 on file 
+	> [ // {uniqueItems: 1}
+	---------------------^`: {
+				given: `[ // {uniqueItems: 1}
+  1
+]`,
+			},
+
+			`ERROR (code 617): Value of constraint "minProperties" should be less or equal to value of "maxProperties" constraint
+	in line 1 on file 
+	> { // {minProperties: 2, maxProperties: 1}
 	--^`: {
-				given: `{
-	@foo: 42
+				given: `{ // {minProperties: 2, maxProperties: 1}
+  "foo": 1
 }`,
-				types: map[string]string{
-					"@foo": "null",
-				},
 			},
 
-			`ERROR (code 1304): Key shortcut "@foo" should be string but "array" given
-	in line 2 on file 
-	> @foo: 42
+			`ERROR (code 622): The number of object properties does not match the "minProperties" rule
+	in line 1 on file 
+	> { // {minProperties: 2}
 	--^`: {
-				given: `{
-	@foo: 42
+				given: `{ // {minProperties: 2}
+  "foo": 1
 }`,
-				types: map[string]string{
-					"@foo": "[1,2,3]",
-				},
 			},
 
-			`ERROR (code 1304): Key shortcut "@foo" should be string but "object" given
-	in line 2 on file 
-	> @foo: 42
+			`ERROR (code 623): The number of object properties does not match the "maxProperties" rule
+	in line 1 on file 
+	> { // {maxProperties: 1}
 	--^`: {
-				given: `{
-	@foo: 42
+				given: `{ // {maxProperties: 1}
+  "foo": 1,
+  "bar": 2
 }`,
-				types: map[string]string{
-					"@foo": `{"fizz": "buzz"}`,
-				},
 			},
 
-			`ERROR (code 402): Duplicate keys (@catId) in the schema
-	in line 4 on file 
-	> "@catId": 3,
+			`ERROR (code 604): Invalid value of "propertyNames" constraint
+	in line 1 on file 
+	> {} // {propertyNames: 42}
+	------------------------^`: {
+				given: `{} // {propertyNames: 42}`,
+			},
+
+			`ERROR (code 1205): The "@key" type in the "propertyNames" rule should be a string type
+	in line 1 on file 
+	> {} // {additionalProperties: true, propertyNames: "@key"}
 	--^`: {
-				given: `{
-  "@catId": 1,
-  @catId: 2,
-  "@catId": 3,
-  @catId: 4
-}`,
+				given: `{} // {additionalProperties: true, propertyNames: "@key"}`,
 				types: map[string]string{
-					"@catId": `"12" // A cat's id.`,
+					"@key": "42",
 				},
 			},
 
-			`ERROR (code 810): "\u0061" value duplicates in "enum"
+			`ERROR (code 811): An object with lists of key names was expected as a value for the "dependentRequired" rule
 	in line 1 on file 
-	> "a" // {enum: ["a", "\u0061"]}
-	----------------------^`: {
-				given: `"a" // {enum: ["a", "\u0061"]}`,
+	> {} // {dependentRequired: ["foo"]}
+	----------------------------^`: {
+				given: `{} // {dependentRequired: ["foo"]}`,
+			},
+
+			`ERROR (code 811): An object with lists of key names was expected as a value for the "dependentRequired" rule
+	in line 1 on file 
+	> {} // {dependentRequired: {"foo": [1]}}
+	-------------------------------------^`: {
+				given: `{} // {dependentRequired: {"foo": [1]}}`,
+			},
+
+			`ERROR (code 812): A condition, or list of conditions, with "key", "const" and "then" properties was expected as a value for the "when" rule
+	in line 1 on file 
+	> {} // {when: {key: "type", const: "card", then: "card"}}
+	--------------------------------------------------^`: {
+				given: `{} // {when: {key: "type", const: "card", then: "card"}}`,
+			},
+
+			`ERROR (code 812): A condition, or list of conditions, with "key", "const" and "then" properties was expected as a value for the "when" rule
+	in line 1 on file 
+	> {} // {when: []}
+	---------------^`: {
+				given: `{} // {when: []}`,
+			},
+
+			`ERROR (code 1206): The key "bar" in the "dependentRequired" rule isn't defined in the object
+	in line 1 on file 
+	> { // {dependentRequired: {"foo": ["bar"]}}
+	--^`: {
+				given: `{ // {dependentRequired: {"foo": ["bar"]}}
+  "foo": 1
+}`,
+			},
+
+			`ERROR (code 1207): The key "foo" can't depend on itself in the "dependentRequired" rule
+	in line 1 on file 
+	> { // {dependentRequired: {"foo": ["foo"]}}
+	--^`: {
+				given: `{ // {dependentRequired: {"foo": ["foo"]}}
+  "foo": 1
+}`,
+			},
+
+			`ERROR (code 1208): The key "type" in the "when" rule isn't defined in the object
+	in line 1 on file 
+	> { // {when: {key: "type", const: "card", then: "@card"}}
+	--^`: {
+				given: `{ // {when: {key: "type", const: "card", then: "@card"}}
+  "kind": "card"
+}`,
+				types: map[string]string{
+					"@card": `{}`,
+				},
+			},
+
+			`ERROR (code 1209): The value "card" in the "when" rule isn't valid for the key "type"
+	in line 1 on file 
+	> { // {when: {key: "type", const: "card", then: "@card"}}
+	--^`: {
+				given: `{ // {when: {key: "type", const: "card", then: "@card"}}
+  "type": 1
+}`,
+				types: map[string]string{
+					"@card": `{}`,
+				},
+			},
+
+			`ERROR (code 1210): The "when" rule has several conditions for the key "type" equal to "c\u0061rd"
+	in line 1 on file 
+	> { // {when: [{key: "type", const: "card", then: "@card"}, {key: "type", const: "c\u0061rd", then: "@card"}]}
+	--^`: {
+				given: `{ // {when: [{key: "type", const: "card", then: "@card"}, {key: "type", const: "c\u0061rd", then: "@card"}]}
+  "type": "card"
+}`,
+				types: map[string]string{
+					"@card": `{}`,
+				},
+			},
+
+			`ERROR (code 1211): The "@card" type in the "when" rule should be an object type
+	in line 1 on file 
+	> { // {when: {key: "type", const: "card", then: "@card"}}
+	--^`: {
+				given: `{ // {when: {key: "type", const: "card", then: "@card"}}
+  "type": "card"
+}`,
+				types: map[string]string{
+					"@card": `"card"`,
+				},
+			},
+
+			`ERROR (code 1118): The "discriminator" rule can only be used together with the "or" rule
+	in line 1 on file 
+	> {} // {discriminator: "kind"}
+	--^`: {
+				given: `{} // {discriminator: "kind"}`,
+			},
+
+			`ERROR (code 1118): The "exclusive" rule can only be used together with the "or" rule
+	in line 1 on file 
+	> 42 // {exclusive: true}
+	--^`: {
+				given: `42 // {exclusive: true}`,
+			},
+
+			`ERROR (code 813): A value, a user type name or a rule-set was expected as a value for the "not" rule
+	in line 1 on file 
+	> "user" // {not: [1]}
+	------------------^`: {
+				given: `"user" // {not: [1]}`,
+			},
+
+			`ERROR (code 1215): The example value is excluded by the "not" rule
+	in line 1 on file 
+	> "user" // {not: "user"}
+	--^`: {
+				given: `"user" // {not: "user"}`,
+			},
+
+			`ERROR (code 1216): The "not" rule excludes all values allowed by the node
+	in line 1 on file 
+	> "user" // {not: {type: "string"}}
+	--^`: {
+				given: `"user" // {not: {type: "string"}}`,
+			},
+
+			`ERROR (code 1212): The "@dog" type should be an object with the "kind" key with the "const" rule to be used with the "discriminator" rule
+	in line 1 on file 
+	> @cat | @dog // {discriminator: "kind"}
+	--^`: {
+				given: `@cat | @dog // {discriminator: "kind"}`,
+				types: map[string]string{
+					"@cat": "{\n  \"kind\": \"cat\" // {const: true}\n}",
+					"@dog": `{"kind": "dog"}`,
+				},
+			},
+
+			`ERROR (code 1213): The "@cat" and "@dog" types have the same value "cat" of the discriminator key "kind"
+	in line 1 on file 
+	> @cat | @dog // {discriminator: "kind"}
+	--^`: {
+				given: `@cat | @dog // {discriminator: "kind"}`,
+				types: map[string]string{
+					"@cat": "{\n  \"kind\": \"cat\" // {const: true}\n}",
+					"@dog": "{\n  \"kind\": \"cat\" // {const: true}\n}",
+				},
+			},
+
+			`ERROR (code 603): Invalid string length for "minLength" = "4" constraint
+	in line 1 on file 
+	> "foo" // {minLength: 4, maxLength: 5}
+	--^`: {
+				given: `"foo" // {minLength: 4, maxLength: 5}`,
+			},
+
+			`ERROR (code 603): Invalid string length for "maxLength" = "2" constraint
+	in line 1 on file 
+	> "foo" // {minLength: 1, maxLength: 2}
+	--^`: {
+				given: `"foo" // {minLength: 1, maxLength: 2}`,
+			},
+
+			`ERROR (code 1304): Key shortcut "@foo" should be string but "integer" given
+	in line 2 on file 
+	> @foo: 42
+	--^`: {
+				given: `{
+	@foo: 42
+}`,
+				types: map[string]string{
+					"@foo": "42",
+				},
+			},
+
+			`ERROR (code 1304): Key shortcut "@foo" should be string but "float" given
+	in line 2 on file 
+	> @foo: 42
+	--^`: {
+				given: `{
+	@foo: 42
+}`,
+				types: map[string]string{
+					"@foo": "3.14",
+				},
+			},
+
+			`ERROR (code 1304): Key shortcut "@foo" should be string but "boolean" given
+	in line 2 on file 
+	> @foo: 42
+	--^`: {
+				given: `{
+	@foo: 42
+}`,
+				types: map[string]string{
+					"@foo": "true",
+				},
+			},
+
+			`ERROR (code 1304): Key shortcut "@foo" should be string but "null" given
+	in line 2 on file 
+	> @foo: 42
+	--^`: {
+				given: `{
+	@foo: 42
+}`,
+				types: map[string]string{
+					"@foo": "null",
+				},
+			},
+
+			`ERROR (code 1304): Key shortcut "@foo" should be string but "array" given
+	in line 2 on file 
+	> @foo: 42
+	--^`: {
+				given: `{
+	@foo: 42
+}`,
+				types: map[string]string{
+					"@foo": "[1,2,3]",
+				},
+			},
+
+			`ERROR (code 1304): Key shortcut "@foo" should be string but "object" given
+	in line 2 on file 
+	> @foo: 42
+	--^`: {
+				given: `{
+	@foo: 42
+}`,
+				types: map[string]string{
+					"@foo": `{"fizz": "buzz"}`,
+				},
+			},
+
+			`ERROR (code 402): Duplicate keys (@catId) in the schema
+	in line 4 on file 
+	> "@catId": 3,
+	--^`: {
+				given: `{
+  "@catId": 1,
+  @catId: 2,
+  "@catId": 3,
+  @catId: 4
+}`,
+				types: map[string]string{
+					"@catId": `"12" // A cat's id.`,
+				},
+			},
+
+			`ERROR (code 810): "\u0061" value duplicates in "enum"
+	in line 1 on file 
+	> "a" // {enum: ["a", "\u0061"]}
+	----------------------^`: {
+				given: `"a" // {enum: ["a", "\u0061"]}`,
 			},
 
 			`ERROR (code 610): Does not match any of the enumeration values
@@ -1932,6 +2550,49 @@ func TestSchema_Validate(t *testing.T) {
 				},
 			},
 
+			"enum with numbers": {
+				schema: `1.5 // {enum: [1, 1.5]}`,
+				jsons: []string{
+					"1.0",
+					"1e0",
+					"15e-1",
+				},
+			},
+
+			"const with number": {
+				schema: `10.0 // {const: true}`,
+				jsons: []string{
+					"10",
+					"10.00",
+					"1e1",
+				},
+			},
+
+			"enum with escaped strings": {
+				schema: `"ab" // {enum: ["ab", "cd"]}`,
+				jsons: []string{
+					`"\u0061b"`,
+					`"c\u0064"`,
+				},
+			},
+
+			"enum with Unicode normalization": {
+				schema: `"\u00E9" // {enum: ["\u00E9", "\u00C5"], unicodeNormalization: "NFC"}`,
+				jsons: []string{
+					`"e\u0301"`,
+					`"\u00E9"`,
+					`"A\u030A"`,
+				},
+			},
+
+			"case-insensitive const": {
+				schema: `"Stra\u00DFe" // {const: true, caseInsensitive: true}`,
+				jsons: []string{
+					`"STRASSE"`,
+					`"stra\u00DFe"`,
+				},
+			},
+
 			"Or without type": {
 				schema: `{
 	"foo": 123 /* {or: [
@@ -1948,100 +2609,1017 @@ func TestSchema_Validate(t *testing.T) {
 
 		for name, c := range cc {
 			t.Run(name, func(t *testing.T) {
-				schema := New("schema", c.schema, KeysAreOptionalByDefault())
-
-				for n, s := range c.types {
-					require.NoError(t, schema.AddType(n, New(s, s, KeysAreOptionalByDefault())))
+				schema := New("schema", c.schema, KeysAreOptionalByDefault())
+
+				for n, s := range c.types {
+					require.NoError(t, schema.AddType(n, New(s, s, KeysAreOptionalByDefault())))
+				}
+
+				for _, s := range c.jsons {
+					t.Run(s, func(t *testing.T) {
+						err := schema.Validate(json.New("json", s))
+						require.NoError(t, err)
+					})
+				}
+			})
+		}
+
+		t.Run("JSONC document", func(t *testing.T) {
+			schema := New("schema", `{
+	"foo": 1,
+	"bar": [1]
+}`)
+
+			err := schema.Validate(json.New("json", `{
+	"foo": 42, // comment
+	/* comment */
+	"bar": [1, 2, 3,],
+}`, json.JSONC()))
+			require.NoError(t, err)
+		})
+
+		t.Run("YAML document", func(t *testing.T) {
+			schema := New("schema", `{
+	"foo": 1,
+	"bar": [@fizz],
+	"buzz": null // {type: "any"}
+}`)
+			require.NoError(t, schema.AddType("@fizz", New("@fizz", `"fizz" // {regex: "^f"}`)))
+
+			err := schema.Validate(yaml.New("yaml", `# comment
+foo: 0x2A
+bar:
+  - foo
+  - 'fizz'
+buzz: {a: [1, 2]}
+`))
+			require.NoError(t, err)
+		})
+
+		t.Run("CBOR document", func(t *testing.T) {
+			schema := New("schema", `{
+	"id": 1,
+	"price": 1.5,
+	"tags": ["foo"]
+}`)
+			// {"id": 42, "price": 0.5, "tags": ["bar"]}
+			err := schema.Validate(cbor.New("cbor", []byte{
+				0xa3,
+				0x62, 'i', 'd', 0x18, 0x2a,
+				0x65, 'p', 'r', 'i', 'c', 'e', 0xf9, 0x38, 0x00,
+				0x64, 't', 'a', 'g', 's', 0x81, 0x63, 'b', 'a', 'r',
+			}))
+			require.NoError(t, err)
+		})
+
+		t.Run("MessagePack document", func(t *testing.T) {
+			schema := New("schema", `{
+	"id": 1,
+	"price": 1.5,
+	"tags": ["foo"]
+}`)
+			// {"id": 42, "price": 0.5, "tags": ["bar"]}
+			err := schema.Validate(msgpack.New("msgpack", []byte{
+				0x83,
+				0xa2, 'i', 'd', 0x2a,
+				0xa5, 'p', 'r', 'i', 'c', 'e', 0xca, 0x3f, 0x00, 0x00, 0x00,
+				0xa4, 't', 'a', 'g', 's', 0x91, 0xa3, 'b', 'a', 'r',
+			}))
+			require.NoError(t, err)
+		})
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		cc := map[string]struct {
+			schema string
+			types  map[string]string
+			json   string
+		}{
+			`ERROR (code 1302): Type "@int" not found
+	in line 2 on file schema
+	> "aaa": 111 // {type: "@int"}
+	---------^`: {
+				schema: `{
+		"aaa": 111 // {type: "@int"}
+	}`,
+			},
+
+			`ERROR (code 1302): Type "@int" not found. Did you mean "@inr"?
+	in line 2 on file schema
+	> "aaa": 111 // {type: "@int"}
+	---------^`: {
+				schema: `{
+		"aaa": 111 // {type: "@int"}
+	}`,
+				types: map[string]string{
+					"@inr": `1`,
+				},
+			},
+
+			`ERROR (code 610): Does not match any of the enumeration values
+	in line 1 on file json
+	> "e\u0301"
+	--^`: {
+				schema: `"\u00E9" // {enum: ["\u00E9", "a"]}`,
+				json:   `"e\u0301"`,
+			},
+
+			`ERROR (code 615): Does not match expected value ("GET")
+	in line 1 on file json
+	> "get"
+	--^`: {
+				schema: `"GET" // {const: true}`,
+				json:   `"get"`,
+			},
+
+			`ERROR (code 206): Schema does not support key "prise". Did you mean "price"?
+	in line 1 on file json
+	> {"name": "foo", "prise": 1}
+	------------------^`: {
+				schema: `{
+	"name": "foo",
+	"price": 1
+}`,
+				json: `{"name": "foo", "prise": 1}`,
+			},
+
+			`ERROR (code 206): Schema does not support key "prise". Did you mean "price"?
+	in line 1 on file json
+	> {"prise": 1}
+	---^`: {
+				schema: `{ // {additionalProperties: false}
+	"price": 1
+}`,
+				json: `{"prise": 1}`,
+			},
+
+			`ERROR (code 1301): Incorrect type of user type
+	in line 2 on file schema
+	> "aaa": 111 // {type: "@int"}
+	---------^`: {
+				schema: `{
+		"aaa": 111 // {type: "@int"}
+	}`,
+				types: map[string]string{
+					"@int": `"abc"`,
+				},
+			},
+
+			`ERROR (code 204): None of the rules in the "OR" set has been validated
+	in line 1 on file json
+	> {"foo": 10}
+	----------^`: {
+				schema: `{
+	"foo": 123 /* {or: [
+		{min: 100},
+		{type: "string"}
+	]} */
+}`,
+				json: `{"foo": 10}`,
+			},
+
+			`ERROR (code 204): None of the rules in the "OR" set has been validated
+	in line 1 on file json
+	> {"foo": true}
+	----------^`: {
+				schema: `{
+	"foo": 123 /* {or: [
+		{min: 100},
+		{type: "string"}
+	]} */
+}`,
+				json: `{"foo": true}`,
+			},
+
+			`ERROR (code 1117): The "precision" constraint can't be used for the "float" type
+	in line 1 on file schema
+	> 1.1 // {type: "float", precision: 2}
+	--^`: {
+				schema: `1.1 // {type: "float", precision: 2}`,
+				json:   "3.14",
+			},
+
+			`ERROR (code 619): Array items should be unique, but the item duplicates the item at index 1
+	in line 4 on file json
+	> {"b": "foo", "a": [1e1]}
+	--^`: {
+				schema: `[ // {uniqueItems: true}
+	{} // {type: "any"}
+]`,
+				json: `[
+	1,
+	{"a": [10], "b": "foo"},
+	{"b": "foo", "a": [1e1]}
+]`,
+			},
+
+			`ERROR (code 620): The "user.id" property of array items should be unique, but the item duplicates the item at index 0
+	in line 1 on file json
+	> [{"user": {"id": 1}}, {"user": {"id": 2}}, {"user": {"id": 1}}]
+	---------------------------------------------^`: {
+				schema: `[ // {uniqueItems: "user.id"}
+	{
+		"user": {
+			"id": 1
+		}
+	}
+]`,
+				json: `[{"user": {"id": 1}}, {"user": {"id": 2}}, {"user": {"id": 1}}]`,
+			},
+
+			`ERROR (code 624): The key "EN" does not match the "propertyNames" rule
+	in line 1 on file json
+	> {"en": "foo", "EN": "bar"}
+	----------------^`: {
+				schema: `{} // {additionalProperties: "string", propertyNames: "@locale"}`,
+				types: map[string]string{
+					"@locale": `"en" // {regex: "^[a-z]{2}$"}`,
+				},
+				json: `{"en": "foo", "EN": "bar"}`,
+			},
+
+			`ERROR (code 625): The key "startDate" is required when the key "endDate" is present ("dependentRequired" rule)
+	in line 1 on file json
+	> {"endDate": "2022-01-31"}
+	---^`: {
+				schema: `{ // {dependentRequired: {"endDate": ["startDate"]}}
+	"startDate": "2022-01-01",
+	"endDate": "2022-01-31"
+}`,
+				json: `{"endDate": "2022-01-31"}`,
+			},
+
+			`ERROR (code 626): The object should match the "@card" type when the key "type" is "card" ("when" rule): Invalid value type "integer", expected "string"
+	in line 3 on file json
+	> "number": 1234
+	------------^`: {
+				schema: `{ // {additionalProperties: true, when: {key: "type", const: "card", then: "@card"}}
+	"type": "card"
+}`,
+				types: map[string]string{
+					"@card": `{ // {additionalProperties: true}
+	"number": "1234"
+}`,
+				},
+				json: `{
+	"type": "card",
+	"number": 1234
+}`,
+			},
+
+			`ERROR (code 210): Invalid value type "string", expected "integer"
+	in line 3 on file json
+	> "lives": "nine"
+	-----------^`: {
+				schema: `@cat | @dog // {discriminator: "kind"}`,
+				types: map[string]string{
+					"@cat": `{
+	"kind": "cat", // {const: true}
+	"lives": 9
+}`,
+					"@dog": `{
+	"kind": "dog", // {const: true}
+	"lives": "unknown"
+}`,
+				},
+				json: `{
+	"kind": "cat",
+	"lives": "nine"
+}`,
+			},
+
+			`ERROR (code 629): The value matches several types of the exclusive "or" rule: @cat, @pet
+	in line 1 on file json
+	> {"name": "Tom"}
+	--^`: {
+				schema: `@cat | @pet // {exclusive: true}`,
+				types: map[string]string{
+					"@cat": `{ // {additionalProperties: true}
+	"name": "Tom"
+}`,
+					"@pet": `{ // {additionalProperties: true}
+	"name": "Rex"
+}`,
+				},
+				json: `{"name": "Tom"}`,
+			},
+
+			`ERROR (code 630): The value should not match the "not" rule
+	in line 1 on file json
+	> "root"
+	--^`: {
+				schema: `"user" // {not: {enum: ["admin", "root"]}}`,
+				json:   `"root"`,
+			},
+
+			`ERROR (code 629): The value matches several types of the exclusive "or" rule: integer, float
+	in line 1 on file json
+	> 42
+	--^`: {
+				schema: `42 // {or: [{type: "integer"}, {type: "float"}], exclusive: true}`,
+				json:   `42`,
+			},
+
+			`ERROR (code 628): Invalid value "cow" of the discriminator key "kind", expected one of: "cat", "dog"
+	in line 1 on file json
+	> {"kind": "cow"}
+	-----------^`: {
+				schema: `@cat | @dog // {discriminator: "kind"}`,
+				types: map[string]string{
+					"@cat": `{
+	"kind": "cat" // {const: true}
+}`,
+					"@dog": `{
+	"kind": "dog" // {const: true}
+}`,
+				},
+				json: `{"kind": "cow"}`,
+			},
+
+			`ERROR (code 622): The number of object properties does not match the "minProperties" rule
+	in line 1 on file json
+	> {"foo": 1}
+	--^`: {
+				schema: `{ // {minProperties: 2, additionalProperties: true}
+	"foo": 1
+}`,
+				json: `{"foo": 1}`,
+			},
+		}
+
+		for expected, c := range cc {
+			t.Run(expected, func(t *testing.T) {
+				schema := New("schema", c.schema, KeysAreOptionalByDefault())
+
+				for n, s := range c.types {
+					require.NoError(t, schema.AddType(n, New(s, s, KeysAreOptionalByDefault())))
+				}
+
+				err := schema.Validate(json.New("json", c.json))
+				assert.EqualError(t, err, expected)
+			})
+		}
+
+		t.Run("document path", func(t *testing.T) {
+			cc := map[string]struct {
+				schema string
+				json   string
+			}{
+				"": {
+					schema: `42`,
+					json:   `"foo"`,
+				},
+				"/foo": {
+					schema: `{"foo": 1}`,
+					json:   `{"foo": "bar"}`,
+				},
+				"/foo/1/b~1a~0r": {
+					schema: `{"foo": [{"b/a~r": 1}]}`,
+					json:   `{"foo": [{"b/a~r": 1}, {"b/a~r": true}]}`,
+				},
+				"/fizz": {
+					schema: `{"foo": [1], "fizz": "buzz"}`,
+					json:   `{"foo": [1, 2], "fizz": 3}`,
+				},
+			}
+
+			for expected, c := range cc {
+				t.Run(expected, func(t *testing.T) {
+					err := New("schema", c.schema).Validate(json.New("json", c.json))
+					require.Error(t, err)
+
+					var e errors.DocumentError
+					require.ErrorAs(t, err, &e)
+
+					path, ok := e.Path()
+					assert.True(t, ok)
+					assert.Equal(t, expected, path)
+				})
+			}
+		})
+
+		t.Run("YAML document", func(t *testing.T) {
+			schema := New("schema", `{
+	"foo": 1,
+	"bar": [
+		"fizz" // {minLength: 3}
+	]
+}`)
+			err := schema.Validate(yaml.New("yaml", `foo: 42
+bar:
+  - buzz
+  - no
+`))
+			assert.EqualError(t, err, `ERROR (code 603): Invalid string length for "minLength" = "3" constraint
+	in line 4 on file yaml
+	> - no
+	----^`)
+		})
+
+		t.Run("JSON5 document", func(t *testing.T) {
+			schema := New("schema", `{
+	"foo": 1,
+	"bar": "fizz" // {minLength: 3}
+}`)
+			err := schema.Validate(json.New("json", `{
+	// comment
+	foo: 0x2A, /* comment */ bar: 'no',
+}`, json.JSON5()))
+			assert.EqualError(t, err, `ERROR (code 603): Invalid string length for "minLength" = "3" constraint
+	in line 3 on file json
+	> foo: 0x2A, /* comment */ bar: 'no',
+	--------------------------------^`)
+		})
+
+		t.Run("JSON5 non-finite number", func(t *testing.T) {
+			schema := New("schema", `{
+	"a": 1.5
+}`)
+			err := schema.Validate(json.New("json", `{a: -Infinity}`, json.JSON5()))
+			assert.EqualError(t, err, `ERROR (code 210): Invalid value type "-Infinity", expected "float"
+	in line 1 on file json
+	> {a: -Infinity}
+	------^`)
+		})
+
+		t.Run("CBOR document", func(t *testing.T) {
+			schema := New("schema", `{
+	"id": 1
+}`)
+			// {"id": 1.5}
+			err := schema.Validate(cbor.New("cbor", []byte{
+				0xa1, 0x62, 'i', 'd', 0xf9, 0x3e, 0x00,
+			}))
+			assert.EqualError(t, err, `ERROR (code 210): Invalid value type "float", expected "integer"
+	in line 1 on file cbor
+	> {"id":1.5}
+	--------^`)
+		})
+
+		t.Run("MessagePack document", func(t *testing.T) {
+			t.Run("invalid value", func(t *testing.T) {
+				// {"id": true}
+				err := New("schema", `{"id": 1}`).Validate(msgpack.New("msgpack", []byte{
+					0x81, 0xa2, 'i', 'd', 0xc3,
+				}))
+				assert.Error(t, err)
+			})
+
+			t.Run("binary data", func(t *testing.T) {
+				// {"id": <bin>}
+				err := New("schema", `{"id": 1}`).Validate(msgpack.New("msgpack", []byte{
+					0x81, 0xa2, 'i', 'd', 0xc4, 0x01, 0x00,
+				}))
+				assert.EqualError(t, err, `ERROR (code 1802): MessagePack byte string at offset 4 can't be represented in JSON
+	in file msgpack`)
+			})
+		})
+
+		t.Run("not a JSON document", func(t *testing.T) {
+			err := New("schema", "42").Validate(&mocks.Document{})
+			assert.EqualError(t, err, "support only JSON, YAML, CBOR, MessagePack and form documents, but got *mocks.Document")
+		})
+	})
+}
+
+func TestSchema_ValidateWithOptions(t *testing.T) {
+	const schema = `{
+  "id": 1, // {readOnly: true}
+  "password": "secret", // {writeOnly: true}
+  "fax": "+1 555 0100", // {deprecated: true, optional: true}
+  "contacts": [
+    {
+      "phone": "555 0100", // {deprecated: true}
+      "email": "john@example.com"
+    }
+  ]
+}`
+
+	t.Run("positive", func(t *testing.T) {
+		cc := map[string]struct {
+			json string
+			oo   []ValidateOption
+		}{
+			"default mode": {
+				json: `{"id": 1, "password": "secret", "fax": "", "contacts": []}`,
+			},
+			"request mode": {
+				json: `{"password": "secret", "contacts": []}`,
+				oo:   []ValidateOption{RequestMode()},
+			},
+			"response mode": {
+				json: `{"id": 1, "contacts": []}`,
+				oo:   []ValidateOption{ResponseMode()},
+			},
+		}
+
+		for name, c := range cc {
+			t.Run(name, func(t *testing.T) {
+				s := New("schema", schema, KeysAreOptionalByDefault())
+				assert.NoError(t, s.ValidateWithOptions(json.New("json", c.json), c.oo...))
+			})
+		}
+	})
+
+	t.Run("report deprecated", func(t *testing.T) {
+		s := New("schema", schema, KeysAreOptionalByDefault())
+
+		var ww []errors.DocumentError
+		err := s.ValidateWithOptions(
+			json.New("json", `{"fax": "", "contacts": [{"email": ""}, {"phone": ""}]}`),
+			ReportDeprecated(func(e errors.DocumentError) {
+				ww = append(ww, e)
+			}),
+		)
+		require.NoError(t, err)
+		require.Len(t, ww, 2)
+
+		assert.EqualError(t, ww[0], `ERROR (code 215): The key "fax" is deprecated
+	in line 1 on file json
+	> {"fax": "", "contacts": [{"email": ""}, {"phone": ""}]}
+	---^`)
+		path, _ := ww[0].Path()
+		assert.Equal(t, "/fax", path)
+
+		assert.EqualError(t, ww[1], `ERROR (code 215): The key "phone" is deprecated
+	in line 1 on file json
+	> {"fax": "", "contacts": [{"email": ""}, {"phone": ""}]}
+	-------------------------------------------^`)
+		path, _ = ww[1].Path()
+		assert.Equal(t, "/contacts/1/phone", path)
+	})
+
+	t.Run("report deprecated once per key", func(t *testing.T) {
+		s := New("schema", `@cat | @dog`)
+		require.NoError(t, s.AddType("@cat", New("@cat", `{
+  "name": "Tom" // {deprecated: true}
+}`)))
+		require.NoError(t, s.AddType("@dog", New("@dog", `{
+  "name": "Rex" // {deprecated: true}
+}`)))
+
+		n := 0
+		err := s.ValidateWithOptions(json.New("json", `{"name": "Felix"}`), ReportDeprecated(func(errors.DocumentError) {
+			n++
+		}))
+		require.NoError(t, err)
+		assert.Equal(t, 1, n)
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		cc := map[string]struct {
+			json string
+			oo   []ValidateOption
+		}{
+			`ERROR (code 213): The key "id" is read-only and cannot be sent in a request
+	in line 1 on file json
+	> {"id": 1}
+	---^`: {
+				json: `{"id": 1}`,
+				oo:   []ValidateOption{RequestMode()},
+			},
+			`ERROR (code 214): The key "password" is write-only and cannot be sent in a response
+	in line 1 on file json
+	> {"password": "secret"}
+	---^`: {
+				json: `{"password": "secret"}`,
+				oo:   []ValidateOption{ResponseMode()},
+			},
+		}
+
+		for expected, c := range cc {
+			t.Run(expected, func(t *testing.T) {
+				s := New("schema", schema, KeysAreOptionalByDefault())
+				assert.EqualError(t, s.ValidateWithOptions(json.New("json", c.json), c.oo...), expected)
+			})
+		}
+	})
+
+	t.Run("limits", func(t *testing.T) {
+		s := New("schema", `[ // {type: "any"}
+]`)
+
+		t.Run("positive", func(t *testing.T) {
+			err := s.ValidateWithOptions(
+				json.New("json", `[{"foo": "bar"}, [1, 2]]`),
+				MaxDepth(2),
+				MaxStringLength(3),
+				MaxKeysPerObject(1),
+				MaxArrayLength(2),
+				MaxLexemes(30),
+			)
+			assert.NoError(t, err)
+		})
+
+		t.Run("negative", func(t *testing.T) {
+			cc := map[string]struct {
+				document jschema.Document
+				option   ValidateOption
+			}{
+				`ERROR (code 305): The nesting depth exceeds the limit of 2
+	in line 1 on file json
+	> [[[1]]]
+	----^`: {
+					json.New("json", `[[[1]]]`),
+					MaxDepth(2),
+				},
+				`ERROR (code 306): The string length exceeds the limit of 3 bytes
+	in line 1 on file json
+	> ["fizz"]
+	---^`: {
+					json.New("json", `["fizz"]`),
+					MaxStringLength(3),
+				},
+				`ERROR (code 307): The number of object keys exceeds the limit of 1
+	in line 1 on file json
+	> [{"foo": 1, "bar": 2}]
+	--------------^`: {
+					json.New("json", `[{"foo": 1, "bar": 2}]`),
+					MaxKeysPerObject(1),
+				},
+				`ERROR (code 308): The number of array items exceeds the limit of 2
+	in line 3 on file yaml
+	> - 3
+	----^`: {
+					yaml.New("yaml", "- 1\n- 2\n- 3\n"),
+					MaxArrayLength(2),
+				},
+				`ERROR (code 309): The number of lexemes exceeds the limit of 5
+	in line 1 on file json
+	> [1, 2]
+	------^`: {
+					json.New("json", `[1, 2]`),
+					MaxLexemes(5),
+				},
+			}
+
+			for expected, c := range cc {
+				t.Run(expected, func(t *testing.T) {
+					assert.EqualError(t, s.ValidateWithOptions(c.document, c.option), expected)
+				})
+			}
+		})
+	})
+}
+
+func TestSchema_ValidateContext(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		s := New("schema", `[1]`)
+		assert.NoError(t, s.ValidateContext(context.Background(), json.New("json", `[1, 2, 3]`)))
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		t.Run("canceled", func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+
+			err := New("schema", `[1]`).ValidateContext(ctx, json.New("json", `[1, 2, 3]`))
+			assert.EqualError(t, err, "Validation canceled: context canceled")
+			assert.ErrorIs(t, err, context.Canceled)
+
+			var e errors.CanceledError
+			require.ErrorAs(t, err, &e)
+			assert.Equal(t, errors.ErrValidationCanceled, e.Code())
+		})
+
+		t.Run("deadline exceeded", func(t *testing.T) {
+			ctx, cancel := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+			defer cancel()
+
+			err := New("schema", `[1]`).ValidateContext(ctx, json.New("json", `[1, 2, 3]`))
+			assert.EqualError(t, err, "Validation canceled: context deadline exceeded")
+			assert.ErrorIs(t, err, context.DeadlineExceeded)
+		})
+
+		t.Run("canceled during validation", func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			var validated []string
+			r := jschema.NewRegistry()
+			require.NoError(t, r.AddRule("cancel", jschema.CustomRule{
+				IsJsonTypeCompatible: func(t jschema.SchemaType) bool {
+					return t == jschema.SchemaTypeInteger
+				},
+				Validate: func(_ interface{}, value []byte) error {
+					validated = append(validated, string(value))
+					cancel()
+					return nil
+				},
+			}))
+
+			s := New("schema", `[
+  1 // {cancel: true}
+]`, WithRegistry(r))
+
+			err := s.ValidateContext(ctx, json.New("json", `[1, 2, 3]`))
+			assert.ErrorIs(t, err, context.Canceled)
+			assert.Equal(t, []string{"1"}, validated)
+		})
+	})
+}
+
+func TestSchema_ApplyDefaults(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		cc := map[string]struct {
+			schema   string
+			types    map[string]string
+			json     string
+			expected string
+		}{
+			"missing keys": {
+				schema: `{
+  "id": 1,
+  "limit": 10, // {optional: true, default: 20}
+  "sort": "asc", // {optional: true, default: "desc"}
+  "tags": [] // {optional: true}
+}`,
+				json:     `{"id": 1}`,
+				expected: `{"id": 1, "limit": 20, "sort": "desc"}`,
+			},
+			"key order is preserved": {
+				schema: `{
+  "limit": 10, // {optional: true, default: 20}
+  "sort": "asc", // {optional: true, default: "desc"}
+  "id": 1
+}`,
+				json:     `{"sort": "asc", "id": 1}`,
+				expected: `{"sort": "asc", "id": 1, "limit": 20}`,
+			},
+			"empty object": {
+				schema: `{
+  "limit": 10, // {optional: true, default: 20}
+  "active": true // {optional: true, default: false}
+}`,
+				json:     `{}`,
+				expected: `{"limit": 20, "active": false}`,
+			},
+			"nothing to add": {
+				schema: `{
+  "limit": 10 // {optional: true, default: 20}
+}`,
+				json:     "{\n  \"limit\": 5\n}\n",
+				expected: "{\n  \"limit\": 5\n}\n",
+			},
+			"null default": {
+				schema: `{
+  "note": "foo" // {optional: true, nullable: true, default: null}
+}`,
+				json:     `{}`,
+				expected: `{"note": null}`,
+			},
+			"nested objects and arrays": {
+				schema: `{
+  "items": [
+    {
+      "name": "foo",
+      "count": 1 // {optional: true, default: 1}
+    }
+  ],
+  "meta": {
+    "page": 1 // {optional: true, default: 1}
+  }
+}`,
+				json:     `{"items": [{"name": "a"}, {"name": "b", "count": 3}], "meta": {}}`,
+				expected: `{"items": [{"name": "a", "count": 1}, {"name": "b", "count": 3}], "meta": {"page": 1}}`,
+			},
+			"tuple": {
+				schema: `[ // {tuple: true, additionalItems: "any"}
+  {
+    "count": 1 // {optional: true, default: 1}
+  }
+]`,
+				json:     `[{}, {}]`,
+				expected: `[{"count": 1}, {}]`,
+			},
+			"user types": {
+				schema: `{
+  "owner": @user,
+  "pets": [@cat | @dog]
+}`,
+				types: map[string]string{
+					"@user": `{
+  "name": "John",
+  "role": "user" // {optional: true, default: "user"}
+}`,
+					"@cat": `{
+  "meow": true,
+  "lives": 9 // {optional: true, default: 9}
+}`,
+					"@dog": `{
+  "bark": true,
+  "good": true // {optional: true, default: true}
+}`,
+				},
+				json:     `{"owner": {"name": "Ann"}, "pets": [{"bark": false}, {"meow": true}]}`,
+				expected: `{"owner": {"name": "Ann", "role": "user"}, "pets": [{"bark": false, "good": true}, {"meow": true, "lives": 9}]}`,
+			},
+			"escaped key": {
+				schema: `{
+  "a\"b": 1 // {optional: true, default: 2}
+}`,
+				json:     `{}`,
+				expected: `{"a\"b": 2}`,
+			},
+		}
+
+		for name, c := range cc {
+			t.Run(name, func(t *testing.T) {
+				s := New("schema", c.schema)
+				for n, b := range c.types {
+					require.NoError(t, s.AddType(n, New(n, b)))
 				}
 
-				for _, s := range c.jsons {
-					t.Run(s, func(t *testing.T) {
-						err := schema.Validate(json.New("json", s))
-						require.NoError(t, err)
-					})
-				}
+				actual, err := s.ApplyDefaults(json.New("json", c.json))
+				require.NoError(t, err)
+				assert.Equal(t, c.expected, string(actual))
 			})
 		}
 	})
 
+	t.Run("YAML document", func(t *testing.T) {
+		s := New("schema", `{
+  "id": 1,
+  "limit": 10 // {optional: true, default: 20}
+}`)
+		actual, err := s.ApplyDefaults(yaml.New("yaml", "id: 1\n"))
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"id": 1, "limit": 20}`, string(actual))
+	})
+
 	t.Run("negative", func(t *testing.T) {
+		t.Run("invalid document", func(t *testing.T) {
+			s := New("schema", `{
+  "limit": 10 // {optional: true, default: 20}
+}`)
+			_, err := s.ApplyDefaults(json.New("json", `{"limit": "foo"}`))
+			assert.EqualError(t, err, `ERROR (code 210): Invalid value type "string", expected "integer"
+	in line 1 on file json
+	> {"limit": "foo"}
+	------------^`)
+		})
+
+		t.Run("not a JSON document", func(t *testing.T) {
+			_, err := New("schema", "42").ApplyDefaults(&mocks.Document{})
+			assert.EqualError(t, err, "support only JSON, YAML, CBOR, MessagePack and form documents, but got *mocks.Document")
+		})
+	})
+}
+
+func TestSchema_Coerce(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
 		cc := map[string]struct {
-			schema string
-			types  map[string]string
-			json   string
+			schema   string
+			types    map[string]string
+			form     string
+			expected string
 		}{
-			`ERROR (code 1302): Type "@int" not found
-	in line 2 on file schema
-	> "aaa": 111 // {type: "@int"}
-	---------^`: {
+			"literals": {
 				schema: `{
-		"aaa": 111 // {type: "@int"}
-	}`,
+  "page": 1,
+  "price": 1.5,
+  "ratio": 0.5,
+  "active": true,
+  "deleted": null,
+  "name": "foo"
+}`,
+				form:     "page=2&price=-3.25&ratio=1&active=false&deleted=null&name=42",
+				expected: `{"page":2,"price":-3.25,"ratio":1,"active":false,"deleted":null,"name":"42"}`,
 			},
-
-			`ERROR (code 1301): Incorrect type of user type
-	in line 2 on file schema
-	> "aaa": 111 // {type: "@int"}
-	---------^`: {
+			"rules": {
 				schema: `{
-		"aaa": 111 // {type: "@int"}
-	}`,
-				types: map[string]string{
-					"@int": `"abc"`,
-				},
+  "page": 1, // {min: 1}
+  "sort": "asc", // {enum: ["asc", "desc"]}
+  "id": 1 // {type: "integer"}
+}`,
+				form:     "page=3&sort=desc&id=7",
+				expected: `{"page":3,"sort":"desc","id":7}`,
 			},
-
-			`ERROR (code 204): None of the rules in the "OR" set has been validated
-	in line 1 on file json
-	> {"foo": 10}
-	----------^`: {
+			"arrays": {
 				schema: `{
-	"foo": 123 /* {or: [
-		{min: 100},
-		{type: "string"}
-	]} */
+  "ids": [1],
+  "tags": ["tag"],
+  "one": [1]
 }`,
-				json: `{"foo": 10}`,
+				form:     "ids=1&ids=2&tags=1&one=3",
+				expected: `{"ids":[1,2],"tags":["1"],"one":[3]}`,
 			},
-
-			`ERROR (code 204): None of the rules in the "OR" set has been validated
-	in line 1 on file json
-	> {"foo": true}
-	----------^`: {
+			"nested": {
 				schema: `{
-	"foo": 123 /* {or: [
-		{min: 100},
-		{type: "string"}
-	]} */
+  "filter": {
+    "active": true,
+    "size": { // {optional: true}
+      "min": 1
+    }
+  },
+  "items": [
+    {"id": 1}
+  ]
 }`,
-				json: `{"foo": true}`,
+				form:     "filter[active]=true&filter[size][min]=5&items[][id]=1&items[][id]=2",
+				expected: `{"filter":{"active":true,"size":{"min":5}},"items":[{"id":1},{"id":2}]}`,
 			},
-
-			`ERROR (code 1117): The "precision" constraint can't be used for the "float" type
-	in line 1 on file schema
-	> 1.1 // {type: "float", precision: 2}
-	--^`: {
-				schema: `1.1 // {type: "float", precision: 2}`,
-				json:   "3.14",
+			"or": {
+				schema: `{
+  "value": 1 // {or: [{type: "integer"}, {type: "boolean"}]}
+}`,
+				form:     "value=true",
+				expected: `{"value":true}`,
+			},
+			"user types": {
+				schema: `{
+  "user": @user,
+  "ids": @ids,
+  "any": @number | @flag
+}`,
+				types: map[string]string{
+					"@user":   `{"age": 18}`,
+					"@ids":    `[1]`,
+					"@number": `1`,
+					"@flag":   `true`,
+				},
+				form:     "user[age]=30&ids=5&any=false",
+				expected: `{"user":{"age":30},"ids":[5],"any":false}`,
+			},
+			"unknown keys": {
+				schema: `{ // {additionalProperties: true}
+  "page": 1
+}`,
+				form:     "page=1&foo=2",
+				expected: `{"page":1,"foo":"2"}`,
 			},
 		}
 
-		for expected, c := range cc {
-			t.Run(expected, func(t *testing.T) {
-				schema := New("schema", c.schema, KeysAreOptionalByDefault())
-
-				for n, s := range c.types {
-					require.NoError(t, schema.AddType(n, New(s, s, KeysAreOptionalByDefault())))
+		for name, c := range cc {
+			t.Run(name, func(t *testing.T) {
+				s := New("schema", c.schema)
+				for n, b := range c.types {
+					require.NoError(t, s.AddType(n, New(n, b)))
 				}
 
-				err := schema.Validate(json.New("json", c.json))
-				assert.EqualError(t, err, expected)
+				actual, err := s.Coerce(form.New("form", c.form))
+				require.NoError(t, err)
+				assert.Equal(t, c.expected, string(actual))
+
+				require.NoError(t, s.ValidateWithOptions(form.New("form", c.form), CoerceStrings()))
 			})
 		}
+	})
+
+	t.Run("JSON document", func(t *testing.T) {
+		s := New("schema", `{"id": 1, "tags": ["a"]}`)
+		actual, err := s.Coerce(json.New("json", `{"id": "42", "tags": "b"}`))
+		require.NoError(t, err)
+		assert.Equal(t, `{"id": 42, "tags": ["b"]}`, string(actual))
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		t.Run("invalid value", func(t *testing.T) {
+			s := New("schema", `{
+  "page": 1, // {min: 1}
+  "active": true
+}`)
+			_, err := s.Coerce(form.New("form", "page=0&active=true"))
+			assert.EqualError(t, err, `ERROR (code 602): Invalid value for "min" = 1 constraint 
+	in line 1 on file form
+	> page=0&active=true
+	-------^`)
+
+			err = s.ValidateWithOptions(form.New("form", "page=1&active=yes"), CoerceStrings())
+			assert.EqualError(t, err, `ERROR (code 210): Invalid value type "string", expected "boolean"
+	in line 1 on file form
+	> page=1&active=yes
+	----------------^`)
+		})
+
+		t.Run("unexpected key", func(t *testing.T) {
+			s := New("schema", `{"a": "foo", "page": 1}`)
+			_, err := s.Coerce(form.New("form", "a=hello&page=1&b=2"))
+			assert.EqualError(t, err, `ERROR (code 206): Schema does not support key "b". Did you mean "a"?
+	in line 1 on file form
+	> a=hello&page=1&b=2
+	-----------------^`)
+		})
+
+		t.Run("without coercion", func(t *testing.T) {
+			err := New("schema", `{"page": 1}`).Validate(form.New("form", "page=1"))
+			assert.EqualError(t, err, `ERROR (code 210): Invalid value type "string", expected "integer"
+	in line 1 on file form
+	> page=1
+	-------^`)
+		})
+
+		t.Run("invalid form", func(t *testing.T) {
+			_, err := New("schema", `{"page": 1}`).Coerce(form.New("form", "page=%zz"))
+			assert.EqualError(t, err, `ERROR (code 1900): Invalid percent-encoding in "%zz"
+	in line 1 on file form
+	> page=%zz
+	-------^`)
+		})
 
 		t.Run("not a JSON document", func(t *testing.T) {
-			err := New("schema", "42").Validate(&mocks.Document{})
-			assert.EqualError(t, err, "support only JSON documents, but got *mocks.Document")
+			_, err := New("schema", "42").Coerce(&mocks.Document{})
+			assert.EqualError(t, err, "support only JSON, YAML, CBOR, MessagePack and form documents, but got *mocks.Document")
 		})
 	})
 }
@@ -2400,6 +3978,147 @@ func TestSchema_GetAST(t *testing.T) {
 				},
 			},
 
+			`42 // {deprecated: true, readOnly: true, title: "Answer", description: "The answer", examples: [1, "2"]}`: {
+				expected: jschema.ASTNode{
+					TokenType:  jschema.TokenTypeNumber,
+					SchemaType: string(jschema.SchemaTypeInteger),
+					Value:      "42",
+					Rules: jschema.NewRuleASTNodes(
+						map[string]jschema.RuleASTNode{
+							"deprecated": {
+								TokenType:  jschema.TokenTypeBoolean,
+								Value:      "true",
+								Properties: &jschema.RuleASTNodes{},
+								Source:     jschema.RuleASTNodeSourceManual,
+							},
+							"readOnly": {
+								TokenType:  jschema.TokenTypeBoolean,
+								Value:      "true",
+								Properties: &jschema.RuleASTNodes{},
+								Source:     jschema.RuleASTNodeSourceManual,
+							},
+							"title": {
+								TokenType:  jschema.TokenTypeString,
+								Value:      "Answer",
+								Properties: &jschema.RuleASTNodes{},
+								Source:     jschema.RuleASTNodeSourceManual,
+							},
+							"description": {
+								TokenType:  jschema.TokenTypeString,
+								Value:      "The answer",
+								Properties: &jschema.RuleASTNodes{},
+								Source:     jschema.RuleASTNodeSourceManual,
+							},
+							"examples": {
+								TokenType:  jschema.TokenTypeArray,
+								Properties: &jschema.RuleASTNodes{},
+								Items: []jschema.RuleASTNode{
+									{
+										TokenType:  jschema.TokenTypeNumber,
+										Value:      "1",
+										Properties: &jschema.RuleASTNodes{},
+										Source:     jschema.RuleASTNodeSourceManual,
+									},
+									{
+										TokenType:  jschema.TokenTypeString,
+										Value:      "2",
+										Properties: &jschema.RuleASTNodes{},
+										Source:     jschema.RuleASTNodeSourceManual,
+									},
+								},
+								Source: jschema.RuleASTNodeSourceManual,
+							},
+						},
+						[]string{"deprecated", "readOnly", "title", "description", "examples"},
+					),
+				},
+			},
+
+			`[ // {tuple: true, additionalItems: "string"}
+	1,
+	true // {optional: true}
+]`: {
+				expected: jschema.ASTNode{
+					TokenType:  jschema.TokenTypeArray,
+					SchemaType: string(jschema.SchemaTypeArray),
+					Rules: jschema.NewRuleASTNodes(
+						map[string]jschema.RuleASTNode{
+							"tuple": {
+								TokenType:  jschema.TokenTypeBoolean,
+								Value:      "true",
+								Properties: &jschema.RuleASTNodes{},
+								Source:     jschema.RuleASTNodeSourceManual,
+							},
+							"additionalItems": {
+								TokenType:  jschema.TokenTypeString,
+								Value:      "string",
+								Properties: &jschema.RuleASTNodes{},
+								Source:     jschema.RuleASTNodeSourceManual,
+							},
+						},
+						[]string{"tuple", "additionalItems"},
+					),
+					Children: []jschema.ASTNode{
+						{
+							TokenType:  jschema.TokenTypeNumber,
+							SchemaType: string(jschema.SchemaTypeInteger),
+							Value:      "1",
+							Rules:      &jschema.RuleASTNodes{},
+						},
+						{
+							TokenType:  jschema.TokenTypeBoolean,
+							SchemaType: string(jschema.SchemaTypeBoolean),
+							Value:      "true",
+							Rules: jschema.NewRuleASTNodes(
+								map[string]jschema.RuleASTNode{
+									"optional": {
+										TokenType:  jschema.TokenTypeBoolean,
+										Value:      "true",
+										Properties: &jschema.RuleASTNodes{},
+										Source:     jschema.RuleASTNodeSourceManual,
+									},
+								},
+								[]string{"optional"},
+							),
+						},
+					},
+				},
+			},
+
+			`[ // {contains: "admin", maxContains: 1}
+	"user"
+]`: {
+				expected: jschema.ASTNode{
+					TokenType:  jschema.TokenTypeArray,
+					SchemaType: string(jschema.SchemaTypeArray),
+					Rules: jschema.NewRuleASTNodes(
+						map[string]jschema.RuleASTNode{
+							"contains": {
+								TokenType:  jschema.TokenTypeString,
+								Value:      "admin",
+								Properties: &jschema.RuleASTNodes{},
+								Source:     jschema.RuleASTNodeSourceManual,
+							},
+							"maxContains": {
+								TokenType:  jschema.TokenTypeNumber,
+								Value:      "1",
+								Properties: &jschema.RuleASTNodes{},
+								Source:     jschema.RuleASTNodeSourceManual,
+							},
+						},
+						[]string{"contains", "maxContains"},
+					),
+					Children: []jschema.ASTNode{
+						{
+							TokenType:  jschema.TokenTypeString,
+							SchemaType: string(jschema.SchemaTypeString),
+							Value:      "user",
+							Rules:      &jschema.RuleASTNodes{},
+						},
+					},
+				},
+			},
+
 			`
 123 /*
         {min: 0}
@@ -4407,6 +6126,57 @@ line
 	})
 }
 
+func TestSchema_Warnings(t *testing.T) {
+	t.Run("positive", func(t *testing.T) {
+		s := New("", `@cat | @dog // {exclusive: true}`)
+		require.NoError(t, s.AddType("@cat", New("@cat", `{"name": "Tom"}`)))
+		require.NoError(t, s.AddType("@dog", New("@dog", `{"breed": "beagle"}`)))
+
+		ww, err := s.Warnings()
+		require.NoError(t, err)
+		assert.Empty(t, ww)
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		cc := map[string]struct {
+			given string
+			types map[string]string
+		}{
+			`ERROR (code 1214): The "integer" and "integer" types of the exclusive "or" rule both accept the value 42
+	in line 1 on file 
+	> 42 // {or: [{type: "integer", min: 0}, {type: "integer", max: 100}], exclusive: true}
+	--^`: {
+				given: `42 // {or: [{type: "integer", min: 0}, {type: "integer", max: 100}], exclusive: true}`,
+			},
+
+			`ERROR (code 1214): The "@positive" and "@even" types of the exclusive "or" rule both accept the value 2
+	in line 1 on file 
+	> @positive | @even // {exclusive: true}
+	--^`: {
+				given: `@positive | @even // {exclusive: true}`,
+				types: map[string]string{
+					"@positive": `1 // {min: 1}`,
+					"@even":     `2 // {multipleOf: 2}`,
+				},
+			},
+		}
+
+		for expected, c := range cc {
+			t.Run(expected, func(t *testing.T) {
+				s := New("", c.given)
+				for n, c := range c.types {
+					require.NoError(t, s.AddType(n, New(n, c)))
+				}
+
+				ww, err := s.Warnings()
+				require.NoError(t, err)
+				require.Len(t, ww, 1)
+				assert.EqualError(t, ww[0], expected)
+			})
+		}
+	})
+}
+
 func TestSchema_UsedUserTypes(t *testing.T) {
 	t.Run("positive", func(t *testing.T) {
 		cc := map[string][]string{
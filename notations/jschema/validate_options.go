@@ -0,0 +1,89 @@
+package jschema
+
+import (
+	"github.com/jsightapi/jsight-schema-go-library/errors"
+	internalSchema "github.com/jsightapi/jsight-schema-go-library/notations/jschema/internal/schema"
+)
+
+// ValidateOption enables optional checks of the metadata rules and the size of
+// the document performed by the ValidateWithOptions method. Without options
+// these rules don't affect validation, and the size isn't limited.
+//
+// Limits of the size are also passed to YAML, CBOR, MessagePack and form
+// documents, which check them while the content is converted to JSON. It takes
+// effect only if the document isn't converted yet, i.e. it isn't used before.
+type ValidateOption func(o *internalSchema.ValidationOptions)
+
+// RequestMode validates the document as a request: keys marked by the
+// "readOnly" rule are rejected.
+func RequestMode() ValidateOption {
+	return func(o *internalSchema.ValidationOptions) {
+		o.Mode = internalSchema.ValidationModeRequest
+	}
+}
+
+// ResponseMode validates the document as a response: keys marked by the
+// "writeOnly" rule are rejected.
+func ResponseMode() ValidateOption {
+	return func(o *internalSchema.ValidationOptions) {
+		o.Mode = internalSchema.ValidationModeResponse
+	}
+}
+
+// ReportDeprecated calls fn for each key marked by the "deprecated" rule which
+// is found in the document. The key is reported even if the document turns out
+// to be invalid later.
+func ReportDeprecated(fn func(errors.DocumentError)) ValidateOption {
+	return func(o *internalSchema.ValidationOptions) {
+		o.ReportDeprecated = fn
+	}
+}
+
+// MaxDepth limits the nesting depth of objects and arrays of the document. The
+// root object or array has the depth of 1.
+func MaxDepth(n uint) ValidateOption {
+	return func(o *internalSchema.ValidationOptions) {
+		o.Limits.MaxDepth = n
+	}
+}
+
+// MaxStringLength limits the length of strings and object keys of the document
+// in bytes, as written in the document without quotes.
+func MaxStringLength(n uint) ValidateOption {
+	return func(o *internalSchema.ValidationOptions) {
+		o.Limits.MaxStringLength = n
+	}
+}
+
+// MaxKeysPerObject limits the number of keys of each object of the document.
+func MaxKeysPerObject(n uint) ValidateOption {
+	return func(o *internalSchema.ValidationOptions) {
+		o.Limits.MaxKeysPerObject = n
+	}
+}
+
+// MaxArrayLength limits the number of items of each array of the document.
+func MaxArrayLength(n uint) ValidateOption {
+	return func(o *internalSchema.ValidationOptions) {
+		o.Limits.MaxArrayLength = n
+	}
+}
+
+// MaxLexemes limits the total number of lexemes of the document. Limits the
+// total size of the document regardless of its structure.
+func MaxLexemes(n uint) ValidateOption {
+	return func(o *internalSchema.ValidationOptions) {
+		o.Limits.MaxLexemes = n
+	}
+}
+
+// CoerceStrings accepts strings of the document as numbers, booleans and null
+// if the schema expects them, e.g. "1" for the integer node and "true" for the
+// boolean node. A string for the array node is accepted as the array with the
+// single item. Is intended for documents in which all values are strings, like
+// form documents. Use the Coerce method to get the coerced content.
+func CoerceStrings() ValidateOption {
+	return func(o *internalSchema.ValidationOptions) {
+		o.CoerceStrings = true
+	}
+}
@@ -0,0 +1,135 @@
+package jschema
+
+import (
+	"sort"
+
+	"github.com/jsightapi/jsight-schema-go-library/errors"
+	"github.com/jsightapi/jsight-schema-go-library/internal/lexeme"
+	"github.com/jsightapi/jsight-schema-go-library/internal/panics"
+	internalSchema "github.com/jsightapi/jsight-schema-go-library/notations/jschema/internal/schema"
+	"github.com/jsightapi/jsight-schema-go-library/notations/jschema/internal/schema/constraint"
+	"github.com/jsightapi/jsight-schema-go-library/notations/jschema/internal/validator"
+)
+
+// Warnings returns problems which don't make this schema invalid, but most
+// likely are mistakes. For instance, types of the exclusive "or" rule which
+// accept the same value.
+// Might return ParsingError if schema isn't valid.
+func (s *Schema) Warnings() (ww []errors.DocumentError, err error) {
+	defer func() {
+		err = panics.Handle(recover(), err)
+	}()
+
+	if err := s.compile(); err != nil {
+		return nil, err
+	}
+
+	c := warningsCollector{
+		rootSchema: s.inner,
+		processed:  map[internalSchema.Node]struct{}{},
+	}
+
+	if root := s.inner.RootNode(); root != nil {
+		c.collect(root)
+	}
+
+	types := s.inner.TypesList()
+	names := make([]string, 0, len(types))
+	for name := range types {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		typ := types[name]
+		if root := typ.Schema().RootNode(); root != nil {
+			c.collect(root)
+		}
+	}
+	return c.warnings, nil
+}
+
+type warningsCollector struct {
+	rootSchema *internalSchema.Schema
+
+	// processed a set of already processed nodes. The same node can be the
+	// root node of several types.
+	processed map[internalSchema.Node]struct{}
+
+	warnings []errors.DocumentError
+}
+
+func (c *warningsCollector) collect(node internalSchema.Node) {
+	if _, ok := c.processed[node]; ok {
+		return
+	}
+	c.processed[node] = struct{}{}
+
+	c.collectOverlappingTypes(node)
+
+	if branchNode, ok := node.(internalSchema.BranchNode); ok {
+		for _, child := range branchNode.Children() {
+			c.collect(child)
+		}
+	}
+}
+
+// collectOverlappingTypes finds types of the exclusive "or" rule which accept
+// the same value. Examples of types and the example of the node are checked.
+// Such values will never be valid.
+func (c *warningsCollector) collectOverlappingTypes(node internalSchema.Node) {
+	if node.Constraint(constraint.ExclusiveConstraintType) == nil {
+		return
+	}
+
+	tl, ok := node.Constraint(constraint.TypesListConstraintType).(*constraint.TypesList)
+	if !ok {
+		return
+	}
+
+	examples := make([][]byte, 0, tl.Len()+1)
+	if literal, ok := node.(*internalSchema.LiteralNode); ok {
+		examples = append(examples, literal.Value())
+	}
+	for _, name := range tl.Names() {
+		example, err := newExampleBuilder(c.rootSchema.TypesList()).Build(c.rootSchema.MustType(name).RootNode())
+		if err == nil {
+			examples = append(examples, example)
+		}
+	}
+
+	reported := map[[2]int]struct{}{}
+	for _, example := range examples {
+		matched := c.matchedTypes(tl, example)
+		if len(matched) < 2 {
+			continue
+		}
+
+		pair := [2]int{matched[0], matched[1]}
+		if _, ok := reported[pair]; ok {
+			continue
+		}
+		reported[pair] = struct{}{}
+
+		c.warnings = append(c.warnings, lexeme.NewLexEventError(
+			node.BasisLexEventOfSchemaForNode(),
+			errors.Format(
+				errors.ErrOverlappingTypesInExclusiveOr,
+				tl.TypeNames()[pair[0]],
+				tl.TypeNames()[pair[1]],
+				string(example),
+			),
+		))
+	}
+}
+
+// matchedTypes returns indexes of types from the list which accept the value.
+func (c *warningsCollector) matchedTypes(tl *constraint.TypesList, value []byte) []int {
+	var matched []int
+	for i, name := range tl.Names() {
+		if validator.IsValidValue(c.rootSchema.MustType(name).RootNode(), *c.rootSchema, value) {
+			matched = append(matched, i)
+		}
+	}
+	return matched
+}
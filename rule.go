@@ -0,0 +1,64 @@
+package jschema
+
+// ruleNames a list of all built-in rules. Used for suggestions for misspelled
+// rule names.
+var ruleNames = []string{
+	"minLength",
+	"maxLength",
+	"lengthUnit",
+	"min",
+	"max",
+	"exclusiveMinimum",
+	"exclusiveMaximum",
+	"multipleOf",
+	"type",
+	"precision",
+	"optional",
+	"minItems",
+	"maxItems",
+	"minProperties",
+	"maxProperties",
+	"propertyNames",
+	"dependentRequired",
+	"when",
+	"additionalProperties",
+	"nullable",
+	"regex",
+	"const",
+	"caseInsensitive",
+	"unicodeNormalization",
+	"or",
+	"discriminator",
+	"exclusive",
+	"not",
+	"enum",
+	"allOf",
+	"uniqueItems",
+	"tuple",
+	"additionalItems",
+	"contains",
+	"minContains",
+	"maxContains",
+	"deprecated",
+	"readOnly",
+	"writeOnly",
+	"title",
+	"description",
+	"examples",
+	"default",
+}
+
+// IsValidRule returns true if the rule with specified name is a built-in rule.
+func IsValidRule(s string) bool {
+	for _, n := range ruleNames {
+		if n == s {
+			return true
+		}
+	}
+	return false
+}
+
+// BuiltinRuleNames returns names of all built-in rules.
+func BuiltinRuleNames() []string {
+	return append([]string(nil), ruleNames...)
+}
@@ -0,0 +1,20 @@
+package jschema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsValidRule(t *testing.T) {
+	for _, name := range BuiltinRuleNames() {
+		name := name
+		t.Run(name, func(t *testing.T) {
+			assert.True(t, IsValidRule(name))
+		})
+	}
+
+	t.Run("invalid", func(t *testing.T) {
+		assert.False(t, IsValidRule("countryCode"))
+	})
+}
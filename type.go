@@ -21,45 +21,77 @@ const (
 type SchemaType string
 
 const (
-	SchemaTypeUndefined SchemaType = ""
-	SchemaTypeString    SchemaType = "string"
-	SchemaTypeInteger   SchemaType = "integer"
-	SchemaTypeFloat     SchemaType = "float"
-	SchemaTypeDecimal   SchemaType = "decimal"
-	SchemaTypeBoolean   SchemaType = "boolean"
-	SchemaTypeObject    SchemaType = "object"
-	SchemaTypeArray     SchemaType = "array"
-	SchemaTypeNull      SchemaType = "null"
-	SchemaTypeEmail     SchemaType = "email"
-	SchemaTypeURI       SchemaType = "uri"
-	SchemaTypeUUID      SchemaType = "uuid"
-	SchemaTypeDate      SchemaType = "date"
-	SchemaTypeDateTime  SchemaType = "datetime"
-	SchemaTypeEnum      SchemaType = "enum"
-	SchemaTypeMixed     SchemaType = "mixed"
-	SchemaTypeAny       SchemaType = "any"
-	SchemaTypeComment   SchemaType = "comment"
+	SchemaTypeUndefined   SchemaType = ""
+	SchemaTypeString      SchemaType = "string"
+	SchemaTypeInteger     SchemaType = "integer"
+	SchemaTypeFloat       SchemaType = "float"
+	SchemaTypeDecimal     SchemaType = "decimal"
+	SchemaTypeInt32       SchemaType = "int32"
+	SchemaTypeInt64       SchemaType = "int64"
+	SchemaTypeUint32      SchemaType = "uint32"
+	SchemaTypeUint64      SchemaType = "uint64"
+	SchemaTypeSafeInteger SchemaType = "safeInteger"
+	SchemaTypeBoolean     SchemaType = "boolean"
+	SchemaTypeObject      SchemaType = "object"
+	SchemaTypeArray       SchemaType = "array"
+	SchemaTypeNull        SchemaType = "null"
+	SchemaTypeEmail       SchemaType = "email"
+	SchemaTypeURI         SchemaType = "uri"
+	SchemaTypeUUID        SchemaType = "uuid"
+	SchemaTypeDate        SchemaType = "date"
+	SchemaTypeDateTime    SchemaType = "datetime"
+	SchemaTypeIPv4        SchemaType = "ipv4"
+	SchemaTypeIPv6        SchemaType = "ipv6"
+	SchemaTypeCIDR        SchemaType = "cidr"
+	SchemaTypeHostname    SchemaType = "hostname"
+	SchemaTypeTime        SchemaType = "time"
+	SchemaTypeDuration    SchemaType = "duration"
+	SchemaTypeBase64      SchemaType = "base64"
+	SchemaTypeBase64URL   SchemaType = "base64url"
+	SchemaTypeHex         SchemaType = "hex"
+	SchemaTypeSemver      SchemaType = "semver"
+	SchemaTypeLanguage    SchemaType = "language"
+	SchemaTypeEnum        SchemaType = "enum"
+	SchemaTypeMixed       SchemaType = "mixed"
+	SchemaTypeAny         SchemaType = "any"
+	SchemaTypeComment     SchemaType = "comment"
 )
 
 func IsValidType(s string) bool {
 	_, ok := map[string]struct{}{
-		string(SchemaTypeString):   {},
-		string(SchemaTypeInteger):  {},
-		string(SchemaTypeFloat):    {},
-		string(SchemaTypeDecimal):  {},
-		string(SchemaTypeBoolean):  {},
-		string(SchemaTypeObject):   {},
-		string(SchemaTypeArray):    {},
-		string(SchemaTypeNull):     {},
-		string(SchemaTypeEmail):    {},
-		string(SchemaTypeURI):      {},
-		string(SchemaTypeUUID):     {},
-		string(SchemaTypeDate):     {},
-		string(SchemaTypeDateTime): {},
-		string(SchemaTypeEnum):     {},
-		string(SchemaTypeMixed):    {},
-		string(SchemaTypeAny):      {},
-		string(SchemaTypeComment):  {},
+		string(SchemaTypeString):      {},
+		string(SchemaTypeInteger):     {},
+		string(SchemaTypeFloat):       {},
+		string(SchemaTypeDecimal):     {},
+		string(SchemaTypeInt32):       {},
+		string(SchemaTypeInt64):       {},
+		string(SchemaTypeUint32):      {},
+		string(SchemaTypeUint64):      {},
+		string(SchemaTypeSafeInteger): {},
+		string(SchemaTypeBoolean):     {},
+		string(SchemaTypeObject):      {},
+		string(SchemaTypeArray):       {},
+		string(SchemaTypeNull):        {},
+		string(SchemaTypeEmail):       {},
+		string(SchemaTypeURI):         {},
+		string(SchemaTypeUUID):        {},
+		string(SchemaTypeDate):        {},
+		string(SchemaTypeDateTime):    {},
+		string(SchemaTypeIPv4):        {},
+		string(SchemaTypeIPv6):        {},
+		string(SchemaTypeCIDR):        {},
+		string(SchemaTypeHostname):    {},
+		string(SchemaTypeTime):        {},
+		string(SchemaTypeDuration):    {},
+		string(SchemaTypeBase64):      {},
+		string(SchemaTypeBase64URL):   {},
+		string(SchemaTypeHex):         {},
+		string(SchemaTypeSemver):      {},
+		string(SchemaTypeLanguage):    {},
+		string(SchemaTypeEnum):        {},
+		string(SchemaTypeMixed):       {},
+		string(SchemaTypeAny):         {},
+		string(SchemaTypeComment):     {},
 	}[s]
 	return ok
 }
@@ -72,7 +104,8 @@ func (t SchemaType) ToTokenType() string {
 		return "array"
 	case SchemaTypeString:
 		return "string"
-	case SchemaTypeInteger, SchemaTypeFloat, SchemaTypeDecimal:
+	case SchemaTypeInteger, SchemaTypeFloat, SchemaTypeDecimal,
+		SchemaTypeInt32, SchemaTypeInt64, SchemaTypeUint32, SchemaTypeUint64, SchemaTypeSafeInteger:
 		return "number"
 	case SchemaTypeBoolean:
 		return "boolean"
@@ -87,20 +120,15 @@ func (t SchemaType) ToTokenType() string {
 }
 
 func (t SchemaType) IsScalar() bool {
-	return t.IsOneOf(
-		SchemaTypeString,
-		SchemaTypeInteger,
-		SchemaTypeFloat,
-		SchemaTypeDecimal,
-		SchemaTypeBoolean,
-		SchemaTypeNull,
-		SchemaTypeEmail,
-		SchemaTypeURI,
-		SchemaTypeUUID,
-		SchemaTypeDate,
-		SchemaTypeDateTime,
-		SchemaTypeEnum,
-	)
+	return t.IsOneOf(stringFormatTypes...) ||
+		t.IsOneOf(integerWidthTypes...) ||
+		t.IsOneOf(
+			SchemaTypeFloat,
+			SchemaTypeDecimal,
+			SchemaTypeBoolean,
+			SchemaTypeNull,
+			SchemaTypeEnum,
+		)
 }
 
 // IsOneOf return true if current schema is one of specified.
@@ -118,9 +146,11 @@ func (t SchemaType) IsOneOf(tt ...SchemaType) bool {
 }
 
 // IsEqualSoft compare two types with next assumptions%
-// - Decimal is the same as float;
-// - Email, URI, UUID, Date, and DateTime are the same as string;
-// - Enum, Mixed and Any are the same as any other type.
+//   - Decimal is the same as float;
+//   - Int32, Int64, Uint32, Uint64 and SafeInteger are the same as integer;
+//   - Email, URI, UUID, Date, DateTime and other string formats are the same as
+//     string;
+//   - Enum, Mixed and Any are the same as any other type.
 func (t SchemaType) IsEqualSoft(x SchemaType) bool {
 	// Fast path.
 	if t == x {
@@ -136,25 +166,48 @@ func (t SchemaType) IsEqualSoft(x SchemaType) bool {
 	return false
 }
 
+// stringFormatTypes the string type and its formats, which are the same as the
+// string type.
+var stringFormatTypes = []SchemaType{
+	SchemaTypeString,
+	SchemaTypeEmail,
+	SchemaTypeURI,
+	SchemaTypeUUID,
+	SchemaTypeDate,
+	SchemaTypeDateTime,
+	SchemaTypeIPv4,
+	SchemaTypeIPv6,
+	SchemaTypeCIDR,
+	SchemaTypeHostname,
+	SchemaTypeTime,
+	SchemaTypeDuration,
+	SchemaTypeBase64,
+	SchemaTypeBase64URL,
+	SchemaTypeHex,
+	SchemaTypeSemver,
+	SchemaTypeLanguage,
+}
+
+// integerWidthTypes the integer type and its widths, which are the same as the
+// integer type.
+var integerWidthTypes = []SchemaType{
+	SchemaTypeInteger,
+	SchemaTypeInt32,
+	SchemaTypeInt64,
+	SchemaTypeUint32,
+	SchemaTypeUint64,
+	SchemaTypeSafeInteger,
+}
+
 var schemaTypeComparisonMap = map[SchemaType][]SchemaType{
-	SchemaTypeUndefined: {},
-	SchemaTypeString: {
-		SchemaTypeString,
-		SchemaTypeEmail,
-		SchemaTypeURI,
-		SchemaTypeUUID,
-		SchemaTypeDate,
-		SchemaTypeDateTime,
-		SchemaTypeEnum,
-		SchemaTypeMixed,
-		SchemaTypeAny,
-	},
-	SchemaTypeInteger: {
-		SchemaTypeInteger,
-		SchemaTypeEnum,
-		SchemaTypeMixed,
-		SchemaTypeAny,
-	},
+	SchemaTypeUndefined:   {},
+	SchemaTypeString:      withWildcardTypes(stringFormatTypes...),
+	SchemaTypeInteger:     withWildcardTypes(integerWidthTypes...),
+	SchemaTypeInt32:       withWildcardTypes(integerWidthTypes...),
+	SchemaTypeInt64:       withWildcardTypes(integerWidthTypes...),
+	SchemaTypeUint32:      withWildcardTypes(integerWidthTypes...),
+	SchemaTypeUint64:      withWildcardTypes(integerWidthTypes...),
+	SchemaTypeSafeInteger: withWildcardTypes(integerWidthTypes...),
 	SchemaTypeFloat: {
 		SchemaTypeFloat,
 		SchemaTypeDecimal,
@@ -194,66 +247,32 @@ var schemaTypeComparisonMap = map[SchemaType][]SchemaType{
 		SchemaTypeMixed,
 		SchemaTypeAny,
 	},
-	SchemaTypeEmail: {
-		SchemaTypeString,
-		SchemaTypeEmail,
-		SchemaTypeURI,
-		SchemaTypeUUID,
-		SchemaTypeDate,
-		SchemaTypeDateTime,
-		SchemaTypeEnum,
-		SchemaTypeMixed,
-		SchemaTypeAny,
-	},
-	SchemaTypeURI: {
-		SchemaTypeString,
-		SchemaTypeEmail,
-		SchemaTypeURI,
-		SchemaTypeUUID,
-		SchemaTypeDate,
-		SchemaTypeDateTime,
-		SchemaTypeEnum,
-		SchemaTypeMixed,
-		SchemaTypeAny,
-	},
-	SchemaTypeUUID: {
-		SchemaTypeString,
-		SchemaTypeEmail,
-		SchemaTypeURI,
-		SchemaTypeUUID,
-		SchemaTypeDate,
-		SchemaTypeDateTime,
-		SchemaTypeEnum,
-		SchemaTypeMixed,
-		SchemaTypeAny,
-	},
-	SchemaTypeDate: {
-		SchemaTypeString,
-		SchemaTypeEmail,
-		SchemaTypeURI,
-		SchemaTypeUUID,
-		SchemaTypeDate,
-		SchemaTypeDateTime,
-		SchemaTypeEnum,
-		SchemaTypeMixed,
-		SchemaTypeAny,
-	},
-	SchemaTypeDateTime: {
-		SchemaTypeString,
-		SchemaTypeEmail,
-		SchemaTypeURI,
-		SchemaTypeUUID,
-		SchemaTypeDate,
-		SchemaTypeDateTime,
-		SchemaTypeEnum,
-		SchemaTypeMixed,
-		SchemaTypeAny,
-	},
+	SchemaTypeEmail:     withWildcardTypes(stringFormatTypes...),
+	SchemaTypeURI:       withWildcardTypes(stringFormatTypes...),
+	SchemaTypeUUID:      withWildcardTypes(stringFormatTypes...),
+	SchemaTypeDate:      withWildcardTypes(stringFormatTypes...),
+	SchemaTypeDateTime:  withWildcardTypes(stringFormatTypes...),
+	SchemaTypeIPv4:      withWildcardTypes(stringFormatTypes...),
+	SchemaTypeIPv6:      withWildcardTypes(stringFormatTypes...),
+	SchemaTypeCIDR:      withWildcardTypes(stringFormatTypes...),
+	SchemaTypeHostname:  withWildcardTypes(stringFormatTypes...),
+	SchemaTypeTime:      withWildcardTypes(stringFormatTypes...),
+	SchemaTypeDuration:  withWildcardTypes(stringFormatTypes...),
+	SchemaTypeBase64:    withWildcardTypes(stringFormatTypes...),
+	SchemaTypeBase64URL: withWildcardTypes(stringFormatTypes...),
+	SchemaTypeHex:       withWildcardTypes(stringFormatTypes...),
+	SchemaTypeSemver:    withWildcardTypes(stringFormatTypes...),
+	SchemaTypeLanguage:  withWildcardTypes(stringFormatTypes...),
 	SchemaTypeEnum: {
 		SchemaTypeString,
 		SchemaTypeInteger,
 		SchemaTypeFloat,
 		SchemaTypeDecimal,
+		SchemaTypeInt32,
+		SchemaTypeInt64,
+		SchemaTypeUint32,
+		SchemaTypeUint64,
+		SchemaTypeSafeInteger,
 		SchemaTypeBoolean,
 		SchemaTypeObject,
 		SchemaTypeArray,
@@ -263,6 +282,17 @@ var schemaTypeComparisonMap = map[SchemaType][]SchemaType{
 		SchemaTypeUUID,
 		SchemaTypeDate,
 		SchemaTypeDateTime,
+		SchemaTypeIPv4,
+		SchemaTypeIPv6,
+		SchemaTypeCIDR,
+		SchemaTypeHostname,
+		SchemaTypeTime,
+		SchemaTypeDuration,
+		SchemaTypeBase64,
+		SchemaTypeBase64URL,
+		SchemaTypeHex,
+		SchemaTypeSemver,
+		SchemaTypeLanguage,
 		SchemaTypeEnum,
 		SchemaTypeMixed,
 		SchemaTypeAny,
@@ -272,6 +302,11 @@ var schemaTypeComparisonMap = map[SchemaType][]SchemaType{
 		SchemaTypeInteger,
 		SchemaTypeFloat,
 		SchemaTypeDecimal,
+		SchemaTypeInt32,
+		SchemaTypeInt64,
+		SchemaTypeUint32,
+		SchemaTypeUint64,
+		SchemaTypeSafeInteger,
 		SchemaTypeBoolean,
 		SchemaTypeObject,
 		SchemaTypeArray,
@@ -281,6 +316,17 @@ var schemaTypeComparisonMap = map[SchemaType][]SchemaType{
 		SchemaTypeUUID,
 		SchemaTypeDate,
 		SchemaTypeDateTime,
+		SchemaTypeIPv4,
+		SchemaTypeIPv6,
+		SchemaTypeCIDR,
+		SchemaTypeHostname,
+		SchemaTypeTime,
+		SchemaTypeDuration,
+		SchemaTypeBase64,
+		SchemaTypeBase64URL,
+		SchemaTypeHex,
+		SchemaTypeSemver,
+		SchemaTypeLanguage,
 		SchemaTypeEnum,
 		SchemaTypeMixed,
 		SchemaTypeAny,
@@ -290,6 +336,11 @@ var schemaTypeComparisonMap = map[SchemaType][]SchemaType{
 		SchemaTypeInteger,
 		SchemaTypeFloat,
 		SchemaTypeDecimal,
+		SchemaTypeInt32,
+		SchemaTypeInt64,
+		SchemaTypeUint32,
+		SchemaTypeUint64,
+		SchemaTypeSafeInteger,
 		SchemaTypeBoolean,
 		SchemaTypeObject,
 		SchemaTypeArray,
@@ -299,12 +350,31 @@ var schemaTypeComparisonMap = map[SchemaType][]SchemaType{
 		SchemaTypeUUID,
 		SchemaTypeDate,
 		SchemaTypeDateTime,
+		SchemaTypeIPv4,
+		SchemaTypeIPv6,
+		SchemaTypeCIDR,
+		SchemaTypeHostname,
+		SchemaTypeTime,
+		SchemaTypeDuration,
+		SchemaTypeBase64,
+		SchemaTypeBase64URL,
+		SchemaTypeHex,
+		SchemaTypeSemver,
+		SchemaTypeLanguage,
 		SchemaTypeEnum,
 		SchemaTypeMixed,
 		SchemaTypeAny,
 	},
 }
 
+// withWildcardTypes returns the types followed by the enum, mixed and any
+// types, which are the same as any other type.
+func withWildcardTypes(tt ...SchemaType) []SchemaType {
+	res := make([]SchemaType, 0, len(tt)+3)
+	res = append(res, tt...)
+	return append(res, SchemaTypeEnum, SchemaTypeMixed, SchemaTypeAny)
+}
+
 var ErrUnknownSchemaType = errors.New("unknown schema type")
 
 func GuessSchemaType(b []byte) (SchemaType, error) {
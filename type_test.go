@@ -145,6 +145,11 @@ var allSchemaTypes = []SchemaType{
 	SchemaTypeInteger,
 	SchemaTypeFloat,
 	SchemaTypeDecimal,
+	SchemaTypeInt32,
+	SchemaTypeInt64,
+	SchemaTypeUint32,
+	SchemaTypeUint64,
+	SchemaTypeSafeInteger,
 	SchemaTypeBoolean,
 	SchemaTypeObject,
 	SchemaTypeArray,
@@ -154,6 +159,17 @@ var allSchemaTypes = []SchemaType{
 	SchemaTypeUUID,
 	SchemaTypeDate,
 	SchemaTypeDateTime,
+	SchemaTypeIPv4,
+	SchemaTypeIPv6,
+	SchemaTypeCIDR,
+	SchemaTypeHostname,
+	SchemaTypeTime,
+	SchemaTypeDuration,
+	SchemaTypeBase64,
+	SchemaTypeBase64URL,
+	SchemaTypeHex,
+	SchemaTypeSemver,
+	SchemaTypeLanguage,
 	SchemaTypeEnum,
 	SchemaTypeMixed,
 	SchemaTypeAny,